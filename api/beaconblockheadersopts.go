@@ -0,0 +1,31 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import "github.com/attestantio/go-eth2-client/spec/phase0"
+
+// BeaconBlockHeadersOpts are the options for obtaining a list of beacon block headers.
+type BeaconBlockHeadersOpts struct {
+	Common CommonOpts
+
+	// Slot is the slot for which headers are obtained.  All headers at the
+	// slot are returned, including those that are not canonical, so the
+	// Canonical flag of each header can be used to find competing blocks.
+	// If not present then data for the head slot will be obtained.
+	Slot *phase0.Slot
+
+	// ParentRoot is the root of the parent block for which headers are obtained.
+	// If not present then data for all parents will be obtained.
+	ParentRoot *phase0.Root
+}
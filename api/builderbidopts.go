@@ -0,0 +1,28 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import "github.com/attestantio/go-eth2-client/spec/phase0"
+
+// BuilderBidOpts are the options for obtaining a bid from a builder.
+type BuilderBidOpts struct {
+	Common CommonOpts
+
+	// Slot is the slot for which the bid is requested.
+	Slot phase0.Slot
+	// ParentHash is the hash of the execution block on which the bid builds.
+	ParentHash phase0.Hash32
+	// PubKey is the public key of the proposer for the slot.
+	PubKey phase0.BLSPubKey
+}
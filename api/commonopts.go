@@ -17,7 +17,10 @@ import "time"
 
 // CommonOpts are options common for all calls.
 type CommonOpts struct {
-	// Timeout is a specific timeout for this call.
+	// Timeout is a specific timeout for this call, covering any retries.
 	// If 0 then the default timeout is used.
 	Timeout time.Duration
+	// RetryPolicy is a specific retry policy for this call.
+	// If nil then the default retry policy is used.
+	RetryPolicy *RetryPolicy
 }
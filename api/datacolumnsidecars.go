@@ -0,0 +1,26 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/fulu"
+	"github.com/pk910/dynamic-ssz/sszutils"
+)
+
+// DataColumnSidecars is an API construct to allow decoding an array of data column sidecars.
+type DataColumnSidecars struct {
+	Sidecars []*fulu.DataColumnSidecar `dynssz-max:"NUMBER_OF_COLUMNS" ssz-max:"128"`
+}
+
+var _ = sszutils.Annotate[DataColumnSidecars](`ssz-type:"wrapper"`)
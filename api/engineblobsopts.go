@@ -0,0 +1,30 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+)
+
+// EngineBlobsOpts are the options for obtaining blobs from an execution client's blob pool.
+type EngineBlobsOpts struct {
+	Common CommonOpts
+
+	// Version is the fork for which blobs are requested, and selects the version of the
+	// engine_getBlobs method.
+	Version spec.DataVersion
+	// VersionedHashes are the versioned hashes of the blobs to obtain.
+	VersionedHashes []deneb.VersionedHash
+}
@@ -0,0 +1,33 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"github.com/attestantio/go-eth2-client/api/v1/engine"
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+// EngineForkchoiceUpdatedOpts are the options for updating an execution client's fork choice.
+type EngineForkchoiceUpdatedOpts struct {
+	Common CommonOpts
+
+	// Version is the fork for which the update is made, and selects the version of the
+	// engine_forkchoiceUpdated method.
+	Version spec.DataVersion
+	// State is the fork choice state.
+	State *engine.ForkchoiceState
+	// Attributes are the attributes of a payload to build.
+	// If nil no payload is built.
+	Attributes *engine.PayloadAttributes
+}
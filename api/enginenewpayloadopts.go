@@ -0,0 +1,39 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// EngineNewPayloadOpts are the options for submitting a new payload to an execution client.
+// The version of the engine_newPayload method is selected by the version of the payload.
+type EngineNewPayloadOpts struct {
+	Common CommonOpts
+
+	// Payload is the execution payload.
+	Payload *spec.VersionedExecutionPayload
+	// VersionedHashes are the versioned hashes of the blobs referenced by the payload.
+	// Used from Deneb; nil is treated as an empty list for payloads without blobs.
+	VersionedHashes []deneb.VersionedHash
+	// ParentBeaconBlockRoot is the root of the parent beacon block.
+	// Required from Deneb.
+	ParentBeaconBlockRoot *phase0.Root
+	// ExecutionRequests are the execution requests included in the beacon block.
+	// Required from Electra.
+	ExecutionRequests *electra.ExecutionRequests
+}
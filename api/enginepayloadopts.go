@@ -0,0 +1,29 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"github.com/attestantio/go-eth2-client/api/v1/engine"
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+// EnginePayloadOpts are the options for obtaining a built payload from an execution client.
+type EnginePayloadOpts struct {
+	Common CommonOpts
+
+	// Version is the fork of the payload, and selects the version of the engine_getPayload method.
+	Version spec.DataVersion
+	// PayloadID is the ID returned when the payload build was started.
+	PayloadID engine.PayloadID
+}
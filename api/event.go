@@ -0,0 +1,111 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"errors"
+	"fmt"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Event is an event delivered to a subscription.
+// Only the field for the event's topic is populated.
+type Event struct {
+	// Topic is the topic of the event.
+	Topic string
+	// Metadata is metadata about the event, for example if it was synthesized.
+	Metadata map[string]any
+
+	Attestation                 *spec.VersionedAttestation
+	AttesterSlashing            *electra.AttesterSlashing
+	BlobSidecar                 *apiv1.BlobSidecarEvent
+	Block                       *apiv1.BlockEvent
+	BlockGossip                 *apiv1.BlockGossipEvent
+	BLSToExecutionChange        *capella.SignedBLSToExecutionChange
+	ChainReorg                  *apiv1.ChainReorgEvent
+	ContributionAndProof        *altair.SignedContributionAndProof
+	DataColumnSidecar           *apiv1.DataColumnSidecarEvent
+	FinalizedCheckpoint         *apiv1.FinalizedCheckpointEvent
+	Head                        *apiv1.HeadEvent
+	LightClientFinalityUpdate   *spec.VersionedLightClientFinalityUpdate
+	LightClientOptimisticUpdate *spec.VersionedLightClientOptimisticUpdate
+	PayloadAttributes           *apiv1.PayloadAttributesEvent
+	ProposerSlashing            *phase0.ProposerSlashing
+	SingleAttestation           *electra.SingleAttestation
+	VoluntaryExit               *phase0.SignedVoluntaryExit
+}
+
+// NewEvent creates a typed event from a generic event.
+func NewEvent(event *apiv1.Event) (*Event, error) {
+	if event == nil {
+		return nil, errors.New("no event supplied")
+	}
+
+	res := &Event{
+		Topic:    event.Topic,
+		Metadata: event.Metadata,
+	}
+
+	var isType bool
+	switch event.Topic {
+	case "attestation":
+		res.Attestation, isType = event.Data.(*spec.VersionedAttestation)
+	case "attester_slashing":
+		res.AttesterSlashing, isType = event.Data.(*electra.AttesterSlashing)
+	case "blob_sidecar":
+		res.BlobSidecar, isType = event.Data.(*apiv1.BlobSidecarEvent)
+	case "block":
+		res.Block, isType = event.Data.(*apiv1.BlockEvent)
+	case "block_gossip":
+		res.BlockGossip, isType = event.Data.(*apiv1.BlockGossipEvent)
+	case "bls_to_execution_change":
+		res.BLSToExecutionChange, isType = event.Data.(*capella.SignedBLSToExecutionChange)
+	case "chain_reorg":
+		res.ChainReorg, isType = event.Data.(*apiv1.ChainReorgEvent)
+	case "contribution_and_proof":
+		res.ContributionAndProof, isType = event.Data.(*altair.SignedContributionAndProof)
+	case "data_column_sidecar":
+		res.DataColumnSidecar, isType = event.Data.(*apiv1.DataColumnSidecarEvent)
+	case "finalized_checkpoint":
+		res.FinalizedCheckpoint, isType = event.Data.(*apiv1.FinalizedCheckpointEvent)
+	case "head":
+		res.Head, isType = event.Data.(*apiv1.HeadEvent)
+	case "light_client_finality_update":
+		res.LightClientFinalityUpdate, isType = event.Data.(*spec.VersionedLightClientFinalityUpdate)
+	case "light_client_optimistic_update":
+		res.LightClientOptimisticUpdate, isType = event.Data.(*spec.VersionedLightClientOptimisticUpdate)
+	case "payload_attributes":
+		res.PayloadAttributes, isType = event.Data.(*apiv1.PayloadAttributesEvent)
+	case "proposer_slashing":
+		res.ProposerSlashing, isType = event.Data.(*phase0.ProposerSlashing)
+	case "single_attestation":
+		res.SingleAttestation, isType = event.Data.(*electra.SingleAttestation)
+	case "voluntary_exit":
+		res.VoluntaryExit, isType = event.Data.(*phase0.SignedVoluntaryExit)
+	default:
+		return nil, fmt.Errorf("unsupported event topic %s", event.Topic)
+	}
+
+	if !isType {
+		return nil, fmt.Errorf("incorrect data type %T for %s event", event.Data, event.Topic)
+	}
+
+	return res, nil
+}
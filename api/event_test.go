@@ -0,0 +1,94 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEvent(t *testing.T) {
+	tests := []struct {
+		name     string
+		event    *apiv1.Event
+		expected *api.Event
+		err      string
+	}{
+		{
+			name: "Nil",
+			err:  "no event supplied",
+		},
+		{
+			name: "UnknownTopic",
+			event: &apiv1.Event{
+				Topic: "unknown",
+			},
+			err: "unsupported event topic unknown",
+		},
+		{
+			name: "IncorrectType",
+			event: &apiv1.Event{
+				Topic: "head",
+				Data:  &apiv1.BlockEvent{},
+			},
+			err: "incorrect data type *v1.BlockEvent for head event",
+		},
+		{
+			name: "Head",
+			event: &apiv1.Event{
+				Topic: "head",
+				Data:  &apiv1.HeadEvent{Slot: 1},
+			},
+			expected: &api.Event{
+				Topic: "head",
+				Head:  &apiv1.HeadEvent{Slot: 1},
+			},
+		},
+		{
+			name: "VoluntaryExit",
+			event: &apiv1.Event{
+				Topic: "voluntary_exit",
+				Data:  &phase0.SignedVoluntaryExit{},
+			},
+			expected: &api.Event{
+				Topic:         "voluntary_exit",
+				VoluntaryExit: &phase0.SignedVoluntaryExit{},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			event, err := api.NewEvent(test.event)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, event)
+			}
+		})
+	}
+}
+
+func TestNewEventAllTopics(t *testing.T) {
+	// Every supported topic must be convertible to a typed event.
+	for topic := range apiv1.SupportedEventTopics {
+		_, err := api.NewEvent(&apiv1.Event{Topic: topic})
+		require.ErrorContains(t, err, "incorrect data type", topic)
+	}
+}
@@ -15,6 +15,7 @@ package api
 
 import (
 	"context"
+	"time"
 
 	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec"
@@ -31,6 +32,33 @@ type EventsOpts struct {
 	// Topics are the topics of events to which we want to listen.
 	Topics []string
 
+	// LastEventID, if supplied, is sent when first connecting to resume a stream from a previous event.
+	// Subsequent reconnections resume from the last event received.
+	LastEventID string
+	// ReconnectBackoff is the delay before the first attempt to reconnect after a disconnection,
+	// doubling with each failed attempt.
+	// If 0 then a default of 1 second is used.
+	ReconnectBackoff time.Duration
+	// MaxReconnectBackoff is the maximum delay between attempts to reconnect.
+	// If 0 then a default of 30 seconds is used.
+	MaxReconnectBackoff time.Duration
+	// Backfill, if true, synthesizes the head and block events missed whilst the stream was disconnected.
+	// Once the stream has reconnected, and before its first event is handled, the last block seen is compared
+	// with the current head, and events for the blocks between them are sent to the handlers, oldest first,
+	// flagged as synthesized.  Live events for blocks that have already been synthesized are not sent again.
+	// Synthesized head events do not contain duty dependent roots.
+	Backfill bool
+	// MaxBackfillBlocks is the maximum number of blocks for which events are synthesized on reconnection.
+	// If 0 then a default of 64 is used.
+	MaxBackfillBlocks int
+
+	// ConnectedHandler is called each time the stream connects.
+	ConnectedHandler EventStreamConnectedHandlerFunc
+	// DisconnectedHandler is called each time a connected stream disconnects, with the cause of the disconnection.
+	DisconnectedHandler EventStreamDisconnectedHandlerFunc
+	// ReconnectingHandler is called before each attempt to reconnect the stream.
+	ReconnectingHandler EventStreamReconnectingHandlerFunc
+
 	// Handler is a generic handler function to which to send all events.
 	// In general, it is better to use event-specific handlers as they avoid casting, and also provide a context.
 	Handler EventHandlerFunc
@@ -57,6 +85,10 @@ type EventsOpts struct {
 	FinalizedCheckpointHandler FinalizedCheckpointEventHandlerFunc
 	// HeadHandler is a handler for the head event.
 	HeadHandler HeadEventHandlerFunc
+	// LightClientFinalityUpdateHandler is a handler for the light_client_finality_update event.
+	LightClientFinalityUpdateHandler LightClientFinalityUpdateEventHandlerFunc
+	// LightClientOptimisticUpdateHandler is a handler for the light_client_optimistic_update event.
+	LightClientOptimisticUpdateHandler LightClientOptimisticUpdateEventHandlerFunc
 	// PayloadAttributesHandler is a handler for the payload_attributes event.
 	PayloadAttributesHandler PayloadAttributesEventHandlerFunc
 	// ProposerSlashingHandler is a handler for the proposer_slashing event.
@@ -67,6 +99,16 @@ type EventsOpts struct {
 	VoluntaryExitHandler VoluntaryExitEventHandlerFunc
 }
 
+// EventStreamConnectedHandlerFunc is the handler for an event stream connecting.
+type EventStreamConnectedHandlerFunc func(context.Context)
+
+// EventStreamDisconnectedHandlerFunc is the handler for an event stream disconnecting.
+type EventStreamDisconnectedHandlerFunc func(context.Context, error)
+
+// EventStreamReconnectingHandlerFunc is the handler for an event stream attempting to reconnect.
+// It is supplied with the attempt number, starting at 1, and the delay before the attempt is made.
+type EventStreamReconnectingHandlerFunc func(ctx context.Context, attempt int, delay time.Duration)
+
 // EventHandlerFunc is the handler for generic events.
 type EventHandlerFunc func(*apiv1.Event)
 
@@ -100,6 +142,12 @@ type FinalizedCheckpointEventHandlerFunc func(context.Context, *apiv1.FinalizedC
 // HeadEventHandlerFunc is the handler for head events.
 type HeadEventHandlerFunc func(context.Context, *apiv1.HeadEvent)
 
+// LightClientFinalityUpdateEventHandlerFunc is the handler for light_client_finality_update events.
+type LightClientFinalityUpdateEventHandlerFunc func(context.Context, *spec.VersionedLightClientFinalityUpdate)
+
+// LightClientOptimisticUpdateEventHandlerFunc is the handler for light_client_optimistic_update events.
+type LightClientOptimisticUpdateEventHandlerFunc func(context.Context, *spec.VersionedLightClientOptimisticUpdate)
+
 // PayloadAttributesEventHandlerFunc is the handler for payload_attributes events.
 type PayloadAttributesEventHandlerFunc func(context.Context, *apiv1.PayloadAttributesEvent)
 
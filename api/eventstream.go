@@ -0,0 +1,70 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import "time"
+
+// EventStreamState is the state of an event stream.
+type EventStreamState int
+
+const (
+	// EventStreamStateConnecting means the stream is making its first connection.
+	EventStreamStateConnecting EventStreamState = iota
+	// EventStreamStateConnected means the stream is connected and receiving events.
+	EventStreamStateConnected
+	// EventStreamStateReconnecting means the stream has disconnected and is attempting to reconnect.
+	EventStreamStateReconnecting
+	// EventStreamStateClosed means the stream has been closed and will not reconnect.
+	EventStreamStateClosed
+)
+
+var eventStreamStateStrings = [...]string{
+	"connecting",
+	"connected",
+	"reconnecting",
+	"closed",
+}
+
+func (e EventStreamState) String() string {
+	if e < 0 || int(e) >= len(eventStreamStateStrings) {
+		return "unknown"
+	}
+
+	return eventStreamStateStrings[e]
+}
+
+// EventStreamStatus is the status of an event stream.
+type EventStreamStatus struct {
+	// State is the current state of the stream.
+	State EventStreamState
+	// Since is the time at which the stream entered its current state.
+	Since time.Time
+	// Reconnects is the number of times the stream has reconnected after a disconnection.
+	Reconnects uint64
+	// LastEventID is the ID of the last event received, if the server supplies IDs.
+	LastEventID string
+	// LastEventTime is the time at which the last event was received.
+	LastEventTime time.Time
+	// LastError is the error that caused the most recent disconnection or failed connection, if any.
+	LastError error
+}
+
+// EventStream is a handle to a stream of events.
+type EventStream interface {
+	// Status returns the current status of the stream.
+	Status() *EventStreamStatus
+
+	// Close closes the stream.  The stream will not reconnect once closed.
+	Close()
+}
@@ -0,0 +1,26 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+// ImportKeystoresOpts are the options for importing keystores in to a validator client.
+type ImportKeystoresOpts struct {
+	Common CommonOpts
+
+	// Keystores are the JSON-encoded EIP-2335 keystores to import.
+	Keystores []string
+	// Passwords are the passwords for the keystores, in the same order as the keystores.
+	Passwords []string
+	// SlashingProtection is the optional JSON-encoded EIP-3076 slashing protection interchange data.
+	SlashingProtection string
+}
@@ -0,0 +1,23 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+// LightClientBootstrapOpts are the options for obtaining light client bootstraps.
+type LightClientBootstrapOpts struct {
+	Common CommonOpts
+
+	// Block is the root of the block for which the data is obtained.
+	// This must be a block root, as per the light client specification.
+	Block string
+}
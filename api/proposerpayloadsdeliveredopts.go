@@ -0,0 +1,88 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// BidTraceOrder is the order in which bid traces are returned.
+type BidTraceOrder int
+
+const (
+	// BidTraceOrderDefault returns bid traces in descending slot order.
+	BidTraceOrderDefault BidTraceOrder = iota
+	// BidTraceOrderValueAscending returns bid traces in ascending value order.
+	BidTraceOrderValueAscending
+	// BidTraceOrderValueDescending returns bid traces in descending value order.
+	BidTraceOrderValueDescending
+)
+
+// ProposerPayloadsDeliveredOpts are the options for obtaining payloads delivered by a relay to proposers.
+type ProposerPayloadsDeliveredOpts struct {
+	Common CommonOpts
+
+	// Slot restricts the results to the given slot.
+	// This is mutually exclusive with Cursor.
+	Slot *phase0.Slot
+	// Cursor is the highest slot for which results are returned.
+	// This is mutually exclusive with Slot.
+	Cursor *phase0.Slot
+	// Limit is the maximum number of results to return.
+	// If this is 0 the relay's default limit is used.
+	Limit uint64
+	// BlockHash restricts the results to the given execution block hash.
+	BlockHash *phase0.Hash32
+	// BlockNumber restricts the results to the given execution block number.
+	BlockNumber *uint64
+	// ProposerPubkey restricts the results to the given proposer.
+	ProposerPubkey *phase0.BLSPubKey
+	// BuilderPubkey restricts the results to the given builder.
+	BuilderPubkey *phase0.BLSPubKey
+	// OrderBy is the order in which results are returned.
+	OrderBy BidTraceOrder
+}
+
+// NextPage returns the options to obtain the page of results following the supplied traces,
+// or nil if there are no further pages.
+// Pagination is only available when results are returned in the default order.
+func (o *ProposerPayloadsDeliveredOpts) NextPage(traces []*apiv1.BidTrace) *ProposerPayloadsDeliveredOpts {
+	if o.Slot != nil || o.OrderBy != BidTraceOrderDefault {
+		// No further pages for a single slot or value-ordered results.
+		return nil
+	}
+
+	if len(traces) == 0 || (o.Limit != 0 && uint64(len(traces)) < o.Limit) {
+		// Final page.
+		return nil
+	}
+
+	lowest := traces[0].Slot
+	for _, trace := range traces[1:] {
+		if trace.Slot < lowest {
+			lowest = trace.Slot
+		}
+	}
+
+	if lowest == 0 {
+		return nil
+	}
+
+	cursor := lowest - 1
+	next := *o
+	next.Cursor = &cursor
+
+	return &next
+}
@@ -0,0 +1,44 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import "time"
+
+// RetryPolicy defines how requests that fail with a transient error are retried.
+//
+// A request is retried if the server responds with 429, 502, 503 or 504,
+// or if the connection is reset.  Requests that read data are retried by
+// default; submissions are only retried if RetrySubmissions is set.  All
+// attempts, and the delays between them, must complete within the request's
+// timeout.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts for a request, including
+	// the first.  It must be at least 1; a value of 1 disables retries.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff is the maximum delay between attempts.  A Retry-After
+	// header requesting a longer delay results in the request failing
+	// rather than being retried.
+	MaxBackoff time.Duration
+	// Multiplier is the factor by which the delay increases after each
+	// retry.  Values below 1 are treated as 1.
+	Multiplier float64
+	// Jitter is the fraction, between 0 and 1, by which each delay is
+	// randomly varied.
+	Jitter float64
+	// RetrySubmissions allows submissions to be retried.  This should only
+	// be set if the submission is safe to repeat.
+	RetrySubmissions bool
+}
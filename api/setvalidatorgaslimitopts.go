@@ -0,0 +1,26 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import "github.com/attestantio/go-eth2-client/spec/phase0"
+
+// SetValidatorGasLimitOpts are the options for setting the gas limit for a validator.
+type SetValidatorGasLimitOpts struct {
+	Common CommonOpts
+
+	// Pubkey is the public key of the validator.
+	Pubkey phase0.BLSPubKey
+	// GasLimit is the gas limit requested for blocks proposed by the validator.
+	GasLimit uint64
+}
@@ -0,0 +1,54 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+// EventOverflowPolicy defines what happens when a subscription's buffer is full.
+type EventOverflowPolicy int
+
+const (
+	// EventOverflowBlock holds further events until there is space in the buffer.
+	// This stalls the underlying event stream.
+	EventOverflowBlock EventOverflowPolicy = iota
+	// EventOverflowDropOldest discards the oldest buffered event to make space for the new event.
+	EventOverflowDropOldest
+	// EventOverflowDropNewest discards the new event.
+	EventOverflowDropNewest
+)
+
+var eventOverflowPolicyStrings = [...]string{
+	"block",
+	"drop_oldest",
+	"drop_newest",
+}
+
+func (e EventOverflowPolicy) String() string {
+	if e < 0 || int(e) >= len(eventOverflowPolicyStrings) {
+		return "unknown"
+	}
+
+	return eventOverflowPolicyStrings[e]
+}
+
+// SubscribeOpts are the options for subscribing to events.
+type SubscribeOpts struct {
+	Common CommonOpts
+
+	// Topics are the topics of events to which we want to subscribe.
+	Topics []string
+	// BufferSize is the number of events that can be held awaiting the subscriber.
+	// If 0 then a default of 64 is used.
+	BufferSize int
+	// OverflowPolicy defines what happens when the buffer is full.
+	OverflowPolicy EventOverflowPolicy
+}
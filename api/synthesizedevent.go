@@ -0,0 +1,36 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import "context"
+
+// EventMetadataSynthesized is the metadata key set for events that were synthesized
+// by the client, rather than received from the beacon node.
+const EventMetadataSynthesized = "synthesized"
+
+type synthesizedEventKey struct{}
+
+// WithSynthesizedEvent returns a context that marks the event passed with it
+// to a handler as synthesized.
+func WithSynthesizedEvent(ctx context.Context) context.Context {
+	return context.WithValue(ctx, synthesizedEventKey{}, true)
+}
+
+// IsSynthesizedEvent returns true if the event passed to a handler with the
+// given context was synthesized, rather than received from the beacon node.
+func IsSynthesizedEvent(ctx context.Context) bool {
+	synthesized, isBool := ctx.Value(synthesizedEventKey{}).(bool)
+
+	return isBool && synthesized
+}
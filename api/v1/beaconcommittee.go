@@ -29,7 +29,7 @@ type BeaconCommittee struct {
 	// Index is the index of the committee.
 	Index phase0.CommitteeIndex
 	// Validators is the list of validator indices in the committee.
-	Validators []phase0.ValidatorIndex
+	Validators []phase0.ValidatorIndex `dynssz-max:"MAX_VALIDATORS_PER_COMMITTEE" ssz-max:"2048"`
 }
 
 // beaconCommitteeJSON is the spec representation of the struct.
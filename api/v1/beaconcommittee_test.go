@@ -109,3 +109,18 @@ func TestBeaconCommitteeJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestBeaconCommitteeSSZ(t *testing.T) {
+	input := []byte(`{"slot":"1","index":"2","validators":["2","128","4","61"]}`)
+
+	var datum api.BeaconCommittee
+	require.NoError(t, json.Unmarshal(input, &datum))
+
+	data, err := datum.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, datum.SizeSSZ())
+
+	var res api.BeaconCommittee
+	require.NoError(t, res.UnmarshalSSZ(data))
+	assert.Equal(t, datum, res)
+}
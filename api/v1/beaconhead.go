@@ -0,0 +1,93 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// BeaconHead is a head of the beacon node's fork choice.
+type BeaconHead struct {
+	// Slot is the slot of the head block.
+	Slot phase0.Slot
+	// Root is the root of the head block.
+	Root phase0.Root
+	// ExecutionOptimistic is true if the head block has not been verified by the execution client.
+	ExecutionOptimistic bool
+}
+
+// beaconHeadJSON is the spec representation of the struct.
+type beaconHeadJSON struct {
+	Slot                string `json:"slot"`
+	Root                string `json:"root"`
+	ExecutionOptimistic bool   `json:"execution_optimistic"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b *BeaconHead) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&beaconHeadJSON{
+		Slot:                fmt.Sprintf("%d", b.Slot),
+		Root:                fmt.Sprintf("%#x", b.Root),
+		ExecutionOptimistic: b.ExecutionOptimistic,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BeaconHead) UnmarshalJSON(input []byte) error {
+	var err error
+
+	var beaconHeadJSON beaconHeadJSON
+	if err = json.Unmarshal(input, &beaconHeadJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	if beaconHeadJSON.Slot == "" {
+		return errors.New("slot missing")
+	}
+	slot, err := strconv.ParseUint(beaconHeadJSON.Slot, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for slot")
+	}
+	b.Slot = phase0.Slot(slot)
+	if beaconHeadJSON.Root == "" {
+		return errors.New("root missing")
+	}
+	root, err := hex.DecodeString(strings.TrimPrefix(beaconHeadJSON.Root, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for root")
+	}
+	if len(root) != rootLength {
+		return fmt.Errorf("incorrect length %d for root", len(root))
+	}
+	copy(b.Root[:], root)
+	b.ExecutionOptimistic = beaconHeadJSON.ExecutionOptimistic
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (b *BeaconHead) String() string {
+	data, err := json.Marshal(b)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
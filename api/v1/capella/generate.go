@@ -13,5 +13,5 @@
 
 package capella
 
-//go:generate rm -f blindedbeaconblockbody_ssz.go blindedbeaconblock_ssz.go signedblindedbeaconblock_ssz.go
+//go:generate rm -f blindedbeaconblockbody_ssz.go blindedbeaconblock_ssz.go builderbid_ssz.go signedblindedbeaconblock_ssz.go signedbuilderbid_ssz.go
 //go:generate go tool dynssz-gen -config generate.yaml
@@ -14,5 +14,5 @@
 package deneb
 
 //nolint:revive
-//go:generate rm -f blindedbeaconblock_ssz.go blindedbeaconblockbody_ssz.go blockcontents_ssz.go signedblindedbeaconblock_ssz.go signedblockcontents_ssz.go
+//go:generate rm -f blindedbeaconblock_ssz.go blindedbeaconblockbody_ssz.go blobsbundle_ssz.go blockcontents_ssz.go builderbid_ssz.go executionpayloadandblobsbundle_ssz.go signedblindedbeaconblock_ssz.go signedblockcontents_ssz.go signedbuilderbid_ssz.go
 //go:generate go tool dynssz-gen -config generate.yaml
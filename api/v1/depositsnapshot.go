@@ -0,0 +1,154 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// DepositSnapshot is an EIP-4881 snapshot of the deposit contract's Merkle tree.
+type DepositSnapshot struct {
+	// Finalized are the roots of the finalized subtrees of the deposit tree.
+	Finalized []phase0.Root `ssz-max:"32"`
+	// DepositRoot is the root of the deposit tree, including the deposit count.
+	DepositRoot phase0.Root `ssz-size:"32"`
+	// DepositCount is the number of deposits covered by the snapshot.
+	DepositCount uint64
+	// ExecutionBlockHash is the hash of the execution block at which the snapshot was taken.
+	ExecutionBlockHash phase0.Hash32 `ssz-size:"32"`
+	// ExecutionBlockHeight is the height of the execution block at which the snapshot was taken.
+	ExecutionBlockHeight uint64
+}
+
+// depositSnapshotJSON is the spec representation of the struct.
+type depositSnapshotJSON struct {
+	Finalized            []string `json:"finalized"`
+	DepositRoot          string   `json:"deposit_root"`
+	DepositCount         string   `json:"deposit_count"`
+	ExecutionBlockHash   string   `json:"execution_block_hash"`
+	ExecutionBlockHeight string   `json:"execution_block_height"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d *DepositSnapshot) MarshalJSON() ([]byte, error) {
+	finalized := make([]string, len(d.Finalized))
+	for i := range d.Finalized {
+		finalized[i] = fmt.Sprintf("%#x", d.Finalized[i])
+	}
+
+	return json.Marshal(&depositSnapshotJSON{
+		Finalized:            finalized,
+		DepositRoot:          fmt.Sprintf("%#x", d.DepositRoot),
+		DepositCount:         strconv.FormatUint(d.DepositCount, 10),
+		ExecutionBlockHash:   fmt.Sprintf("%#x", d.ExecutionBlockHash),
+		ExecutionBlockHeight: strconv.FormatUint(d.ExecutionBlockHeight, 10),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DepositSnapshot) UnmarshalJSON(input []byte) error {
+	var err error
+
+	var depositSnapshotJSON depositSnapshotJSON
+	if err = json.Unmarshal(input, &depositSnapshotJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if depositSnapshotJSON.Finalized == nil {
+		return errors.New("finalized missing")
+	}
+
+	d.Finalized = make([]phase0.Root, len(depositSnapshotJSON.Finalized))
+	for i := range depositSnapshotJSON.Finalized {
+		if depositSnapshotJSON.Finalized[i] == "" {
+			return fmt.Errorf("finalized root %d missing", i)
+		}
+
+		root, err := hex.DecodeString(strings.TrimPrefix(depositSnapshotJSON.Finalized[i], "0x"))
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("invalid value for finalized root %d", i))
+		}
+
+		if len(root) != phase0.RootLength {
+			return fmt.Errorf("incorrect length for finalized root %d", i)
+		}
+
+		copy(d.Finalized[i][:], root)
+	}
+
+	if depositSnapshotJSON.DepositRoot == "" {
+		return errors.New("deposit root missing")
+	}
+
+	depositRoot, err := hex.DecodeString(strings.TrimPrefix(depositSnapshotJSON.DepositRoot, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for deposit root")
+	}
+
+	if len(depositRoot) != phase0.RootLength {
+		return errors.New("incorrect length for deposit root")
+	}
+
+	copy(d.DepositRoot[:], depositRoot)
+
+	if depositSnapshotJSON.DepositCount == "" {
+		return errors.New("deposit count missing")
+	}
+
+	if d.DepositCount, err = strconv.ParseUint(depositSnapshotJSON.DepositCount, 10, 64); err != nil {
+		return errors.Wrap(err, "invalid value for deposit count")
+	}
+
+	if depositSnapshotJSON.ExecutionBlockHash == "" {
+		return errors.New("execution block hash missing")
+	}
+
+	executionBlockHash, err := hex.DecodeString(strings.TrimPrefix(depositSnapshotJSON.ExecutionBlockHash, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for execution block hash")
+	}
+
+	if len(executionBlockHash) != phase0.Hash32Length {
+		return errors.New("incorrect length for execution block hash")
+	}
+
+	copy(d.ExecutionBlockHash[:], executionBlockHash)
+
+	if depositSnapshotJSON.ExecutionBlockHeight == "" {
+		return errors.New("execution block height missing")
+	}
+
+	if d.ExecutionBlockHeight, err = strconv.ParseUint(depositSnapshotJSON.ExecutionBlockHeight, 10, 64); err != nil {
+		return errors.Wrap(err, "invalid value for execution block height")
+	}
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (d *DepositSnapshot) String() string {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
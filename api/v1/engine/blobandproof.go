@@ -0,0 +1,101 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/pkg/errors"
+)
+
+// BlobAndProof is a blob held by the execution client, along with its proofs.
+// engine_getBlobsV1 returns a single KZG proof per blob; engine_getBlobsV2
+// returns the cell proofs for the blob.
+type BlobAndProof struct {
+	Blob   deneb.Blob
+	Proofs []deneb.KZGProof
+}
+
+// blobAndProofJSON is the Engine API representation of the struct.
+type blobAndProofJSON struct {
+	Blob   string   `json:"blob"`
+	Proof  string   `json:"proof,omitempty"`
+	Proofs []string `json:"proofs,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+// A single proof is encoded as BlobAndProofV1, otherwise as BlobAndProofV2.
+func (b *BlobAndProof) MarshalJSON() ([]byte, error) {
+	data := &blobAndProofJSON{
+		Blob: encodeData(b.Blob[:]),
+	}
+
+	if len(b.Proofs) == 1 {
+		data.Proof = encodeData(b.Proofs[0][:])
+	} else {
+		data.Proofs = make([]string, len(b.Proofs))
+		for i := range b.Proofs {
+			data.Proofs[i] = encodeData(b.Proofs[i][:])
+		}
+	}
+
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BlobAndProof) UnmarshalJSON(input []byte) error {
+	var data blobAndProofJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.Blob == "" {
+		return errors.New("blob missing")
+	}
+
+	if err := decodeFixedData(data.Blob, b.Blob[:]); err != nil {
+		return errors.Wrap(err, "invalid value for blob")
+	}
+
+	switch {
+	case data.Proof != "":
+		b.Proofs = make([]deneb.KZGProof, 1)
+		if err := decodeFixedData(data.Proof, b.Proofs[0][:]); err != nil {
+			return errors.Wrap(err, "invalid value for proof")
+		}
+	case data.Proofs != nil:
+		b.Proofs = make([]deneb.KZGProof, len(data.Proofs))
+		for i := range data.Proofs {
+			if err := decodeFixedData(data.Proofs[i], b.Proofs[i][:]); err != nil {
+				return errors.Wrapf(err, "invalid value for proof %d", i)
+			}
+		}
+	default:
+		return errors.New("proofs missing")
+	}
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (b *BlobAndProof) String() string {
+	data, err := json.Marshal(b)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,216 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/holiman/uint256"
+	"github.com/pkg/errors"
+)
+
+// BuiltPayload is a payload built by the execution client, along with its
+// associated data.  Which fields are present depends on the version of the
+// payload: engine_getPayloadV1 returns only the execution payload,
+// engine_getPayloadV2 adds the block value, engine_getPayloadV3 adds the
+// blobs bundle and builder override flag, and engine_getPayloadV4 onwards
+// add the execution requests.
+type BuiltPayload struct {
+	ExecutionPayload      *spec.VersionedExecutionPayload
+	BlockValue            *uint256.Int
+	BlobsBundle           *apiv1deneb.BlobsBundle
+	ShouldOverrideBuilder bool
+	ExecutionRequests     *electra.ExecutionRequests
+}
+
+// builtPayloadJSON is the Engine API representation of the struct.
+type builtPayloadJSON struct {
+	ExecutionPayload      json.RawMessage  `json:"executionPayload"`
+	BlockValue            string           `json:"blockValue"`
+	BlobsBundle           *blobsBundleJSON `json:"blobsBundle,omitempty"`
+	ShouldOverrideBuilder bool             `json:"shouldOverrideBuilder,omitempty"`
+	ExecutionRequests     *[]string        `json:"executionRequests,omitempty"`
+}
+
+// blobsBundleJSON is the Engine API representation of a blobs bundle.
+type blobsBundleJSON struct {
+	Commitments []string `json:"commitments"`
+	Proofs      []string `json:"proofs"`
+	Blobs       []string `json:"blobs"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b *BuiltPayload) MarshalJSON() ([]byte, error) {
+	if b.ExecutionPayload == nil {
+		return nil, errors.New("no execution payload")
+	}
+
+	executionPayload, err := MarshalExecutionPayload(b.ExecutionPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if b.ExecutionPayload.Version == spec.DataVersionBellatrix {
+		// engine_getPayloadV1 returns the bare execution payload.
+		return executionPayload, nil
+	}
+
+	if b.BlockValue == nil {
+		return nil, errors.New("no block value")
+	}
+
+	data := &builtPayloadJSON{
+		ExecutionPayload:      executionPayload,
+		BlockValue:            encodeBigQuantity(b.BlockValue),
+		ShouldOverrideBuilder: b.ShouldOverrideBuilder,
+	}
+
+	if b.BlobsBundle != nil {
+		data.BlobsBundle = packBlobsBundle(b.BlobsBundle)
+	}
+
+	if b.ExecutionRequests != nil {
+		executionRequests, err := packExecutionRequests(b.ExecutionRequests)
+		if err != nil {
+			return nil, err
+		}
+
+		data.ExecutionRequests = &executionRequests
+	}
+
+	return json.Marshal(data)
+}
+
+// UnmarshalBuiltPayload decodes a built payload from its Engine API form.
+// The version is required as the Engine API encoding does not carry it.
+func UnmarshalBuiltPayload(version spec.DataVersion, input []byte) (*BuiltPayload, error) {
+	if version == spec.DataVersionBellatrix {
+		executionPayload, err := UnmarshalExecutionPayload(version, input)
+		if err != nil {
+			return nil, err
+		}
+
+		return &BuiltPayload{
+			ExecutionPayload: executionPayload,
+		}, nil
+	}
+
+	var data builtPayloadJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return nil, errors.Wrap(err, "invalid JSON")
+	}
+
+	if len(data.ExecutionPayload) == 0 {
+		return nil, errors.New("execution payload missing")
+	}
+
+	executionPayload, err := UnmarshalExecutionPayload(version, data.ExecutionPayload)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid value for execution payload")
+	}
+
+	res := &BuiltPayload{
+		ExecutionPayload:      executionPayload,
+		ShouldOverrideBuilder: data.ShouldOverrideBuilder,
+	}
+
+	if data.BlockValue == "" {
+		return nil, errors.New("block value missing")
+	}
+
+	if res.BlockValue, err = decodeBigQuantity(data.BlockValue); err != nil {
+		return nil, errors.Wrap(err, "invalid value for block value")
+	}
+
+	if version >= spec.DataVersionDeneb {
+		if data.BlobsBundle == nil {
+			return nil, errors.New("blobs bundle missing")
+		}
+
+		if res.BlobsBundle, err = unpackBlobsBundle(data.BlobsBundle); err != nil {
+			return nil, err
+		}
+	}
+
+	if version >= spec.DataVersionElectra {
+		if data.ExecutionRequests == nil {
+			return nil, errors.New("execution requests missing")
+		}
+
+		if res.ExecutionRequests, err = unpackExecutionRequests(*data.ExecutionRequests); err != nil {
+			return nil, errors.Wrap(err, "invalid value for execution requests")
+		}
+	}
+
+	return res, nil
+}
+
+// String returns a string version of the structure.
+func (b *BuiltPayload) String() string {
+	data, err := json.Marshal(b)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
+
+func packBlobsBundle(bundle *apiv1deneb.BlobsBundle) *blobsBundleJSON {
+	res := &blobsBundleJSON{
+		Commitments: make([]string, len(bundle.Commitments)),
+		Proofs:      make([]string, len(bundle.Proofs)),
+		Blobs:       make([]string, len(bundle.Blobs)),
+	}
+	for i := range bundle.Commitments {
+		res.Commitments[i] = encodeData(bundle.Commitments[i][:])
+	}
+	for i := range bundle.Proofs {
+		res.Proofs[i] = encodeData(bundle.Proofs[i][:])
+	}
+	for i := range bundle.Blobs {
+		res.Blobs[i] = encodeData(bundle.Blobs[i][:])
+	}
+
+	return res
+}
+
+func unpackBlobsBundle(data *blobsBundleJSON) (*apiv1deneb.BlobsBundle, error) {
+	res := &apiv1deneb.BlobsBundle{
+		Commitments: make([]deneb.KZGCommitment, len(data.Commitments)),
+		Proofs:      make([]deneb.KZGProof, len(data.Proofs)),
+		Blobs:       make([]deneb.Blob, len(data.Blobs)),
+	}
+	for i := range data.Commitments {
+		if err := decodeFixedData(data.Commitments[i], res.Commitments[i][:]); err != nil {
+			return nil, errors.Wrapf(err, "invalid value for blobs bundle commitment %d", i)
+		}
+	}
+	for i := range data.Proofs {
+		if err := decodeFixedData(data.Proofs[i], res.Proofs[i][:]); err != nil {
+			return nil, errors.Wrapf(err, "invalid value for blobs bundle proof %d", i)
+		}
+	}
+	for i := range data.Blobs {
+		if err := decodeFixedData(data.Blobs[i], res.Blobs[i][:]); err != nil {
+			return nil, errors.Wrapf(err, "invalid value for blobs bundle blob %d", i)
+		}
+	}
+
+	return res, nil
+}
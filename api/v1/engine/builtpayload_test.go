@@ -0,0 +1,121 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/api/v1/engine"
+	"github.com/attestantio/go-eth2-client/spec"
+	consensusdeneb "github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestBuiltPayloadRoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload *engine.BuiltPayload
+	}{
+		{
+			name: "Bellatrix",
+			payload: &engine.BuiltPayload{
+				ExecutionPayload: testExecutionPayload(spec.DataVersionBellatrix),
+			},
+		},
+		{
+			name: "Capella",
+			payload: &engine.BuiltPayload{
+				ExecutionPayload: testExecutionPayload(spec.DataVersionCapella),
+				BlockValue:       uint256.NewInt(12345),
+			},
+		},
+		{
+			name: "Deneb",
+			payload: &engine.BuiltPayload{
+				ExecutionPayload: testExecutionPayload(spec.DataVersionDeneb),
+				BlockValue:       uint256.NewInt(12345),
+				BlobsBundle: &deneb.BlobsBundle{
+					Commitments: []consensusdeneb.KZGCommitment{{0x01}},
+					Proofs:      []consensusdeneb.KZGProof{{0x02}},
+					Blobs:       []consensusdeneb.Blob{{0x03}},
+				},
+				ShouldOverrideBuilder: true,
+			},
+		},
+		{
+			name: "Electra",
+			payload: &engine.BuiltPayload{
+				ExecutionPayload: testExecutionPayload(spec.DataVersionElectra),
+				BlockValue:       uint256.NewInt(12345),
+				BlobsBundle: &deneb.BlobsBundle{
+					Commitments: []consensusdeneb.KZGCommitment{},
+					Proofs:      []consensusdeneb.KZGProof{},
+					Blobs:       []consensusdeneb.Blob{},
+				},
+				ExecutionRequests: testExecutionRequests(),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, err := json.Marshal(test.payload)
+			require.NoError(t, err)
+
+			res, err := engine.UnmarshalBuiltPayload(test.payload.ExecutionPayload.Version, data)
+			require.NoError(t, err)
+			assert.Equal(t, test.payload, res)
+			assert.Equal(t, string(data), res.String())
+		})
+	}
+}
+
+func TestBuiltPayloadErrors(t *testing.T) {
+	_, err := engine.UnmarshalBuiltPayload(spec.DataVersionCapella, []byte(`[]`))
+	require.ErrorContains(t, err, "invalid JSON")
+
+	_, err = engine.UnmarshalBuiltPayload(spec.DataVersionCapella, []byte(`{}`))
+	require.EqualError(t, err, "execution payload missing")
+
+	capella, err := json.Marshal(&engine.BuiltPayload{
+		ExecutionPayload: testExecutionPayload(spec.DataVersionCapella),
+		BlockValue:       uint256.NewInt(1),
+	})
+	require.NoError(t, err)
+
+	_, err = engine.UnmarshalBuiltPayload(spec.DataVersionCapella, []byte(`{"executionPayload":{}}`))
+	require.ErrorContains(t, err, "invalid value for execution payload")
+
+	res, err := engine.UnmarshalBuiltPayload(spec.DataVersionCapella, capella)
+	require.NoError(t, err)
+	assert.Nil(t, res.BlobsBundle)
+
+	deneb, err := json.Marshal(&engine.BuiltPayload{
+		ExecutionPayload: testExecutionPayload(spec.DataVersionDeneb),
+		BlockValue:       uint256.NewInt(1),
+	})
+	require.NoError(t, err)
+
+	_, err = engine.UnmarshalBuiltPayload(spec.DataVersionDeneb, deneb)
+	require.EqualError(t, err, "blobs bundle missing")
+
+	_, err = json.Marshal(&engine.BuiltPayload{
+		ExecutionPayload: testExecutionPayload(spec.DataVersionCapella),
+	})
+	require.ErrorContains(t, err, "no block value")
+}
@@ -0,0 +1,104 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/holiman/uint256"
+	"github.com/pkg/errors"
+)
+
+// encodeQuantity encodes an unsigned integer as an Engine API quantity.
+func encodeQuantity(input uint64) string {
+	return fmt.Sprintf("%#x", input)
+}
+
+// decodeQuantity decodes an Engine API quantity to an unsigned integer.
+func decodeQuantity(input string) (uint64, error) {
+	if !strings.HasPrefix(input, "0x") {
+		return 0, errors.New("missing 0x prefix")
+	}
+
+	return strconv.ParseUint(input[2:], 16, 64)
+}
+
+// encodeBigQuantity encodes a 256-bit unsigned integer as an Engine API quantity.
+func encodeBigQuantity(input *uint256.Int) string {
+	if input == nil {
+		return "0x0"
+	}
+
+	return input.Hex()
+}
+
+// decodeBigQuantity decodes an Engine API quantity to a 256-bit unsigned integer.
+func decodeBigQuantity(input string) (*uint256.Int, error) {
+	return uint256.FromHex(input)
+}
+
+// encodeData encodes a byte slice as Engine API data.
+func encodeData(input []byte) string {
+	return "0x" + hex.EncodeToString(input)
+}
+
+// decodeData decodes Engine API data to a byte slice.
+func decodeData(input string) ([]byte, error) {
+	if !strings.HasPrefix(input, "0x") {
+		return nil, errors.New("missing 0x prefix")
+	}
+
+	return hex.DecodeString(input[2:])
+}
+
+// decodeFixedData decodes Engine API data in to a fixed-length destination.
+func decodeFixedData(input string, dst []byte) error {
+	data, err := decodeData(input)
+	if err != nil {
+		return err
+	}
+
+	if len(data) != len(dst) {
+		return errors.New("incorrect length")
+	}
+
+	copy(dst, data)
+
+	return nil
+}
+
+// leToUint256 converts a 32-byte little-endian value to a 256-bit unsigned integer.
+func leToUint256(input [32]byte) *uint256.Int {
+	var be [32]byte
+	for i := range 32 {
+		be[i] = input[32-1-i]
+	}
+
+	return new(uint256.Int).SetBytes32(be[:])
+}
+
+// uint256ToLE converts a 256-bit unsigned integer to a 32-byte little-endian value.
+func uint256ToLE(input *uint256.Int) [32]byte {
+	be := input.Bytes32()
+
+	var le [32]byte
+	for i := range 32 {
+		le[i] = be[32-1-i]
+	}
+
+	return le
+}
@@ -0,0 +1,484 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/pkg/errors"
+)
+
+// executionPayloadJSON is the Engine API representation of an execution payload.
+// It covers ExecutionPayloadV1 to ExecutionPayloadV3, with later fields omitted for earlier versions.
+type executionPayloadJSON struct {
+	ParentHash    string             `json:"parentHash"`
+	FeeRecipient  string             `json:"feeRecipient"`
+	StateRoot     string             `json:"stateRoot"`
+	ReceiptsRoot  string             `json:"receiptsRoot"`
+	LogsBloom     string             `json:"logsBloom"`
+	PrevRandao    string             `json:"prevRandao"`
+	BlockNumber   string             `json:"blockNumber"`
+	GasLimit      string             `json:"gasLimit"`
+	GasUsed       string             `json:"gasUsed"`
+	Timestamp     string             `json:"timestamp"`
+	ExtraData     string             `json:"extraData"`
+	BaseFeePerGas string             `json:"baseFeePerGas"`
+	BlockHash     string             `json:"blockHash"`
+	Transactions  []string           `json:"transactions"`
+	Withdrawals   *[]*withdrawalJSON `json:"withdrawals,omitempty"`
+	BlobGasUsed   string             `json:"blobGasUsed,omitempty"`
+	ExcessBlobGas string             `json:"excessBlobGas,omitempty"`
+}
+
+// withdrawalJSON is the Engine API representation of a withdrawal.
+type withdrawalJSON struct {
+	Index          string `json:"index"`
+	ValidatorIndex string `json:"validatorIndex"`
+	Address        string `json:"address"`
+	Amount         string `json:"amount"`
+}
+
+// MarshalExecutionPayload encodes a versioned execution payload in its Engine API form.
+func MarshalExecutionPayload(payload *spec.VersionedExecutionPayload) ([]byte, error) {
+	data, err := packExecutionPayload(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(data)
+}
+
+// UnmarshalExecutionPayload decodes an execution payload from its Engine API form.
+func UnmarshalExecutionPayload(version spec.DataVersion, input []byte) (*spec.VersionedExecutionPayload, error) {
+	var data executionPayloadJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return nil, errors.Wrap(err, "invalid JSON")
+	}
+
+	return unpackExecutionPayload(version, &data)
+}
+
+//nolint:gocyclo
+func packExecutionPayload(payload *spec.VersionedExecutionPayload) (*executionPayloadJSON, error) {
+	if payload == nil || payload.IsEmpty() {
+		return nil, errors.New("no execution payload")
+	}
+
+	switch payload.Version {
+	case spec.DataVersionBellatrix:
+		p := payload.Bellatrix
+		if p == nil {
+			return nil, errors.New("no bellatrix execution payload")
+		}
+
+		return &executionPayloadJSON{
+			ParentHash:    encodeData(p.ParentHash[:]),
+			FeeRecipient:  encodeData(p.FeeRecipient[:]),
+			StateRoot:     encodeData(p.StateRoot[:]),
+			ReceiptsRoot:  encodeData(p.ReceiptsRoot[:]),
+			LogsBloom:     encodeData(p.LogsBloom[:]),
+			PrevRandao:    encodeData(p.PrevRandao[:]),
+			BlockNumber:   encodeQuantity(p.BlockNumber),
+			GasLimit:      encodeQuantity(p.GasLimit),
+			GasUsed:       encodeQuantity(p.GasUsed),
+			Timestamp:     encodeQuantity(p.Timestamp),
+			ExtraData:     encodeData(p.ExtraData),
+			BaseFeePerGas: encodeBigQuantity(leToUint256(p.BaseFeePerGas)),
+			BlockHash:     encodeData(p.BlockHash[:]),
+			Transactions:  packTransactions(p.Transactions),
+		}, nil
+	case spec.DataVersionCapella:
+		p := payload.Capella
+		if p == nil {
+			return nil, errors.New("no capella execution payload")
+		}
+
+		return &executionPayloadJSON{
+			ParentHash:    encodeData(p.ParentHash[:]),
+			FeeRecipient:  encodeData(p.FeeRecipient[:]),
+			StateRoot:     encodeData(p.StateRoot[:]),
+			ReceiptsRoot:  encodeData(p.ReceiptsRoot[:]),
+			LogsBloom:     encodeData(p.LogsBloom[:]),
+			PrevRandao:    encodeData(p.PrevRandao[:]),
+			BlockNumber:   encodeQuantity(p.BlockNumber),
+			GasLimit:      encodeQuantity(p.GasLimit),
+			GasUsed:       encodeQuantity(p.GasUsed),
+			Timestamp:     encodeQuantity(p.Timestamp),
+			ExtraData:     encodeData(p.ExtraData),
+			BaseFeePerGas: encodeBigQuantity(leToUint256(p.BaseFeePerGas)),
+			BlockHash:     encodeData(p.BlockHash[:]),
+			Transactions:  packTransactions(p.Transactions),
+			Withdrawals:   packWithdrawals(p.Withdrawals),
+		}, nil
+	case spec.DataVersionDeneb, spec.DataVersionElectra, spec.DataVersionFulu:
+		var p *deneb.ExecutionPayload
+		switch payload.Version {
+		case spec.DataVersionDeneb:
+			p = payload.Deneb
+		case spec.DataVersionElectra:
+			p = payload.Electra
+		default:
+			p = payload.Fulu
+		}
+
+		if p == nil {
+			return nil, fmt.Errorf("no %s execution payload", payload.Version)
+		}
+
+		return &executionPayloadJSON{
+			ParentHash:    encodeData(p.ParentHash[:]),
+			FeeRecipient:  encodeData(p.FeeRecipient[:]),
+			StateRoot:     encodeData(p.StateRoot[:]),
+			ReceiptsRoot:  encodeData(p.ReceiptsRoot[:]),
+			LogsBloom:     encodeData(p.LogsBloom[:]),
+			PrevRandao:    encodeData(p.PrevRandao[:]),
+			BlockNumber:   encodeQuantity(p.BlockNumber),
+			GasLimit:      encodeQuantity(p.GasLimit),
+			GasUsed:       encodeQuantity(p.GasUsed),
+			Timestamp:     encodeQuantity(p.Timestamp),
+			ExtraData:     encodeData(p.ExtraData),
+			BaseFeePerGas: encodeBigQuantity(p.BaseFeePerGas),
+			BlockHash:     encodeData(p.BlockHash[:]),
+			Transactions:  packTransactions(p.Transactions),
+			Withdrawals:   packWithdrawals(p.Withdrawals),
+			BlobGasUsed:   encodeQuantity(p.BlobGasUsed),
+			ExcessBlobGas: encodeQuantity(p.ExcessBlobGas),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported execution payload version %s", payload.Version)
+	}
+}
+
+func packTransactions(transactions []bellatrix.Transaction) []string {
+	res := make([]string, len(transactions))
+	for i := range transactions {
+		res[i] = encodeData(transactions[i])
+	}
+
+	return res
+}
+
+// packWithdrawals returns a pointer so that an empty list is still encoded.
+func packWithdrawals(withdrawals []*capella.Withdrawal) *[]*withdrawalJSON {
+	res := make([]*withdrawalJSON, len(withdrawals))
+	for i, withdrawal := range withdrawals {
+		res[i] = &withdrawalJSON{
+			Index:          encodeQuantity(uint64(withdrawal.Index)),
+			ValidatorIndex: encodeQuantity(uint64(withdrawal.ValidatorIndex)),
+			Address:        encodeData(withdrawal.Address[:]),
+			Amount:         encodeQuantity(uint64(withdrawal.Amount)),
+		}
+	}
+
+	return &res
+}
+
+// executionPayloadFields are the fields common to all execution payload versions.
+type executionPayloadFields struct {
+	parentHash    phase0.Hash32
+	feeRecipient  bellatrix.ExecutionAddress
+	stateRoot     [32]byte
+	receiptsRoot  [32]byte
+	logsBloom     [256]byte
+	prevRandao    [32]byte
+	blockNumber   uint64
+	gasLimit      uint64
+	gasUsed       uint64
+	timestamp     uint64
+	extraData     []byte
+	baseFeePerGas *uint256.Int
+	blockHash     phase0.Hash32
+	transactions  []bellatrix.Transaction
+}
+
+//nolint:gocyclo
+func unpackExecutionPayloadFields(data *executionPayloadJSON) (*executionPayloadFields, error) {
+	var err error
+
+	res := &executionPayloadFields{}
+
+	if data.ParentHash == "" {
+		return nil, errors.New("parent hash missing")
+	}
+
+	if err := decodeFixedData(data.ParentHash, res.parentHash[:]); err != nil {
+		return nil, errors.Wrap(err, "invalid value for parent hash")
+	}
+
+	if data.FeeRecipient == "" {
+		return nil, errors.New("fee recipient missing")
+	}
+
+	if err := decodeFixedData(data.FeeRecipient, res.feeRecipient[:]); err != nil {
+		return nil, errors.Wrap(err, "invalid value for fee recipient")
+	}
+
+	if data.StateRoot == "" {
+		return nil, errors.New("state root missing")
+	}
+
+	if err := decodeFixedData(data.StateRoot, res.stateRoot[:]); err != nil {
+		return nil, errors.Wrap(err, "invalid value for state root")
+	}
+
+	if data.ReceiptsRoot == "" {
+		return nil, errors.New("receipts root missing")
+	}
+
+	if err := decodeFixedData(data.ReceiptsRoot, res.receiptsRoot[:]); err != nil {
+		return nil, errors.Wrap(err, "invalid value for receipts root")
+	}
+
+	if data.LogsBloom == "" {
+		return nil, errors.New("logs bloom missing")
+	}
+
+	if err := decodeFixedData(data.LogsBloom, res.logsBloom[:]); err != nil {
+		return nil, errors.Wrap(err, "invalid value for logs bloom")
+	}
+
+	if data.PrevRandao == "" {
+		return nil, errors.New("prev randao missing")
+	}
+
+	if err := decodeFixedData(data.PrevRandao, res.prevRandao[:]); err != nil {
+		return nil, errors.Wrap(err, "invalid value for prev randao")
+	}
+
+	if data.BlockNumber == "" {
+		return nil, errors.New("block number missing")
+	}
+
+	if res.blockNumber, err = decodeQuantity(data.BlockNumber); err != nil {
+		return nil, errors.Wrap(err, "invalid value for block number")
+	}
+
+	if data.GasLimit == "" {
+		return nil, errors.New("gas limit missing")
+	}
+
+	if res.gasLimit, err = decodeQuantity(data.GasLimit); err != nil {
+		return nil, errors.Wrap(err, "invalid value for gas limit")
+	}
+
+	if data.GasUsed == "" {
+		return nil, errors.New("gas used missing")
+	}
+
+	if res.gasUsed, err = decodeQuantity(data.GasUsed); err != nil {
+		return nil, errors.Wrap(err, "invalid value for gas used")
+	}
+
+	if data.Timestamp == "" {
+		return nil, errors.New("timestamp missing")
+	}
+
+	if res.timestamp, err = decodeQuantity(data.Timestamp); err != nil {
+		return nil, errors.Wrap(err, "invalid value for timestamp")
+	}
+
+	if data.ExtraData == "" {
+		return nil, errors.New("extra data missing")
+	}
+
+	if res.extraData, err = decodeData(data.ExtraData); err != nil {
+		return nil, errors.Wrap(err, "invalid value for extra data")
+	}
+
+	if data.BaseFeePerGas == "" {
+		return nil, errors.New("base fee per gas missing")
+	}
+
+	if res.baseFeePerGas, err = decodeBigQuantity(data.BaseFeePerGas); err != nil {
+		return nil, errors.Wrap(err, "invalid value for base fee per gas")
+	}
+
+	if data.BlockHash == "" {
+		return nil, errors.New("block hash missing")
+	}
+
+	if err := decodeFixedData(data.BlockHash, res.blockHash[:]); err != nil {
+		return nil, errors.Wrap(err, "invalid value for block hash")
+	}
+
+	if data.Transactions == nil {
+		return nil, errors.New("transactions missing")
+	}
+
+	res.transactions = make([]bellatrix.Transaction, len(data.Transactions))
+	for i := range data.Transactions {
+		if res.transactions[i], err = decodeData(data.Transactions[i]); err != nil {
+			return nil, errors.Wrapf(err, "invalid value for transaction %d", i)
+		}
+	}
+
+	return res, nil
+}
+
+func unpackWithdrawals(data *[]*withdrawalJSON) ([]*capella.Withdrawal, error) {
+	if data == nil {
+		return nil, errors.New("withdrawals missing")
+	}
+
+	res := make([]*capella.Withdrawal, len(*data))
+	for i, withdrawal := range *data {
+		if withdrawal == nil {
+			return nil, fmt.Errorf("withdrawal %d missing", i)
+		}
+
+		index, err := decodeQuantity(withdrawal.Index)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid value for withdrawal %d index", i)
+		}
+
+		validatorIndex, err := decodeQuantity(withdrawal.ValidatorIndex)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid value for withdrawal %d validator index", i)
+		}
+
+		amount, err := decodeQuantity(withdrawal.Amount)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid value for withdrawal %d amount", i)
+		}
+
+		res[i] = &capella.Withdrawal{
+			Index:          capella.WithdrawalIndex(index),
+			ValidatorIndex: phase0.ValidatorIndex(validatorIndex),
+			Amount:         phase0.Gwei(amount),
+		}
+
+		if err := decodeFixedData(withdrawal.Address, res[i].Address[:]); err != nil {
+			return nil, errors.Wrapf(err, "invalid value for withdrawal %d address", i)
+		}
+	}
+
+	return res, nil
+}
+
+func unpackExecutionPayload(version spec.DataVersion, data *executionPayloadJSON) (*spec.VersionedExecutionPayload, error) {
+	fields, err := unpackExecutionPayloadFields(data)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &spec.VersionedExecutionPayload{
+		Version: version,
+	}
+
+	switch version {
+	case spec.DataVersionBellatrix:
+		res.Bellatrix = &bellatrix.ExecutionPayload{
+			ParentHash:    fields.parentHash,
+			FeeRecipient:  fields.feeRecipient,
+			StateRoot:     fields.stateRoot,
+			ReceiptsRoot:  fields.receiptsRoot,
+			LogsBloom:     fields.logsBloom,
+			PrevRandao:    fields.prevRandao,
+			BlockNumber:   fields.blockNumber,
+			GasLimit:      fields.gasLimit,
+			GasUsed:       fields.gasUsed,
+			Timestamp:     fields.timestamp,
+			ExtraData:     fields.extraData,
+			BaseFeePerGas: uint256ToLE(fields.baseFeePerGas),
+			BlockHash:     fields.blockHash,
+			Transactions:  fields.transactions,
+		}
+	case spec.DataVersionCapella:
+		withdrawals, err := unpackWithdrawals(data.Withdrawals)
+		if err != nil {
+			return nil, err
+		}
+
+		res.Capella = &capella.ExecutionPayload{
+			ParentHash:    fields.parentHash,
+			FeeRecipient:  fields.feeRecipient,
+			StateRoot:     fields.stateRoot,
+			ReceiptsRoot:  fields.receiptsRoot,
+			LogsBloom:     fields.logsBloom,
+			PrevRandao:    fields.prevRandao,
+			BlockNumber:   fields.blockNumber,
+			GasLimit:      fields.gasLimit,
+			GasUsed:       fields.gasUsed,
+			Timestamp:     fields.timestamp,
+			ExtraData:     fields.extraData,
+			BaseFeePerGas: uint256ToLE(fields.baseFeePerGas),
+			BlockHash:     fields.blockHash,
+			Transactions:  fields.transactions,
+			Withdrawals:   withdrawals,
+		}
+	case spec.DataVersionDeneb, spec.DataVersionElectra, spec.DataVersionFulu:
+		withdrawals, err := unpackWithdrawals(data.Withdrawals)
+		if err != nil {
+			return nil, err
+		}
+
+		if data.BlobGasUsed == "" {
+			return nil, errors.New("blob gas used missing")
+		}
+
+		blobGasUsed, err := decodeQuantity(data.BlobGasUsed)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid value for blob gas used")
+		}
+
+		if data.ExcessBlobGas == "" {
+			return nil, errors.New("excess blob gas missing")
+		}
+
+		excessBlobGas, err := decodeQuantity(data.ExcessBlobGas)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid value for excess blob gas")
+		}
+
+		payload := &deneb.ExecutionPayload{
+			ParentHash:    fields.parentHash,
+			FeeRecipient:  fields.feeRecipient,
+			StateRoot:     fields.stateRoot,
+			ReceiptsRoot:  fields.receiptsRoot,
+			LogsBloom:     fields.logsBloom,
+			PrevRandao:    fields.prevRandao,
+			BlockNumber:   fields.blockNumber,
+			GasLimit:      fields.gasLimit,
+			GasUsed:       fields.gasUsed,
+			Timestamp:     fields.timestamp,
+			ExtraData:     fields.extraData,
+			BaseFeePerGas: fields.baseFeePerGas,
+			BlockHash:     fields.blockHash,
+			Transactions:  fields.transactions,
+			Withdrawals:   withdrawals,
+			BlobGasUsed:   blobGasUsed,
+			ExcessBlobGas: excessBlobGas,
+		}
+
+		switch version {
+		case spec.DataVersionDeneb:
+			res.Deneb = payload
+		case spec.DataVersionElectra:
+			res.Electra = payload
+		default:
+			res.Fulu = payload
+		}
+	default:
+		return nil, fmt.Errorf("unsupported execution payload version %s", version)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,166 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api/v1/engine"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func testWithdrawals() []*capella.Withdrawal {
+	return []*capella.Withdrawal{
+		{
+			Index:          1,
+			ValidatorIndex: 2,
+			Address:        bellatrix.ExecutionAddress{0x11},
+			Amount:         3,
+		},
+	}
+}
+
+func testExecutionPayload(version spec.DataVersion) *spec.VersionedExecutionPayload {
+	res := &spec.VersionedExecutionPayload{
+		Version: version,
+	}
+
+	switch version {
+	case spec.DataVersionBellatrix:
+		res.Bellatrix = &bellatrix.ExecutionPayload{
+			ParentHash:    phase0.Hash32{0x01},
+			FeeRecipient:  bellatrix.ExecutionAddress{0x02},
+			StateRoot:     [32]byte{0x03},
+			ReceiptsRoot:  [32]byte{0x04},
+			PrevRandao:    [32]byte{0x05},
+			BlockNumber:   6,
+			GasLimit:      30000000,
+			GasUsed:       21000,
+			Timestamp:     1700000000,
+			ExtraData:     []byte{},
+			BaseFeePerGas: [32]byte{0x07, 0x01},
+			BlockHash:     phase0.Hash32{0x08},
+			Transactions:  []bellatrix.Transaction{{0x09, 0x0a}},
+		}
+	case spec.DataVersionCapella:
+		res.Capella = &capella.ExecutionPayload{
+			ParentHash:    phase0.Hash32{0x01},
+			FeeRecipient:  bellatrix.ExecutionAddress{0x02},
+			StateRoot:     [32]byte{0x03},
+			ReceiptsRoot:  [32]byte{0x04},
+			PrevRandao:    [32]byte{0x05},
+			BlockNumber:   6,
+			GasLimit:      30000000,
+			GasUsed:       21000,
+			Timestamp:     1700000000,
+			ExtraData:     []byte{0x0b},
+			BaseFeePerGas: [32]byte{0x07},
+			BlockHash:     phase0.Hash32{0x08},
+			Transactions:  []bellatrix.Transaction{},
+			Withdrawals:   testWithdrawals(),
+		}
+	default:
+		payload := &deneb.ExecutionPayload{
+			ParentHash:    phase0.Hash32{0x01},
+			FeeRecipient:  bellatrix.ExecutionAddress{0x02},
+			StateRoot:     [32]byte{0x03},
+			ReceiptsRoot:  [32]byte{0x04},
+			PrevRandao:    [32]byte{0x05},
+			BlockNumber:   6,
+			GasLimit:      30000000,
+			GasUsed:       21000,
+			Timestamp:     1700000000,
+			ExtraData:     []byte{0x0b},
+			BaseFeePerGas: uint256.NewInt(7),
+			BlockHash:     phase0.Hash32{0x08},
+			Transactions:  []bellatrix.Transaction{{0x09}},
+			Withdrawals:   []*capella.Withdrawal{},
+			BlobGasUsed:   131072,
+			ExcessBlobGas: 0,
+		}
+		switch version {
+		case spec.DataVersionDeneb:
+			res.Deneb = payload
+		case spec.DataVersionElectra:
+			res.Electra = payload
+		default:
+			res.Fulu = payload
+		}
+	}
+
+	return res
+}
+
+func TestExecutionPayloadRoundTrip(t *testing.T) {
+	for _, version := range []spec.DataVersion{
+		spec.DataVersionBellatrix,
+		spec.DataVersionCapella,
+		spec.DataVersionDeneb,
+		spec.DataVersionElectra,
+		spec.DataVersionFulu,
+	} {
+		t.Run(version.String(), func(t *testing.T) {
+			payload := testExecutionPayload(version)
+			data, err := engine.MarshalExecutionPayload(payload)
+			require.NoError(t, err)
+
+			res, err := engine.UnmarshalExecutionPayload(version, data)
+			require.NoError(t, err)
+			assert.Equal(t, payload, res)
+		})
+	}
+}
+
+func TestExecutionPayloadEncoding(t *testing.T) {
+	data, err := engine.MarshalExecutionPayload(testExecutionPayload(spec.DataVersionBellatrix))
+	require.NoError(t, err)
+	// Base fee per gas is little-endian in the consensus type and a quantity in the Engine API.
+	assert.Contains(t, string(data), `"baseFeePerGas":"0x107"`)
+	assert.Contains(t, string(data), `"extraData":"0x"`)
+	assert.Contains(t, string(data), `"transactions":["0x090a"]`)
+	assert.NotContains(t, string(data), `"withdrawals"`)
+
+	data, err = engine.MarshalExecutionPayload(testExecutionPayload(spec.DataVersionDeneb))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"withdrawals":[]`)
+	assert.Contains(t, string(data), `"blobGasUsed":"0x20000"`)
+	assert.Contains(t, string(data), `"excessBlobGas":"0x0"`)
+}
+
+func TestExecutionPayloadErrors(t *testing.T) {
+	_, err := engine.MarshalExecutionPayload(nil)
+	require.EqualError(t, err, "no execution payload")
+
+	_, err = engine.UnmarshalExecutionPayload(spec.DataVersionBellatrix, []byte(`[]`))
+	require.ErrorContains(t, err, "invalid JSON")
+
+	bellatrixData, err := engine.MarshalExecutionPayload(testExecutionPayload(spec.DataVersionBellatrix))
+	require.NoError(t, err)
+
+	_, err = engine.UnmarshalExecutionPayload(spec.DataVersionCapella, bellatrixData)
+	require.EqualError(t, err, "withdrawals missing")
+
+	capellaData, err := engine.MarshalExecutionPayload(testExecutionPayload(spec.DataVersionCapella))
+	require.NoError(t, err)
+
+	_, err = engine.UnmarshalExecutionPayload(spec.DataVersionDeneb, capellaData)
+	require.EqualError(t, err, "blob gas used missing")
+}
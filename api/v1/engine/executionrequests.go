@@ -0,0 +1,183 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/pkg/errors"
+)
+
+const (
+	depositRequestType       = 0x00
+	withdrawalRequestType    = 0x01
+	consolidationRequestType = 0x02
+
+	depositRequestLength       = 192
+	withdrawalRequestLength    = 76
+	consolidationRequestLength = 116
+)
+
+// MarshalExecutionRequests encodes execution requests as the EIP-7685 list of typed requests.
+// Each element is the request type followed by the SSZ encoding of the requests of that type;
+// types with no requests are omitted.
+func MarshalExecutionRequests(requests *electra.ExecutionRequests) ([][]byte, error) {
+	res := make([][]byte, 0, 3)
+	if requests == nil {
+		return res, nil
+	}
+
+	if len(requests.Deposits) > 0 {
+		data := []byte{depositRequestType}
+		for i, request := range requests.Deposits {
+			encoded, err := request.MarshalSSZ()
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to encode deposit request %d", i)
+			}
+
+			data = append(data, encoded...)
+		}
+
+		res = append(res, data)
+	}
+
+	if len(requests.Withdrawals) > 0 {
+		data := []byte{withdrawalRequestType}
+		for i, request := range requests.Withdrawals {
+			encoded, err := request.MarshalSSZ()
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to encode withdrawal request %d", i)
+			}
+
+			data = append(data, encoded...)
+		}
+
+		res = append(res, data)
+	}
+
+	if len(requests.Consolidations) > 0 {
+		data := []byte{consolidationRequestType}
+		for i, request := range requests.Consolidations {
+			encoded, err := request.MarshalSSZ()
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to encode consolidation request %d", i)
+			}
+
+			data = append(data, encoded...)
+		}
+
+		res = append(res, data)
+	}
+
+	return res, nil
+}
+
+// UnmarshalExecutionRequests decodes execution requests from the EIP-7685 list of typed requests.
+//
+//nolint:gocyclo
+func UnmarshalExecutionRequests(input [][]byte) (*electra.ExecutionRequests, error) {
+	res := &electra.ExecutionRequests{
+		Deposits:       make([]*electra.DepositRequest, 0),
+		Withdrawals:    make([]*electra.WithdrawalRequest, 0),
+		Consolidations: make([]*electra.ConsolidationRequest, 0),
+	}
+
+	lastType := -1
+	for i, data := range input {
+		if len(data) < 2 {
+			return nil, fmt.Errorf("execution request %d too short", i)
+		}
+
+		requestType := int(data[0])
+		if requestType <= lastType {
+			return nil, fmt.Errorf("execution request %d out of order", i)
+		}
+
+		lastType = requestType
+		data = data[1:]
+
+		switch requestType {
+		case depositRequestType:
+			if len(data)%depositRequestLength != 0 {
+				return nil, errors.New("invalid length for deposit requests")
+			}
+
+			for offset := 0; offset < len(data); offset += depositRequestLength {
+				request := &electra.DepositRequest{}
+				if err := request.UnmarshalSSZ(data[offset : offset+depositRequestLength]); err != nil {
+					return nil, errors.Wrap(err, "invalid value for deposit request")
+				}
+
+				res.Deposits = append(res.Deposits, request)
+			}
+		case withdrawalRequestType:
+			if len(data)%withdrawalRequestLength != 0 {
+				return nil, errors.New("invalid length for withdrawal requests")
+			}
+
+			for offset := 0; offset < len(data); offset += withdrawalRequestLength {
+				request := &electra.WithdrawalRequest{}
+				if err := request.UnmarshalSSZ(data[offset : offset+withdrawalRequestLength]); err != nil {
+					return nil, errors.Wrap(err, "invalid value for withdrawal request")
+				}
+
+				res.Withdrawals = append(res.Withdrawals, request)
+			}
+		case consolidationRequestType:
+			if len(data)%consolidationRequestLength != 0 {
+				return nil, errors.New("invalid length for consolidation requests")
+			}
+
+			for offset := 0; offset < len(data); offset += consolidationRequestLength {
+				request := &electra.ConsolidationRequest{}
+				if err := request.UnmarshalSSZ(data[offset : offset+consolidationRequestLength]); err != nil {
+					return nil, errors.Wrap(err, "invalid value for consolidation request")
+				}
+
+				res.Consolidations = append(res.Consolidations, request)
+			}
+		default:
+			return nil, fmt.Errorf("unknown execution request type %d", requestType)
+		}
+	}
+
+	return res, nil
+}
+
+func packExecutionRequests(requests *electra.ExecutionRequests) ([]string, error) {
+	encoded, err := MarshalExecutionRequests(requests)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]string, len(encoded))
+	for i := range encoded {
+		res[i] = encodeData(encoded[i])
+	}
+
+	return res, nil
+}
+
+func unpackExecutionRequests(input []string) (*electra.ExecutionRequests, error) {
+	encoded := make([][]byte, len(input))
+	for i := range input {
+		var err error
+		if encoded[i], err = decodeData(input[i]); err != nil {
+			return nil, errors.Wrapf(err, "invalid value for execution request %d", i)
+		}
+	}
+
+	return UnmarshalExecutionRequests(encoded)
+}
@@ -0,0 +1,118 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api/v1/engine"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func testExecutionRequests() *electra.ExecutionRequests {
+	return &electra.ExecutionRequests{
+		Deposits: []*electra.DepositRequest{
+			{
+				Pubkey:                phase0.BLSPubKey{0x01},
+				WithdrawalCredentials: make([]byte, 32),
+				Amount:                32000000000,
+				Signature:             phase0.BLSSignature{0x02},
+				Index:                 3,
+			},
+		},
+		Withdrawals: []*electra.WithdrawalRequest{},
+		Consolidations: []*electra.ConsolidationRequest{
+			{
+				SourceAddress: bellatrix.ExecutionAddress{0x04},
+				SourcePubkey:  phase0.BLSPubKey{0x05},
+				TargetPubkey:  phase0.BLSPubKey{0x06},
+			},
+		},
+	}
+}
+
+func TestExecutionRequestsRoundTrip(t *testing.T) {
+	requests := testExecutionRequests()
+
+	data, err := engine.MarshalExecutionRequests(requests)
+	require.NoError(t, err)
+	// Withdrawal requests are empty so are omitted.
+	require.Len(t, data, 2)
+	assert.Equal(t, byte(0x00), data[0][0])
+	assert.Len(t, data[0], 1+192)
+	assert.Equal(t, byte(0x02), data[1][0])
+	assert.Len(t, data[1], 1+116)
+
+	res, err := engine.UnmarshalExecutionRequests(data)
+	require.NoError(t, err)
+	assert.Equal(t, requests, res)
+}
+
+func TestExecutionRequestsErrors(t *testing.T) {
+	encoded, err := engine.MarshalExecutionRequests(testExecutionRequests())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		input [][]byte
+		err   string
+	}{
+		{
+			name:  "TooShort",
+			input: [][]byte{{0x00}},
+			err:   "execution request 0 too short",
+		},
+		{
+			name:  "OutOfOrder",
+			input: [][]byte{encoded[1], encoded[0]},
+			err:   "execution request 1 out of order",
+		},
+		{
+			name:  "Duplicate",
+			input: [][]byte{encoded[1], encoded[1]},
+			err:   "execution request 1 out of order",
+		},
+		{
+			name:  "UnknownType",
+			input: [][]byte{{0x03, 0x00}},
+			err:   "unknown execution request type 3",
+		},
+		{
+			name:  "DepositLength",
+			input: [][]byte{{0x00, 0x00}},
+			err:   "invalid length for deposit requests",
+		},
+		{
+			name:  "WithdrawalLength",
+			input: [][]byte{{0x01, 0x00}},
+			err:   "invalid length for withdrawal requests",
+		},
+		{
+			name:  "ConsolidationLength",
+			input: [][]byte{{0x02, 0x00}},
+			err:   "invalid length for consolidation requests",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := engine.UnmarshalExecutionRequests(test.input)
+			require.EqualError(t, err, test.err)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api/v1/engine"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestForkchoiceStateJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type engine.forkchoiceStateJSON",
+		},
+		{
+			name:  "HeadBlockHashMissing",
+			input: []byte(`{"safeBlockHash":"0x0202020202020202020202020202020202020202020202020202020202020202","finalizedBlockHash":"0x0303030303030303030303030303030303030303030303030303030303030303"}`),
+			err:   "head block hash missing",
+		},
+		{
+			name:  "HeadBlockHashInvalid",
+			input: []byte(`{"headBlockHash":"invalid","safeBlockHash":"0x0202020202020202020202020202020202020202020202020202020202020202","finalizedBlockHash":"0x0303030303030303030303030303030303030303030303030303030303030303"}`),
+			err:   "invalid value for head block hash: missing 0x prefix",
+		},
+		{
+			name:  "SafeBlockHashMissing",
+			input: []byte(`{"headBlockHash":"0x0101010101010101010101010101010101010101010101010101010101010101","finalizedBlockHash":"0x0303030303030303030303030303030303030303030303030303030303030303"}`),
+			err:   "safe block hash missing",
+		},
+		{
+			name:  "SafeBlockHashInvalid",
+			input: []byte(`{"headBlockHash":"0x0101010101010101010101010101010101010101010101010101010101010101","safeBlockHash":"invalid","finalizedBlockHash":"0x0303030303030303030303030303030303030303030303030303030303030303"}`),
+			err:   "invalid value for safe block hash: missing 0x prefix",
+		},
+		{
+			name:  "FinalizedBlockHashMissing",
+			input: []byte(`{"headBlockHash":"0x0101010101010101010101010101010101010101010101010101010101010101","safeBlockHash":"0x0202020202020202020202020202020202020202020202020202020202020202"}`),
+			err:   "finalized block hash missing",
+		},
+		{
+			name:  "FinalizedBlockHashInvalid",
+			input: []byte(`{"headBlockHash":"0x0101010101010101010101010101010101010101010101010101010101010101","safeBlockHash":"0x0202020202020202020202020202020202020202020202020202020202020202","finalizedBlockHash":"invalid"}`),
+			err:   "invalid value for finalized block hash: missing 0x prefix",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"headBlockHash":"0x0101010101010101010101010101010101010101010101010101010101010101","safeBlockHash":"0x0202020202020202020202020202020202020202020202020202020202020202","finalizedBlockHash":"0x0303030303030303030303030303030303030303030303030303030303030303"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res engine.ForkchoiceState
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// PayloadID is the identifier of a payload build process.
+type PayloadID [8]byte
+
+// String returns a string version of the payload ID.
+func (p PayloadID) String() string {
+	return encodeData(p[:])
+}
+
+// ForkchoiceUpdatedResult is the result of a fork choice update.
+type ForkchoiceUpdatedResult struct {
+	PayloadStatus *PayloadStatus
+	PayloadID     *PayloadID
+}
+
+// forkchoiceUpdatedResultJSON is the Engine API representation of the struct.
+type forkchoiceUpdatedResultJSON struct {
+	PayloadStatus *PayloadStatus `json:"payloadStatus"`
+	PayloadID     *string        `json:"payloadId"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f *ForkchoiceUpdatedResult) MarshalJSON() ([]byte, error) {
+	data := &forkchoiceUpdatedResultJSON{
+		PayloadStatus: f.PayloadStatus,
+	}
+
+	if f.PayloadID != nil {
+		payloadID := f.PayloadID.String()
+		data.PayloadID = &payloadID
+	}
+
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (f *ForkchoiceUpdatedResult) UnmarshalJSON(input []byte) error {
+	var data forkchoiceUpdatedResultJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.PayloadStatus == nil {
+		return errors.New("payload status missing")
+	}
+
+	f.PayloadStatus = data.PayloadStatus
+
+	f.PayloadID = nil
+	if data.PayloadID != nil {
+		payloadID := PayloadID{}
+		if err := decodeFixedData(*data.PayloadID, payloadID[:]); err != nil {
+			return errors.Wrap(err, "invalid value for payload ID")
+		}
+
+		f.PayloadID = &payloadID
+	}
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (f *ForkchoiceUpdatedResult) String() string {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,80 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api/v1/engine"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestForkchoiceUpdatedResultJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type engine.forkchoiceUpdatedResultJSON",
+		},
+		{
+			name:  "PayloadStatusMissing",
+			input: []byte(`{"payloadStatus":null,"payloadId":null}`),
+			err:   "payload status missing",
+		},
+		{
+			name:  "PayloadStatusInvalid",
+			input: []byte(`{"payloadStatus":{},"payloadId":null}`),
+			err:   "invalid JSON: status missing",
+		},
+		{
+			name:  "PayloadIDInvalid",
+			input: []byte(`{"payloadStatus":{"status":"VALID","latestValidHash":"0x0101010101010101010101010101010101010101010101010101010101010101","validationError":null},"payloadId":"0x01"}`),
+			err:   "invalid value for payload ID: incorrect length",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"payloadStatus":{"status":"VALID","latestValidHash":"0x0101010101010101010101010101010101010101010101010101010101010101","validationError":null},"payloadId":"0x0102030405060708"}`),
+		},
+		{
+			name:  "GoodNoPayloadID",
+			input: []byte(`{"payloadStatus":{"status":"VALID","latestValidHash":"0x0101010101010101010101010101010101010101010101010101010101010101","validationError":null},"payloadId":null}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res engine.ForkchoiceUpdatedResult
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
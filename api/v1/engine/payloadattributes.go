@@ -0,0 +1,126 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// PayloadAttributes are the attributes used by the execution client to build a payload.
+// Withdrawals are present from PayloadAttributesV2, and the parent beacon block root
+// from PayloadAttributesV3; nil values are omitted when encoding.
+type PayloadAttributes struct {
+	Timestamp             uint64
+	PrevRandao            [32]byte
+	SuggestedFeeRecipient bellatrix.ExecutionAddress
+	Withdrawals           []*capella.Withdrawal
+	ParentBeaconBlockRoot *phase0.Root
+}
+
+// payloadAttributesJSON is the Engine API representation of the struct.
+type payloadAttributesJSON struct {
+	Timestamp             string             `json:"timestamp"`
+	PrevRandao            string             `json:"prevRandao"`
+	SuggestedFeeRecipient string             `json:"suggestedFeeRecipient"`
+	Withdrawals           *[]*withdrawalJSON `json:"withdrawals,omitempty"`
+	ParentBeaconBlockRoot string             `json:"parentBeaconBlockRoot,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p *PayloadAttributes) MarshalJSON() ([]byte, error) {
+	data := &payloadAttributesJSON{
+		Timestamp:             encodeQuantity(p.Timestamp),
+		PrevRandao:            encodeData(p.PrevRandao[:]),
+		SuggestedFeeRecipient: encodeData(p.SuggestedFeeRecipient[:]),
+	}
+
+	if p.Withdrawals != nil {
+		data.Withdrawals = packWithdrawals(p.Withdrawals)
+	}
+
+	if p.ParentBeaconBlockRoot != nil {
+		data.ParentBeaconBlockRoot = encodeData(p.ParentBeaconBlockRoot[:])
+	}
+
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *PayloadAttributes) UnmarshalJSON(input []byte) error {
+	var data payloadAttributesJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	var err error
+
+	if data.Timestamp == "" {
+		return errors.New("timestamp missing")
+	}
+
+	if p.Timestamp, err = decodeQuantity(data.Timestamp); err != nil {
+		return errors.Wrap(err, "invalid value for timestamp")
+	}
+
+	if data.PrevRandao == "" {
+		return errors.New("prev randao missing")
+	}
+
+	if err := decodeFixedData(data.PrevRandao, p.PrevRandao[:]); err != nil {
+		return errors.Wrap(err, "invalid value for prev randao")
+	}
+
+	if data.SuggestedFeeRecipient == "" {
+		return errors.New("suggested fee recipient missing")
+	}
+
+	if err := decodeFixedData(data.SuggestedFeeRecipient, p.SuggestedFeeRecipient[:]); err != nil {
+		return errors.Wrap(err, "invalid value for suggested fee recipient")
+	}
+
+	p.Withdrawals = nil
+	if data.Withdrawals != nil {
+		if p.Withdrawals, err = unpackWithdrawals(data.Withdrawals); err != nil {
+			return err
+		}
+	}
+
+	p.ParentBeaconBlockRoot = nil
+	if data.ParentBeaconBlockRoot != "" {
+		root := phase0.Root{}
+		if err := decodeFixedData(data.ParentBeaconBlockRoot, root[:]); err != nil {
+			return errors.Wrap(err, "invalid value for parent beacon block root")
+		}
+
+		p.ParentBeaconBlockRoot = &root
+	}
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (p *PayloadAttributes) String() string {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,113 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api/v1/engine"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestPayloadAttributesJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type engine.payloadAttributesJSON",
+		},
+		{
+			name:  "TimestampMissing",
+			input: []byte(`{"prevRandao":"0x0101010101010101010101010101010101010101010101010101010101010101","suggestedFeeRecipient":"0x1111111111111111111111111111111111111111"}`),
+			err:   "timestamp missing",
+		},
+		{
+			name:  "TimestampInvalid",
+			input: []byte(`{"timestamp":"0xzz","prevRandao":"0x0101010101010101010101010101010101010101010101010101010101010101","suggestedFeeRecipient":"0x1111111111111111111111111111111111111111"}`),
+			err:   "invalid value for timestamp: strconv.ParseUint: parsing \"zz\": invalid syntax",
+		},
+		{
+			name:  "PrevRandaoMissing",
+			input: []byte(`{"timestamp":"0x64","suggestedFeeRecipient":"0x1111111111111111111111111111111111111111"}`),
+			err:   "prev randao missing",
+		},
+		{
+			name:  "PrevRandaoInvalid",
+			input: []byte(`{"timestamp":"0x64","prevRandao":"0x01","suggestedFeeRecipient":"0x1111111111111111111111111111111111111111"}`),
+			err:   "invalid value for prev randao: incorrect length",
+		},
+		{
+			name:  "SuggestedFeeRecipientMissing",
+			input: []byte(`{"timestamp":"0x64","prevRandao":"0x0101010101010101010101010101010101010101010101010101010101010101"}`),
+			err:   "suggested fee recipient missing",
+		},
+		{
+			name:  "SuggestedFeeRecipientInvalid",
+			input: []byte(`{"timestamp":"0x64","prevRandao":"0x0101010101010101010101010101010101010101010101010101010101010101","suggestedFeeRecipient":"0x01"}`),
+			err:   "invalid value for suggested fee recipient: incorrect length",
+		},
+		{
+			name:  "WithdrawalAmountInvalid",
+			input: []byte(`{"timestamp":"0x64","prevRandao":"0x0101010101010101010101010101010101010101010101010101010101010101","suggestedFeeRecipient":"0x1111111111111111111111111111111111111111","withdrawals":[{"index":"0x1","validatorIndex":"0x2","address":"0x1111111111111111111111111111111111111111","amount":"1"}]}`),
+			err:   "invalid value for withdrawal 0 amount: missing 0x prefix",
+		},
+		{
+			name:  "ParentBeaconBlockRootInvalid",
+			input: []byte(`{"timestamp":"0x64","prevRandao":"0x0101010101010101010101010101010101010101010101010101010101010101","suggestedFeeRecipient":"0x1111111111111111111111111111111111111111","withdrawals":[{"index":"0x1","validatorIndex":"0x2","address":"0x1111111111111111111111111111111111111111","amount":"0x3b9aca00"}],"parentBeaconBlockRoot":"0x01"}`),
+			err:   "invalid value for parent beacon block root: incorrect length",
+		},
+		{
+			name:  "GoodV1",
+			input: []byte(`{"timestamp":"0x64","prevRandao":"0x0101010101010101010101010101010101010101010101010101010101010101","suggestedFeeRecipient":"0x1111111111111111111111111111111111111111"}`),
+		},
+		{
+			name:  "GoodV2",
+			input: []byte(`{"timestamp":"0x64","prevRandao":"0x0101010101010101010101010101010101010101010101010101010101010101","suggestedFeeRecipient":"0x1111111111111111111111111111111111111111","withdrawals":[{"index":"0x1","validatorIndex":"0x2","address":"0x1111111111111111111111111111111111111111","amount":"0x3b9aca00"}]}`),
+		},
+		{
+			name:  "GoodV2EmptyWithdrawals",
+			input: []byte(`{"timestamp":"0x64","prevRandao":"0x0101010101010101010101010101010101010101010101010101010101010101","suggestedFeeRecipient":"0x1111111111111111111111111111111111111111","withdrawals":[]}`),
+		},
+		{
+			name:  "GoodV3",
+			input: []byte(`{"timestamp":"0x64","prevRandao":"0x0101010101010101010101010101010101010101010101010101010101010101","suggestedFeeRecipient":"0x1111111111111111111111111111111111111111","withdrawals":[{"index":"0x1","validatorIndex":"0x2","address":"0x1111111111111111111111111111111111111111","amount":"0x3b9aca00"}],"parentBeaconBlockRoot":"0x0202020202020202020202020202020202020202020202020202020202020202"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res engine.PayloadAttributes
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
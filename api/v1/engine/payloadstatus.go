@@ -0,0 +1,111 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// PayloadStatusValue is the status of a payload as reported by the execution client.
+type PayloadStatusValue string
+
+const (
+	// PayloadStatusValid is returned when the payload is valid.
+	PayloadStatusValid PayloadStatusValue = "VALID"
+	// PayloadStatusInvalid is returned when the payload is invalid.
+	PayloadStatusInvalid PayloadStatusValue = "INVALID"
+	// PayloadStatusSyncing is returned when the execution client is syncing and cannot validate the payload.
+	PayloadStatusSyncing PayloadStatusValue = "SYNCING"
+	// PayloadStatusAccepted is returned when the payload has been accepted but not yet validated.
+	PayloadStatusAccepted PayloadStatusValue = "ACCEPTED"
+	// PayloadStatusInvalidBlockHash is returned when the block hash of the payload is incorrect.
+	PayloadStatusInvalidBlockHash PayloadStatusValue = "INVALID_BLOCK_HASH"
+)
+
+// PayloadStatus is the result of processing a payload.
+type PayloadStatus struct {
+	Status          PayloadStatusValue
+	LatestValidHash *phase0.Hash32
+	ValidationError string
+}
+
+// payloadStatusJSON is the Engine API representation of the struct.
+type payloadStatusJSON struct {
+	Status          string  `json:"status"`
+	LatestValidHash *string `json:"latestValidHash"`
+	ValidationError *string `json:"validationError"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p *PayloadStatus) MarshalJSON() ([]byte, error) {
+	data := &payloadStatusJSON{
+		Status: string(p.Status),
+	}
+
+	if p.LatestValidHash != nil {
+		latestValidHash := encodeData(p.LatestValidHash[:])
+		data.LatestValidHash = &latestValidHash
+	}
+
+	if p.ValidationError != "" {
+		data.ValidationError = &p.ValidationError
+	}
+
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *PayloadStatus) UnmarshalJSON(input []byte) error {
+	var data payloadStatusJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.Status == "" {
+		return errors.New("status missing")
+	}
+
+	p.Status = PayloadStatusValue(data.Status)
+
+	p.LatestValidHash = nil
+	if data.LatestValidHash != nil {
+		latestValidHash := phase0.Hash32{}
+		if err := decodeFixedData(*data.LatestValidHash, latestValidHash[:]); err != nil {
+			return errors.Wrap(err, "invalid value for latest valid hash")
+		}
+
+		p.LatestValidHash = &latestValidHash
+	}
+
+	p.ValidationError = ""
+	if data.ValidationError != nil {
+		p.ValidationError = *data.ValidationError
+	}
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (p *PayloadStatus) String() string {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
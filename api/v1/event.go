@@ -31,25 +31,29 @@ type Event struct {
 	Topic string
 	// Data is the data of the event.
 	Data any
+	// Metadata is metadata about the event, for example if it was synthesized.
+	Metadata map[string]any
 }
 
 // SupportedEventTopics is a map of supported event topics.
 var SupportedEventTopics = map[string]bool{
-	"attestation":             true,
-	"attester_slashing":       true,
-	"blob_sidecar":            true,
-	"block":                   true,
-	"block_gossip":            true,
-	"bls_to_execution_change": true,
-	"chain_reorg":             true,
-	"contribution_and_proof":  true,
-	"data_column_sidecar":     true,
-	"finalized_checkpoint":    true,
-	"head":                    true,
-	"payload_attributes":      true,
-	"proposer_slashing":       true,
-	"single_attestation":      true,
-	"voluntary_exit":          true,
+	"attestation":                    true,
+	"attester_slashing":              true,
+	"blob_sidecar":                   true,
+	"block":                          true,
+	"block_gossip":                   true,
+	"bls_to_execution_change":        true,
+	"chain_reorg":                    true,
+	"contribution_and_proof":         true,
+	"data_column_sidecar":            true,
+	"finalized_checkpoint":           true,
+	"head":                           true,
+	"light_client_finality_update":   true,
+	"light_client_optimistic_update": true,
+	"payload_attributes":             true,
+	"proposer_slashing":              true,
+	"single_attestation":             true,
+	"voluntary_exit":                 true,
 }
 
 // eventJSON is the spec representation of the struct.
@@ -119,6 +123,10 @@ func (e *Event) UnmarshalJSON(input []byte) error {
 		e.Data = &FinalizedCheckpointEvent{}
 	case "head":
 		e.Data = &HeadEvent{}
+	case "light_client_finality_update":
+		e.Data = &spec.VersionedLightClientFinalityUpdate{}
+	case "light_client_optimistic_update":
+		e.Data = &spec.VersionedLightClientOptimisticUpdate{}
 	case "payload_attributes":
 		e.Data = &PayloadAttributesEvent{}
 	case "proposer_slashing":
@@ -0,0 +1,316 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ForkChoiceTree is a navigable tree built from the nodes of a fork choice.
+// Node weights are cumulative, as provided by the beacon node, so the weight of a node includes
+// the weight of all of its descendants.
+type ForkChoiceTree struct {
+	anchor *ForkChoiceTreeNode
+	nodes  map[phase0.Root]*ForkChoiceTreeNode
+}
+
+// ForkChoiceTreeNode is a node in a fork choice tree.
+type ForkChoiceTreeNode struct {
+	// Node is the fork choice node.
+	Node *ForkChoiceNode
+	// Parent is the parent of the node; nil for the anchor of the tree.
+	Parent *ForkChoiceTreeNode
+	// Children are the children of the node, ordered by slot and then root.
+	Children []*ForkChoiceTreeNode
+}
+
+// ForkChoiceBranch is a branch that competes with the canonical chain.
+type ForkChoiceBranch struct {
+	// ForkPoint is the canonical node from which the branch diverges.
+	ForkPoint *ForkChoiceTreeNode
+	// Canonical is the child of the fork point on the canonical chain.
+	Canonical *ForkChoiceTreeNode
+	// Branch is the child of the fork point that starts the competing branch.
+	Branch *ForkChoiceTreeNode
+	// WeightDelta is the weight of the canonical child less the weight of the branch.
+	WeightDelta uint64
+}
+
+// ForkChoiceHeadComparison is the result of comparing two heads of a fork choice tree.
+type ForkChoiceHeadComparison struct {
+	// Winner is the head that fork choice would select.
+	Winner *ForkChoiceTreeNode
+	// Loser is the head that fork choice would not select.
+	Loser *ForkChoiceTreeNode
+	// CommonAncestor is the latest node that is an ancestor of both heads.
+	CommonAncestor *ForkChoiceTreeNode
+	// WeightDelta is the weight of the winning branch less the weight of the losing branch where they diverge.
+	// It is 0 if one head is a descendant of the other, or if the losing branch is invalid and
+	// no lighter than the winning branch.
+	WeightDelta uint64
+}
+
+// NewForkChoiceTree builds a fork choice tree from fork choice nodes.
+// Exactly one node, the anchor, must have a parent that is not present in the nodes.
+func NewForkChoiceTree(nodes []*ForkChoiceNode) (*ForkChoiceTree, error) {
+	if len(nodes) == 0 {
+		return nil, errors.New("no fork choice nodes supplied")
+	}
+
+	tree := &ForkChoiceTree{
+		nodes: make(map[phase0.Root]*ForkChoiceTreeNode, len(nodes)),
+	}
+	for i, node := range nodes {
+		if node == nil {
+			return nil, fmt.Errorf("fork choice node %d missing", i)
+		}
+		if _, exists := tree.nodes[node.BlockRoot]; exists {
+			return nil, fmt.Errorf("duplicate fork choice node %#x", node.BlockRoot)
+		}
+		tree.nodes[node.BlockRoot] = &ForkChoiceTreeNode{
+			Node: node,
+		}
+	}
+
+	for _, node := range nodes {
+		treeNode := tree.nodes[node.BlockRoot]
+		parent, exists := tree.nodes[node.ParentRoot]
+		if !exists || parent == treeNode {
+			if tree.anchor != nil {
+				return nil, fmt.Errorf("multiple anchors %#x and %#x", tree.anchor.Node.BlockRoot, node.BlockRoot)
+			}
+			tree.anchor = treeNode
+
+			continue
+		}
+		treeNode.Parent = parent
+		parent.Children = append(parent.Children, treeNode)
+	}
+
+	if tree.anchor == nil {
+		return nil, errors.New("no anchor found")
+	}
+
+	if len(tree.anchor.descendants()) != len(tree.nodes) {
+		return nil, errors.New("fork choice nodes contain a cycle")
+	}
+
+	for _, node := range tree.nodes {
+		sort.Slice(node.Children, func(i, j int) bool {
+			if node.Children[i].Node.Slot != node.Children[j].Node.Slot {
+				return node.Children[i].Node.Slot < node.Children[j].Node.Slot
+			}
+
+			return bytes.Compare(node.Children[i].Node.BlockRoot[:], node.Children[j].Node.BlockRoot[:]) < 0
+		})
+	}
+
+	return tree, nil
+}
+
+// Anchor returns the anchor of the tree, which is generally the finalized block.
+func (t *ForkChoiceTree) Anchor() *ForkChoiceTreeNode {
+	return t.anchor
+}
+
+// Node returns the node with the given block root.
+func (t *ForkChoiceTree) Node(root phase0.Root) (*ForkChoiceTreeNode, bool) {
+	node, exists := t.nodes[root]
+
+	return node, exists
+}
+
+// Heads returns the nodes of the tree that have no children, ordered by slot and then root.
+func (t *ForkChoiceTree) Heads() []*ForkChoiceTreeNode {
+	heads := make([]*ForkChoiceTreeNode, 0)
+	for _, node := range t.anchor.descendants() {
+		if len(node.Children) == 0 {
+			heads = append(heads, node)
+		}
+	}
+	sort.SliceStable(heads, func(i, j int) bool {
+		if heads[i].Node.Slot != heads[j].Node.Slot {
+			return heads[i].Node.Slot < heads[j].Node.Slot
+		}
+
+		return bytes.Compare(heads[i].Node.BlockRoot[:], heads[j].Node.BlockRoot[:]) < 0
+	})
+
+	return heads
+}
+
+// Head returns the head selected by fork choice.
+// Starting at the anchor, this repeatedly selects the child with the highest weight, using the
+// higher block root to break ties, and ignoring children that are known to be invalid.
+func (t *ForkChoiceTree) Head() *ForkChoiceTreeNode {
+	node := t.anchor
+	for {
+		best := node.bestChild()
+		if best == nil {
+			return node
+		}
+		node = best
+	}
+}
+
+// CanonicalChain returns the nodes from the anchor to the head selected by fork choice.
+func (t *ForkChoiceTree) CanonicalChain() []*ForkChoiceTreeNode {
+	return t.Head().chain()
+}
+
+// Chain returns the nodes from the anchor to the node with the given block root.
+func (t *ForkChoiceTree) Chain(root phase0.Root) ([]*ForkChoiceTreeNode, error) {
+	node, exists := t.nodes[root]
+	if !exists {
+		return nil, fmt.Errorf("block root %#x not in tree", root)
+	}
+
+	return node.chain(), nil
+}
+
+// CompetingBranches returns the branches that diverge from the canonical chain, in slot order of
+// their fork points.  Branches that are known to be invalid are ignored.
+func (t *ForkChoiceTree) CompetingBranches() []*ForkChoiceBranch {
+	branches := make([]*ForkChoiceBranch, 0)
+	for _, node := range t.CanonicalChain() {
+		canonical := node.bestChild()
+		if canonical == nil {
+			continue
+		}
+		for _, child := range node.Children {
+			if child == canonical || child.Node.Validity == ForkChoiceNodeValidityInvalid {
+				continue
+			}
+			branches = append(branches, &ForkChoiceBranch{
+				ForkPoint:   node,
+				Canonical:   canonical,
+				Branch:      child,
+				WeightDelta: canonical.Node.Weight - child.Node.Weight,
+			})
+		}
+	}
+
+	return branches
+}
+
+// CompareHeads returns the head that fork choice would select between the two given heads.
+// A head on a branch that is known to be invalid where the heads diverge loses to one that is not.
+func (t *ForkChoiceTree) CompareHeads(head1 phase0.Root, head2 phase0.Root) (*ForkChoiceHeadComparison, error) {
+	node1, exists := t.nodes[head1]
+	if !exists {
+		return nil, fmt.Errorf("block root %#x not in tree", head1)
+	}
+	node2, exists := t.nodes[head2]
+	if !exists {
+		return nil, fmt.Errorf("block root %#x not in tree", head2)
+	}
+
+	chain1 := node1.chain()
+	chain2 := node2.chain()
+
+	// Find the point at which the chains diverge.
+	divergence := 0
+	for divergence < len(chain1) && divergence < len(chain2) && chain1[divergence] == chain2[divergence] {
+		divergence++
+	}
+	res := &ForkChoiceHeadComparison{
+		CommonAncestor: chain1[divergence-1],
+	}
+
+	switch {
+	case divergence == len(chain1):
+		// Head 1 is an ancestor of (or the same as) head 2.
+		res.Winner = node2
+		res.Loser = node1
+	case divergence == len(chain2):
+		// Head 2 is an ancestor of head 1.
+		res.Winner = node1
+		res.Loser = node2
+	default:
+		// As with fork choice itself, a branch that is known to be invalid never wins.
+		branch1 := chain1[divergence]
+		branch2 := chain2[divergence]
+		invalid1 := branch1.Node.Validity == ForkChoiceNodeValidityInvalid
+		invalid2 := branch2.Node.Validity == ForkChoiceNodeValidityInvalid
+		head1Wins := betterForkChoiceNode(branch1, branch2)
+		if invalid1 != invalid2 {
+			head1Wins = invalid2
+		}
+		winningBranch, losingBranch := branch2, branch1
+		res.Winner = node2
+		res.Loser = node1
+		if head1Wins {
+			winningBranch, losingBranch = branch1, branch2
+			res.Winner = node1
+			res.Loser = node2
+		}
+		if winningBranch.Node.Weight > losingBranch.Node.Weight {
+			res.WeightDelta = winningBranch.Node.Weight - losingBranch.Node.Weight
+		}
+	}
+
+	return res, nil
+}
+
+// bestChild returns the child that fork choice would select, or nil if there are no valid children.
+func (n *ForkChoiceTreeNode) bestChild() *ForkChoiceTreeNode {
+	var best *ForkChoiceTreeNode
+	for _, child := range n.Children {
+		if child.Node.Validity == ForkChoiceNodeValidityInvalid {
+			continue
+		}
+		if best == nil || betterForkChoiceNode(child, best) {
+			best = child
+		}
+	}
+
+	return best
+}
+
+// chain returns the nodes from the anchor to this node.
+func (n *ForkChoiceTreeNode) chain() []*ForkChoiceTreeNode {
+	chain := make([]*ForkChoiceTreeNode, 0)
+	for node := n; node != nil; node = node.Parent {
+		chain = append(chain, node)
+	}
+	for i, j := 0, len(chain)-1; i < j; i, j = i+1, j-1 {
+		chain[i], chain[j] = chain[j], chain[i]
+	}
+
+	return chain
+}
+
+// descendants returns this node and all of its descendants.
+func (n *ForkChoiceTreeNode) descendants() []*ForkChoiceTreeNode {
+	res := []*ForkChoiceTreeNode{n}
+	for i := 0; i < len(res); i++ {
+		res = append(res, res[i].Children...)
+	}
+
+	return res
+}
+
+// betterForkChoiceNode returns true if fork choice would select node1 over node2.
+func betterForkChoiceNode(node1 *ForkChoiceTreeNode, node2 *ForkChoiceTreeNode) bool {
+	if node1.Node.Weight != node2.Node.Weight {
+		return node1.Node.Weight > node2.Node.Weight
+	}
+
+	return bytes.Compare(node1.Node.BlockRoot[:], node2.Node.BlockRoot[:]) > 0
+}
@@ -0,0 +1,300 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	require "github.com/stretchr/testify/require"
+)
+
+func forkChoiceTestNode(root byte, parent byte, slot phase0.Slot, weight uint64) *api.ForkChoiceNode {
+	return &api.ForkChoiceNode{
+		Slot:       slot,
+		BlockRoot:  phase0.Root{root},
+		ParentRoot: phase0.Root{parent},
+		Weight:     weight,
+		Validity:   api.ForkChoiceNodeValidityValid,
+	}
+}
+
+func forkChoiceTreeRoots(nodes []*api.ForkChoiceTreeNode) []phase0.Root {
+	res := make([]phase0.Root, len(nodes))
+	for i := range nodes {
+		res[i] = nodes[i].Node.BlockRoot
+	}
+
+	return res
+}
+
+// forkChoiceTestTree creates the tree:
+//
+//	0x0a (100) -+- 0x0b (60) -+- 0x0d (40)
+//	            |             +- 0x0e (20)
+//	            +- 0x0c (40) --- 0x0f (40)
+func forkChoiceTestTree(t *testing.T) *api.ForkChoiceTree {
+	t.Helper()
+
+	tree, err := api.NewForkChoiceTree([]*api.ForkChoiceNode{
+		forkChoiceTestNode(0x0f, 0x0c, 3, 40),
+		forkChoiceTestNode(0x0a, 0x00, 0, 100),
+		forkChoiceTestNode(0x0b, 0x0a, 1, 60),
+		forkChoiceTestNode(0x0c, 0x0a, 2, 40),
+		forkChoiceTestNode(0x0d, 0x0b, 2, 40),
+		forkChoiceTestNode(0x0e, 0x0b, 3, 20),
+	})
+	require.NoError(t, err)
+
+	return tree
+}
+
+func TestNewForkChoiceTree(t *testing.T) {
+	tests := []struct {
+		name  string
+		nodes []*api.ForkChoiceNode
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "no fork choice nodes supplied",
+		},
+		{
+			name:  "NodeMissing",
+			nodes: []*api.ForkChoiceNode{forkChoiceTestNode(0x0a, 0x00, 0, 100), nil},
+			err:   "fork choice node 1 missing",
+		},
+		{
+			name: "Duplicate",
+			nodes: []*api.ForkChoiceNode{
+				forkChoiceTestNode(0x0a, 0x00, 0, 100),
+				forkChoiceTestNode(0x0a, 0x00, 0, 100),
+			},
+			err: "duplicate fork choice node 0x0a00000000000000000000000000000000000000000000000000000000000000",
+		},
+		{
+			name: "MultipleAnchors",
+			nodes: []*api.ForkChoiceNode{
+				forkChoiceTestNode(0x0a, 0x00, 0, 100),
+				forkChoiceTestNode(0x0b, 0x01, 1, 100),
+			},
+			err: "multiple anchors 0x0a00000000000000000000000000000000000000000000000000000000000000 and 0x0b00000000000000000000000000000000000000000000000000000000000000",
+		},
+		{
+			name: "Cycle",
+			nodes: []*api.ForkChoiceNode{
+				forkChoiceTestNode(0x0a, 0x00, 0, 100),
+				forkChoiceTestNode(0x0b, 0x0c, 1, 100),
+				forkChoiceTestNode(0x0c, 0x0b, 2, 100),
+			},
+			err: "fork choice nodes contain a cycle",
+		},
+		{
+			name:  "Single",
+			nodes: []*api.ForkChoiceNode{forkChoiceTestNode(0x0a, 0x00, 0, 100)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tree, err := api.NewForkChoiceTree(test.nodes)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.nodes[0].BlockRoot, tree.Anchor().Node.BlockRoot)
+			require.Equal(t, tree.Anchor(), tree.Head())
+		})
+	}
+}
+
+func TestForkChoiceTreeNavigation(t *testing.T) {
+	tree := forkChoiceTestTree(t)
+
+	require.Equal(t, phase0.Root{0x0a}, tree.Anchor().Node.BlockRoot)
+	require.Equal(t, phase0.Root{0x0d}, tree.Head().Node.BlockRoot)
+	require.Equal(t, []phase0.Root{{0x0a}, {0x0b}, {0x0d}}, forkChoiceTreeRoots(tree.CanonicalChain()))
+	require.Equal(t, []phase0.Root{{0x0d}, {0x0e}, {0x0f}}, forkChoiceTreeRoots(tree.Heads()))
+
+	node, exists := tree.Node(phase0.Root{0x0c})
+	require.True(t, exists)
+	require.Equal(t, phase0.Root{0x0a}, node.Parent.Node.BlockRoot)
+	require.Equal(t, []phase0.Root{{0x0f}}, forkChoiceTreeRoots(node.Children))
+	_, exists = tree.Node(phase0.Root{0x01})
+	require.False(t, exists)
+
+	chain, err := tree.Chain(phase0.Root{0x0f})
+	require.NoError(t, err)
+	require.Equal(t, []phase0.Root{{0x0a}, {0x0c}, {0x0f}}, forkChoiceTreeRoots(chain))
+	_, err = tree.Chain(phase0.Root{0x01})
+	require.EqualError(t, err, "block root 0x0100000000000000000000000000000000000000000000000000000000000000 not in tree")
+}
+
+func TestForkChoiceTreeCompetingBranches(t *testing.T) {
+	tree := forkChoiceTestTree(t)
+
+	branches := tree.CompetingBranches()
+	require.Len(t, branches, 2)
+	require.Equal(t, phase0.Root{0x0a}, branches[0].ForkPoint.Node.BlockRoot)
+	require.Equal(t, phase0.Root{0x0b}, branches[0].Canonical.Node.BlockRoot)
+	require.Equal(t, phase0.Root{0x0c}, branches[0].Branch.Node.BlockRoot)
+	require.Equal(t, uint64(20), branches[0].WeightDelta)
+	require.Equal(t, phase0.Root{0x0b}, branches[1].ForkPoint.Node.BlockRoot)
+	require.Equal(t, phase0.Root{0x0d}, branches[1].Canonical.Node.BlockRoot)
+	require.Equal(t, phase0.Root{0x0e}, branches[1].Branch.Node.BlockRoot)
+	require.Equal(t, uint64(20), branches[1].WeightDelta)
+}
+
+func TestForkChoiceTreeTieBreak(t *testing.T) {
+	nodes := []*api.ForkChoiceNode{
+		forkChoiceTestNode(0x0a, 0x00, 0, 100),
+		forkChoiceTestNode(0x0b, 0x0a, 1, 50),
+		forkChoiceTestNode(0x0c, 0x0a, 1, 50),
+		forkChoiceTestNode(0x0d, 0x0a, 1, 80),
+	}
+	nodes[3].Validity = api.ForkChoiceNodeValidityInvalid
+
+	tree, err := api.NewForkChoiceTree(nodes)
+	require.NoError(t, err)
+
+	// Invalid 0x0d is ignored, and 0x0c beats 0x0b on root.
+	require.Equal(t, phase0.Root{0x0c}, tree.Head().Node.BlockRoot)
+	branches := tree.CompetingBranches()
+	require.Len(t, branches, 1)
+	require.Equal(t, phase0.Root{0x0b}, branches[0].Branch.Node.BlockRoot)
+	require.Equal(t, uint64(0), branches[0].WeightDelta)
+}
+
+func TestForkChoiceTreeCompareHeads(t *testing.T) {
+	tree := forkChoiceTestTree(t)
+
+	tests := []struct {
+		name           string
+		head1          phase0.Root
+		head2          phase0.Root
+		winner         phase0.Root
+		loser          phase0.Root
+		commonAncestor phase0.Root
+		weightDelta    uint64
+		err            string
+	}{
+		{
+			name:  "Head1Unknown",
+			head1: phase0.Root{0x01},
+			head2: phase0.Root{0x0d},
+			err:   "block root 0x0100000000000000000000000000000000000000000000000000000000000000 not in tree",
+		},
+		{
+			name:  "Head2Unknown",
+			head1: phase0.Root{0x0d},
+			head2: phase0.Root{0x01},
+			err:   "block root 0x0100000000000000000000000000000000000000000000000000000000000000 not in tree",
+		},
+		{
+			name:           "Siblings",
+			head1:          phase0.Root{0x0e},
+			head2:          phase0.Root{0x0d},
+			winner:         phase0.Root{0x0d},
+			loser:          phase0.Root{0x0e},
+			commonAncestor: phase0.Root{0x0b},
+			weightDelta:    20,
+		},
+		{
+			name:           "Cousins",
+			head1:          phase0.Root{0x0f},
+			head2:          phase0.Root{0x0e},
+			winner:         phase0.Root{0x0e},
+			loser:          phase0.Root{0x0f},
+			commonAncestor: phase0.Root{0x0a},
+			weightDelta:    20,
+		},
+		{
+			name:           "Ancestor",
+			head1:          phase0.Root{0x0b},
+			head2:          phase0.Root{0x0e},
+			winner:         phase0.Root{0x0e},
+			loser:          phase0.Root{0x0b},
+			commonAncestor: phase0.Root{0x0b},
+		},
+		{
+			name:           "Descendant",
+			head1:          phase0.Root{0x0f},
+			head2:          phase0.Root{0x0a},
+			winner:         phase0.Root{0x0f},
+			loser:          phase0.Root{0x0a},
+			commonAncestor: phase0.Root{0x0a},
+		},
+		{
+			name:           "Same",
+			head1:          phase0.Root{0x0d},
+			head2:          phase0.Root{0x0d},
+			winner:         phase0.Root{0x0d},
+			loser:          phase0.Root{0x0d},
+			commonAncestor: phase0.Root{0x0d},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := tree.CompareHeads(test.head1, test.head2)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.winner, res.Winner.Node.BlockRoot)
+			require.Equal(t, test.loser, res.Loser.Node.BlockRoot)
+			require.Equal(t, test.commonAncestor, res.CommonAncestor.Node.BlockRoot)
+			require.Equal(t, test.weightDelta, res.WeightDelta)
+		})
+	}
+}
+
+func TestForkChoiceTreeCompareHeadsInvalid(t *testing.T) {
+	// 0x0a (100) -+- 0x0b (70, invalid) --- 0x0d (70, invalid)
+	//             +- 0x0c (30) ------------ 0x0e (30)
+	nodes := []*api.ForkChoiceNode{
+		forkChoiceTestNode(0x0a, 0x00, 0, 100),
+		forkChoiceTestNode(0x0b, 0x0a, 1, 70),
+		forkChoiceTestNode(0x0c, 0x0a, 1, 30),
+		forkChoiceTestNode(0x0d, 0x0b, 2, 70),
+		forkChoiceTestNode(0x0e, 0x0c, 2, 30),
+	}
+	nodes[1].Validity = api.ForkChoiceNodeValidityInvalid
+	nodes[3].Validity = api.ForkChoiceNodeValidityInvalid
+
+	tree, err := api.NewForkChoiceTree(nodes)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Root{0x0e}, tree.Head().Node.BlockRoot)
+
+	// The heavier invalid branch loses, in either order.
+	res, err := tree.CompareHeads(phase0.Root{0x0d}, phase0.Root{0x0e})
+	require.NoError(t, err)
+	require.Equal(t, phase0.Root{0x0e}, res.Winner.Node.BlockRoot)
+	require.Equal(t, phase0.Root{0x0d}, res.Loser.Node.BlockRoot)
+	require.Equal(t, phase0.Root{0x0a}, res.CommonAncestor.Node.BlockRoot)
+	require.Equal(t, uint64(0), res.WeightDelta)
+
+	res, err = tree.CompareHeads(phase0.Root{0x0e}, phase0.Root{0x0d})
+	require.NoError(t, err)
+	require.Equal(t, phase0.Root{0x0e}, res.Winner.Node.BlockRoot)
+	require.Equal(t, phase0.Root{0x0d}, res.Loser.Node.BlockRoot)
+	require.Equal(t, uint64(0), res.WeightDelta)
+}
@@ -13,5 +13,5 @@
 
 package v1
 
-//go:generate rm -f blobs_ssz.go signedvalidatorregistration_ssz.go validatorregistration_ssz.go
+//go:generate rm -f beaconcommittee_ssz.go blobs_ssz.go depositsnapshot_ssz.go signedvalidatorregistration_ssz.go synccommitteeduty_ssz.go validatorbalance_ssz.go validatoridentity_ssz.go validatorregistration_ssz.go validatorssz_ssz.go
 //go:generate go tool dynssz-gen -config generate.yaml
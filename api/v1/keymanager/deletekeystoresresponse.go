@@ -0,0 +1,70 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// DeleteKeystoresResponse is the response to a request to delete keystores.
+type DeleteKeystoresResponse struct {
+	// Results are the results of the deletions, in the order of the request.
+	Results []*OperationResult
+	// SlashingProtection is the EIP-3076 slashing protection interchange data for the deleted keys.
+	SlashingProtection string
+}
+
+// deleteKeystoresResponseJSON is the spec representation of the struct.
+type deleteKeystoresResponseJSON struct {
+	Data               []*OperationResult `json:"data"`
+	SlashingProtection string             `json:"slashing_protection"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d *DeleteKeystoresResponse) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&deleteKeystoresResponseJSON{
+		Data:               d.Results,
+		SlashingProtection: d.SlashingProtection,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DeleteKeystoresResponse) UnmarshalJSON(input []byte) error {
+	var data deleteKeystoresResponseJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.Data == nil {
+		return errors.New("data missing")
+	}
+
+	d.Results = data.Data
+	d.SlashingProtection = data.SlashingProtection
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (d *DeleteKeystoresResponse) String() string {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
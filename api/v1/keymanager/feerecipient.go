@@ -0,0 +1,81 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// FeeRecipient is the fee recipient configured for a validator.
+type FeeRecipient struct {
+	// Pubkey is the public key of the validator.
+	Pubkey phase0.BLSPubKey
+	// EthAddress is the execution address to which fees are paid.
+	EthAddress bellatrix.ExecutionAddress
+}
+
+// feeRecipientJSON is the spec representation of the struct.
+type feeRecipientJSON struct {
+	Pubkey     string `json:"pubkey"`
+	EthAddress string `json:"ethaddress"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f *FeeRecipient) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&feeRecipientJSON{
+		Pubkey:     fmt.Sprintf("%#x", f.Pubkey),
+		EthAddress: f.EthAddress.String(),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (f *FeeRecipient) UnmarshalJSON(input []byte) error {
+	var data feeRecipientJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.Pubkey == "" {
+		return errors.New("public key missing")
+	}
+
+	if err := decodeFixedHex(data.Pubkey, f.Pubkey[:]); err != nil {
+		return errors.Wrap(err, "invalid value for public key")
+	}
+
+	if data.EthAddress == "" {
+		return errors.New("eth address missing")
+	}
+
+	if err := decodeFixedHex(data.EthAddress, f.EthAddress[:]); err != nil {
+		return errors.Wrap(err, "invalid value for eth address")
+	}
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (f *FeeRecipient) String() string {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
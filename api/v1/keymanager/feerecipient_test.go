@@ -0,0 +1,86 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api/v1/keymanager"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestFeeRecipientJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type keymanager.feeRecipientJSON",
+		},
+		{
+			name:  "PubkeyMissing",
+			input: []byte(`{"ethaddress":"0x000102030405060708090a0b0c0d0e0f10111213"}`),
+			err:   "public key missing",
+		},
+		{
+			name:  "PubkeyInvalid",
+			input: []byte(`{"pubkey":"invalid","ethaddress":"0x000102030405060708090a0b0c0d0e0f10111213"}`),
+			err:   "invalid value for public key: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "EthAddressMissing",
+			input: []byte(`{"pubkey":"0x010101010101010101010101010101010101010101010101010101010101010101010101010101010101010101010101"}`),
+			err:   "eth address missing",
+		},
+		{
+			name:  "EthAddressInvalid",
+			input: []byte(`{"pubkey":"0x010101010101010101010101010101010101010101010101010101010101010101010101010101010101010101010101","ethaddress":"invalid"}`),
+			err:   "invalid value for eth address: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "EthAddressShort",
+			input: []byte(`{"pubkey":"0x010101010101010101010101010101010101010101010101010101010101010101010101010101010101010101010101","ethaddress":"0x000102030405060708090a0b0c0d0e0f101112"}`),
+			err:   "invalid value for eth address: incorrect length",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"pubkey":"0x010101010101010101010101010101010101010101010101010101010101010101010101010101010101010101010101","ethaddress":"0x000102030405060708090a0b0c0d0e0f10111213"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res keymanager.FeeRecipient
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api/v1/keymanager"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestGasLimitJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type keymanager.gasLimitJSON",
+		},
+		{
+			name:  "PubkeyMissing",
+			input: []byte(`{"gas_limit":"30000000"}`),
+			err:   "public key missing",
+		},
+		{
+			name:  "GasLimitMissing",
+			input: []byte(`{"pubkey":"0x010101010101010101010101010101010101010101010101010101010101010101010101010101010101010101010101"}`),
+			err:   "gas limit missing",
+		},
+		{
+			name:  "GasLimitInvalid",
+			input: []byte(`{"pubkey":"0x010101010101010101010101010101010101010101010101010101010101010101010101010101010101010101010101","gas_limit":"-1"}`),
+			err:   "invalid value for gas limit: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"pubkey":"0x010101010101010101010101010101010101010101010101010101010101010101010101010101010101010101010101","gas_limit":"30000000"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res keymanager.GasLimit
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// Graffiti is the graffiti configured for a validator.
+type Graffiti struct {
+	// Pubkey is the public key of the validator.
+	Pubkey phase0.BLSPubKey
+	// Graffiti is the graffiti included in blocks proposed by the validator.
+	Graffiti string
+}
+
+// graffitiJSON is the spec representation of the struct.
+type graffitiJSON struct {
+	Pubkey   string `json:"pubkey"`
+	Graffiti string `json:"graffiti"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (g *Graffiti) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&graffitiJSON{
+		Pubkey:   fmt.Sprintf("%#x", g.Pubkey),
+		Graffiti: g.Graffiti,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (g *Graffiti) UnmarshalJSON(input []byte) error {
+	var data graffitiJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.Pubkey == "" {
+		return errors.New("public key missing")
+	}
+
+	if err := decodeFixedHex(data.Pubkey, g.Pubkey[:]); err != nil {
+		return errors.Wrap(err, "invalid value for public key")
+	}
+
+	// Graffiti can legitimately be empty.
+	g.Graffiti = data.Graffiti
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (g *Graffiti) String() string {
+	data, err := json.Marshal(g)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,80 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api/v1/keymanager"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestKeystoreJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type keymanager.keystoreJSON",
+		},
+		{
+			name:  "PubkeyMissing",
+			input: []byte(`{"derivation_path":"m/12381/3600/0/0/0"}`),
+			err:   "validating public key missing",
+		},
+		{
+			name:  "PubkeyInvalid",
+			input: []byte(`{"validating_pubkey":"invalid"}`),
+			err:   "invalid value for validating public key: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "PubkeyShort",
+			input: []byte(`{"validating_pubkey":"0x0101010101010101010101010101010101010101010101010101010101010101010101010101010101010101010101"}`),
+			err:   "invalid value for validating public key: incorrect length",
+		},
+		{
+			name:  "Minimal",
+			input: []byte(`{"validating_pubkey":"0x010101010101010101010101010101010101010101010101010101010101010101010101010101010101010101010101"}`),
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"validating_pubkey":"0x010101010101010101010101010101010101010101010101010101010101010101010101010101010101010101010101","derivation_path":"m/12381/3600/0/0/0","readonly":true}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res keymanager.Keystore
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// OperationStatus is the status of an individual import or delete operation.
+type OperationStatus string
+
+const (
+	// OperationStatusImported is returned when a key has been imported.
+	OperationStatusImported OperationStatus = "imported"
+	// OperationStatusDuplicate is returned when a key was already present.
+	OperationStatusDuplicate OperationStatus = "duplicate"
+	// OperationStatusDeleted is returned when a key has been deleted.
+	OperationStatusDeleted OperationStatus = "deleted"
+	// OperationStatusNotActive is returned when a key was not active but slashing protection data is available.
+	OperationStatusNotActive OperationStatus = "not_active"
+	// OperationStatusNotFound is returned when a key was not found.
+	OperationStatusNotFound OperationStatus = "not_found"
+	// OperationStatusError is returned when the operation failed.
+	OperationStatusError OperationStatus = "error"
+)
+
+// OperationResult is the result of an individual import or delete operation.
+type OperationResult struct {
+	// Status is the status of the operation.
+	Status OperationStatus
+	// Message is additional information about the operation, if any.
+	Message string
+}
+
+// operationResultJSON is the spec representation of the struct.
+type operationResultJSON struct {
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *OperationResult) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&operationResultJSON{
+		Status:  string(o.Status),
+		Message: o.Message,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *OperationResult) UnmarshalJSON(input []byte) error {
+	var data operationResultJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.Status == "" {
+		return errors.New("status missing")
+	}
+
+	o.Status = OperationStatus(data.Status)
+	o.Message = data.Message
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (o *OperationResult) String() string {
+	data, err := json.Marshal(o)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,83 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// RemoteKey is a key held by a validator client that signs through a remote signer.
+type RemoteKey struct {
+	// Pubkey is the public key of the validator.
+	Pubkey phase0.BLSPubKey
+	// URL is the URL of the remote signer.
+	URL string
+	// ReadOnly is true if the key cannot be deleted through the API.
+	ReadOnly bool
+}
+
+// remoteKeyJSON is the spec representation of the struct.
+type remoteKeyJSON struct {
+	Pubkey   string `json:"pubkey"`
+	URL      string `json:"url"`
+	ReadOnly bool   `json:"readonly,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (r *RemoteKey) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&remoteKeyJSON{
+		Pubkey:   fmt.Sprintf("%#x", r.Pubkey),
+		URL:      r.URL,
+		ReadOnly: r.ReadOnly,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (r *RemoteKey) UnmarshalJSON(input []byte) error {
+	var data remoteKeyJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.Pubkey == "" {
+		return errors.New("public key missing")
+	}
+
+	if err := decodeFixedHex(data.Pubkey, r.Pubkey[:]); err != nil {
+		return errors.Wrap(err, "invalid value for public key")
+	}
+
+	if data.URL == "" {
+		return errors.New("URL missing")
+	}
+
+	r.URL = data.URL
+	r.ReadOnly = data.ReadOnly
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (r *RemoteKey) String() string {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
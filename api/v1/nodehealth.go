@@ -0,0 +1,43 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+// NodeHealth defines the health of a node, as reported by its health endpoint.
+type NodeHealth int
+
+const (
+	// NodeHealthUnknown means the health of the node could not be determined.
+	NodeHealthUnknown NodeHealth = iota
+	// NodeHealthReady means the node is synced and ready to serve requests.
+	NodeHealthReady
+	// NodeHealthSyncing means the node is syncing, but can serve incomplete data.
+	NodeHealthSyncing
+	// NodeHealthNotReady means the node is not initialized or has issues.
+	NodeHealthNotReady
+)
+
+var nodeHealthStrings = [...]string{
+	"unknown",
+	"ready",
+	"syncing",
+	"not_ready",
+}
+
+func (n NodeHealth) String() string {
+	if n < 0 || int(n) >= len(nodeHealthStrings) {
+		return nodeHealthStrings[0] // unknown
+	}
+
+	return nodeHealthStrings[n]
+}
@@ -0,0 +1,105 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// NodeIdentity is the network identity of a node.
+type NodeIdentity struct {
+	// PeerID is the libp2p peer ID of the node.
+	PeerID string
+	// ENR is the Ethereum node record of the node.
+	ENR string
+	// P2PAddresses are the multiaddrs on which the node listens for libp2p connections.
+	P2PAddresses []string
+	// DiscoveryAddresses are the multiaddrs on which the node listens for discovery.
+	DiscoveryAddresses []string
+	// Metadata is the p2p metadata of the node.
+	Metadata *NodeMetadata
+}
+
+// nodeIdentityJSON is the spec representation of the struct.
+type nodeIdentityJSON struct {
+	PeerID             string        `json:"peer_id"`
+	ENR                string        `json:"enr"`
+	P2PAddresses       []string      `json:"p2p_addresses"`
+	DiscoveryAddresses []string      `json:"discovery_addresses"`
+	Metadata           *NodeMetadata `json:"metadata"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (n *NodeIdentity) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&nodeIdentityJSON{
+		PeerID:             n.PeerID,
+		ENR:                n.ENR,
+		P2PAddresses:       n.P2PAddresses,
+		DiscoveryAddresses: n.DiscoveryAddresses,
+		Metadata:           n.Metadata,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (n *NodeIdentity) UnmarshalJSON(input []byte) error {
+	var nodeIdentityJSON nodeIdentityJSON
+	if err := json.Unmarshal(input, &nodeIdentityJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if nodeIdentityJSON.PeerID == "" {
+		return errors.New("peer ID missing")
+	}
+
+	n.PeerID = nodeIdentityJSON.PeerID
+
+	if nodeIdentityJSON.ENR == "" {
+		return errors.New("ENR missing")
+	}
+
+	n.ENR = nodeIdentityJSON.ENR
+
+	if nodeIdentityJSON.P2PAddresses == nil {
+		return errors.New("p2p addresses missing")
+	}
+
+	n.P2PAddresses = nodeIdentityJSON.P2PAddresses
+
+	if nodeIdentityJSON.DiscoveryAddresses == nil {
+		return errors.New("discovery addresses missing")
+	}
+
+	n.DiscoveryAddresses = nodeIdentityJSON.DiscoveryAddresses
+
+	if nodeIdentityJSON.Metadata == nil {
+		return errors.New("metadata missing")
+	}
+
+	n.Metadata = nodeIdentityJSON.Metadata
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (n *NodeIdentity) String() string {
+	data, err := json.Marshal(n)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,133 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	bitfield "github.com/OffchainLabs/go-bitfield"
+	"github.com/pkg/errors"
+)
+
+// NodeMetadata is the p2p metadata advertised by a node.
+type NodeMetadata struct {
+	// SeqNumber is the sequence number of the metadata.
+	SeqNumber uint64
+	// Attnets are the attestation subnets to which the node is subscribed.
+	Attnets bitfield.Bitvector64
+	// Syncnets are the sync committee subnets to which the node is subscribed.
+	Syncnets bitfield.Bitvector4
+	// CustodyGroupCount is the number of custody groups the node serves.
+	// This is only present from the Fulu hard fork onwards.
+	CustodyGroupCount *uint64
+}
+
+// nodeMetadataJSON is the spec representation of the struct.
+type nodeMetadataJSON struct {
+	SeqNumber         string `json:"seq_number"`
+	Attnets           string `json:"attnets"`
+	Syncnets          string `json:"syncnets,omitempty"`
+	CustodyGroupCount string `json:"custody_group_count,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (n *NodeMetadata) MarshalJSON() ([]byte, error) {
+	data := &nodeMetadataJSON{
+		SeqNumber: fmt.Sprintf("%d", n.SeqNumber),
+		Attnets:   fmt.Sprintf("%#x", n.Attnets.Bytes()),
+	}
+	if n.Syncnets != nil {
+		data.Syncnets = fmt.Sprintf("%#x", n.Syncnets.Bytes())
+	}
+
+	if n.CustodyGroupCount != nil {
+		data.CustodyGroupCount = fmt.Sprintf("%d", *n.CustodyGroupCount)
+	}
+
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (n *NodeMetadata) UnmarshalJSON(input []byte) error {
+	var err error
+
+	var nodeMetadataJSON nodeMetadataJSON
+	if err = json.Unmarshal(input, &nodeMetadataJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if nodeMetadataJSON.SeqNumber == "" {
+		return errors.New("seq number missing")
+	}
+
+	n.SeqNumber, err = strconv.ParseUint(nodeMetadataJSON.SeqNumber, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for seq number")
+	}
+
+	if nodeMetadataJSON.Attnets == "" {
+		return errors.New("attnets missing")
+	}
+
+	attnets, err := hex.DecodeString(strings.TrimPrefix(nodeMetadataJSON.Attnets, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for attnets")
+	}
+
+	if len(attnets) != 8 {
+		return errors.New("incorrect length for attnets")
+	}
+
+	n.Attnets = attnets
+
+	// Syncnets are only present from the Altair hard fork onwards.
+	if nodeMetadataJSON.Syncnets != "" {
+		syncnets, err := hex.DecodeString(strings.TrimPrefix(nodeMetadataJSON.Syncnets, "0x"))
+		if err != nil {
+			return errors.Wrap(err, "invalid value for syncnets")
+		}
+
+		if len(syncnets) != 1 {
+			return errors.New("incorrect length for syncnets")
+		}
+
+		n.Syncnets = syncnets
+	}
+
+	// Custody group count is only present from the Fulu hard fork onwards.
+	if nodeMetadataJSON.CustodyGroupCount != "" {
+		custodyGroupCount, err := strconv.ParseUint(nodeMetadataJSON.CustodyGroupCount, 10, 64)
+		if err != nil {
+			return errors.Wrap(err, "invalid value for custody group count")
+		}
+
+		n.CustodyGroupCount = &custodyGroupCount
+	}
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (n *NodeMetadata) String() string {
+	data, err := json.Marshal(n)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,109 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestNodeMetadataJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.nodeMetadataJSON",
+		},
+		{
+			name:  "SeqNumberMissing",
+			input: []byte(`{"attnets":"0x0000000000000000","syncnets":"0x0f"}`),
+			err:   "seq number missing",
+		},
+		{
+			name:  "SeqNumberInvalid",
+			input: []byte(`{"seq_number":"-1","attnets":"0x0000000000000000","syncnets":"0x0f"}`),
+			err:   "invalid value for seq number: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "AttnetsMissing",
+			input: []byte(`{"seq_number":"1","syncnets":"0x0f"}`),
+			err:   "attnets missing",
+		},
+		{
+			name:  "AttnetsInvalid",
+			input: []byte(`{"seq_number":"1","attnets":"invalid","syncnets":"0x0f"}`),
+			err:   "invalid value for attnets: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "AttnetsShort",
+			input: []byte(`{"seq_number":"1","attnets":"0x00000000000000","syncnets":"0x0f"}`),
+			err:   "incorrect length for attnets",
+		},
+		{
+			name:  "SyncnetsInvalid",
+			input: []byte(`{"seq_number":"1","attnets":"0x0000000000000000","syncnets":"invalid"}`),
+			err:   "invalid value for syncnets: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "SyncnetsLong",
+			input: []byte(`{"seq_number":"1","attnets":"0x0000000000000000","syncnets":"0x0f00"}`),
+			err:   "incorrect length for syncnets",
+		},
+		{
+			name:  "CustodyGroupCountInvalid",
+			input: []byte(`{"seq_number":"1","attnets":"0x0000000000000000","syncnets":"0x0f","custody_group_count":"-1"}`),
+			err:   "invalid value for custody group count: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "GoodPhase0",
+			input: []byte(`{"seq_number":"1","attnets":"0xffffffffffffffff"}`),
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"seq_number":"1","attnets":"0x0000000000000000","syncnets":"0x0f"}`),
+		},
+		{
+			name:  "GoodCustody",
+			input: []byte(`{"seq_number":"1","attnets":"0x0000000000000000","syncnets":"0x0f","custody_group_count":"128"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.NodeMetadata
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
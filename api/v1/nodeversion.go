@@ -0,0 +1,211 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// clientNames maps client codes to client names.
+var clientNames = map[string]string{
+	"BU": "besu",
+	"EG": "erigon",
+	"EJ": "ethereumjs",
+	"GE": "geth",
+	"GR": "grandine",
+	"LH": "lighthouse",
+	"LS": "lodestar",
+	"NB": "nimbus",
+	"NM": "nethermind",
+	"PM": "prysm",
+	"RH": "reth",
+	"TE": "trin-execution",
+	"TK": "teku",
+}
+
+// versionStringClients are the names of clients that can be found in free-text version strings,
+// along with their client codes.
+var versionStringClients = []struct {
+	name string
+	code string
+}{
+	{name: "charon"},
+	{name: "grandine", code: "GR"},
+	{name: "lighthouse", code: "LH"},
+	{name: "lodestar", code: "LS"},
+	{name: "nimbus", code: "NB"},
+	{name: "prysm", code: "PM"},
+	{name: "teku", code: "TK"},
+}
+
+// NodeVersion contains the identity of a beacon node and its execution client.
+type NodeVersion struct {
+	// BeaconNode is the identity of the beacon node.
+	BeaconNode *ClientVersion
+	// ExecutionClient is the identity of the execution client; nil if not known.
+	ExecutionClient *ClientVersion
+}
+
+// nodeVersionJSON is the spec representation of the struct.
+type nodeVersionJSON struct {
+	BeaconNode      *ClientVersion `json:"beacon_node"`
+	ExecutionClient *ClientVersion `json:"execution_client,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (n *NodeVersion) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&nodeVersionJSON{
+		BeaconNode:      n.BeaconNode,
+		ExecutionClient: n.ExecutionClient,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (n *NodeVersion) UnmarshalJSON(input []byte) error {
+	var nodeVersionJSON nodeVersionJSON
+	if err := json.Unmarshal(input, &nodeVersionJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	if nodeVersionJSON.BeaconNode == nil {
+		return errors.New("beacon node missing")
+	}
+	n.BeaconNode = nodeVersionJSON.BeaconNode
+	n.ExecutionClient = nodeVersionJSON.ExecutionClient
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (n *NodeVersion) String() string {
+	data, err := json.Marshal(n)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
+
+// ParseNodeVersion creates a node version from the free-text version string returned by
+// /eth/v1/node/version, for example "Lighthouse/v4.5.0-441fc16/x86_64-linux".
+// Only the beacon node identity is available from the string; the commit is not populated.
+func ParseNodeVersion(input string) *NodeVersion {
+	parts := strings.Split(strings.TrimSpace(input), "/")
+
+	beaconNode := &ClientVersion{
+		Name: parts[0],
+	}
+	if len(parts) > 1 {
+		beaconNode.Version = parts[1]
+	}
+
+	// Search for a known client, as some version strings are prefixed with an organisation.
+	for i := range parts {
+		for _, client := range versionStringClients {
+			if strings.Contains(strings.ToLower(parts[i]), client.name) {
+				beaconNode.Code = client.code
+				beaconNode.Name = parts[i]
+				beaconNode.Version = ""
+				if i+1 < len(parts) {
+					beaconNode.Version = parts[i+1]
+				}
+
+				return &NodeVersion{
+					BeaconNode: beaconNode,
+				}
+			}
+		}
+	}
+
+	return &NodeVersion{
+		BeaconNode: beaconNode,
+	}
+}
+
+// ClientVersion is the identity of a client.
+type ClientVersion struct {
+	// Code is the two-letter code of the client, for example "LH".
+	Code string
+	// Name is the human-readable name of the client.
+	Name string
+	// Version is the version of the client.
+	Version string
+	// Commit is the hex-encoded prefix of the commit from which the client was built.
+	Commit string
+}
+
+// clientVersionJSON is the spec representation of the struct.
+type clientVersionJSON struct {
+	Code    string `json:"code"`
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Commit  string `json:"commit"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c *ClientVersion) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&clientVersionJSON{
+		Code:    c.Code,
+		Name:    c.Name,
+		Version: c.Version,
+		Commit:  c.Commit,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *ClientVersion) UnmarshalJSON(input []byte) error {
+	var clientVersionJSON clientVersionJSON
+	if err := json.Unmarshal(input, &clientVersionJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	if clientVersionJSON.Name == "" {
+		return errors.New("name missing")
+	}
+	c.Code = clientVersionJSON.Code
+	c.Name = clientVersionJSON.Name
+	c.Version = clientVersionJSON.Version
+	c.Commit = clientVersionJSON.Commit
+
+	return nil
+}
+
+// Client returns the lower-case name of the client, for example "lighthouse".
+// The name is obtained from the client code if it is known, otherwise from the client name.
+func (c *ClientVersion) Client() string {
+	if name, exists := clientNames[strings.ToUpper(c.Code)]; exists {
+		return name
+	}
+
+	name := strings.ToLower(c.Name)
+	for _, client := range versionStringClients {
+		if strings.Contains(name, client.name) {
+			return client.name
+		}
+	}
+
+	return name
+}
+
+// String returns a string version of the structure.
+func (c *ClientVersion) String() string {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
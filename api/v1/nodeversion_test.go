@@ -0,0 +1,181 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestNodeVersionJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.nodeVersionJSON",
+		},
+		{
+			name:  "BeaconNodeMissing",
+			input: []byte(`{"execution_client":{"code":"GE","name":"go-ethereum","version":"1.15.0","commit":"0x8a9e1f8a"}}`),
+			err:   "beacon node missing",
+		},
+		{
+			name:  "BeaconNodeWrongType",
+			input: []byte(`{"beacon_node":true}`),
+			err:   "invalid JSON: invalid JSON: json: cannot unmarshal bool into Go value of type v1.clientVersionJSON",
+		},
+		{
+			name:  "BeaconNodeNameMissing",
+			input: []byte(`{"beacon_node":{"code":"LH","version":"v7.0.0","commit":"0x1a2b3c4d"}}`),
+			err:   "invalid JSON: name missing",
+		},
+		{
+			name:  "ExecutionClientNameMissing",
+			input: []byte(`{"beacon_node":{"code":"LH","name":"Lighthouse","version":"v7.0.0","commit":"0x1a2b3c4d"},"execution_client":{"code":"GE"}}`),
+			err:   "invalid JSON: name missing",
+		},
+		{
+			name:  "GoodNoExecutionClient",
+			input: []byte(`{"beacon_node":{"code":"LH","name":"Lighthouse","version":"v7.0.0","commit":"0x1a2b3c4d"}}`),
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"beacon_node":{"code":"LH","name":"Lighthouse","version":"v7.0.0","commit":"0x1a2b3c4d"},"execution_client":{"code":"GE","name":"go-ethereum","version":"1.15.0","commit":"0x8a9e1f8a"}}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.NodeVersion
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
+
+func TestParseNodeVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected *api.ClientVersion
+		client   string
+	}{
+		{
+			name:     "Empty",
+			input:    "",
+			expected: &api.ClientVersion{},
+			client:   "",
+		},
+		{
+			name:     "Unknown",
+			input:    "Unknown/v1.2.3/linux",
+			expected: &api.ClientVersion{Name: "Unknown", Version: "v1.2.3"},
+			client:   "unknown",
+		},
+		{
+			name:     "Lighthouse",
+			input:    "Lighthouse/v4.5.0-441fc16/x86_64-linux",
+			expected: &api.ClientVersion{Code: "LH", Name: "Lighthouse", Version: "v4.5.0-441fc16"},
+			client:   "lighthouse",
+		},
+		{
+			name:     "Teku",
+			input:    "teku/v23.10.0/linux-x86_64/-eclipseadoptium-openjdk64bitservervm-java-17",
+			expected: &api.ClientVersion{Code: "TK", Name: "teku", Version: "v23.10.0"},
+			client:   "teku",
+		},
+		{
+			name:     "Prysm",
+			input:    "Prysm/v4.1.1 (linux amd64)",
+			expected: &api.ClientVersion{Code: "PM", Name: "Prysm", Version: "v4.1.1 (linux amd64)"},
+			client:   "prysm",
+		},
+		{
+			name:     "NameOnly",
+			input:    "Nimbus",
+			expected: &api.ClientVersion{Code: "NB", Name: "Nimbus"},
+			client:   "nimbus",
+		},
+		{
+			name:     "Charon",
+			input:    "obolnetwork/charon/v0.17.0-4d5e1f0/linux-amd64",
+			expected: &api.ClientVersion{Name: "charon", Version: "v0.17.0-4d5e1f0"},
+			client:   "charon",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res := api.ParseNodeVersion(test.input)
+			require.Equal(t, test.expected, res.BeaconNode)
+			require.Nil(t, res.ExecutionClient)
+			require.Equal(t, test.client, res.BeaconNode.Client())
+		})
+	}
+}
+
+func TestClientVersionClient(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  *api.ClientVersion
+		expected string
+	}{
+		{
+			name:     "Code",
+			version:  &api.ClientVersion{Code: "GE", Name: "go-ethereum"},
+			expected: "geth",
+		},
+		{
+			name:     "CodeLowerCase",
+			version:  &api.ClientVersion{Code: "rh", Name: "reth"},
+			expected: "reth",
+		},
+		{
+			name:     "UnknownCode",
+			version:  &api.ClientVersion{Code: "XX", Name: "Lodestar"},
+			expected: "lodestar",
+		},
+		{
+			name:     "Name",
+			version:  &api.ClientVersion{Name: "MyClient"},
+			expected: "myclient",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, test.version.Client())
+		})
+	}
+}
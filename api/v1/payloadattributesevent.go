@@ -538,7 +538,7 @@ func (e *PayloadAttributesEvent) MarshalJSON() ([]byte, error) {
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to marshal payload attributes v3")
 		}
-	case spec.DataVersionElectra, spec.DataVersionFulu:
+	case spec.DataVersionElectra, spec.DataVersionFulu, spec.DataVersionGloas:
 		if e.Data.V4 == nil {
 			return nil, errors.New("no payload attributes v4 data")
 		}
@@ -699,7 +699,7 @@ func (e *PayloadAttributesEvent) unpack(data *payloadAttributesEventJSON) error
 		}
 
 		e.Data.V3 = &payloadAttributes
-	case spec.DataVersionElectra, spec.DataVersionFulu:
+	case spec.DataVersionElectra, spec.DataVersionFulu, spec.DataVersionGloas:
 		var payloadAttributes PayloadAttributesV4
 
 		err = json.Unmarshal(data.Data.PayloadAttributes, &payloadAttributes)
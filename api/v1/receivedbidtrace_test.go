@@ -0,0 +1,211 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestReceivedBidTraceJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.receivedBidTraceJSON",
+		},
+		{
+			name:  "SlotMissing",
+			input: []byte(`{"parent_hash":"0x0101010101010101010101010101010101010101010101010101010101010101","block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","builder_pubkey":"0x030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"12345678","value":"123456789012345678","block_number":"1000","num_tx":"150","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":true}`),
+			err:   "slot missing",
+		},
+		{
+			name:  "SlotInvalid",
+			input: []byte(`{"slot":"-1","parent_hash":"0x0101010101010101010101010101010101010101010101010101010101010101","block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","builder_pubkey":"0x030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"12345678","value":"123456789012345678","block_number":"1000","num_tx":"150","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":true}`),
+			err:   "invalid value for slot: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "ParentHashMissing",
+			input: []byte(`{"slot":"123","block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","builder_pubkey":"0x030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"12345678","value":"123456789012345678","block_number":"1000","num_tx":"150","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":true}`),
+			err:   "parent hash missing",
+		},
+		{
+			name:  "ParentHashInvalid",
+			input: []byte(`{"slot":"123","parent_hash":"invalid","block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","builder_pubkey":"0x030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"12345678","value":"123456789012345678","block_number":"1000","num_tx":"150","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":true}`),
+			err:   "invalid value for parent hash: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "ParentHashShort",
+			input: []byte(`{"slot":"123","parent_hash":"0x01010101010101010101010101010101010101010101010101010101010101","block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","builder_pubkey":"0x030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"12345678","value":"123456789012345678","block_number":"1000","num_tx":"150","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":true}`),
+			err:   "invalid value for parent hash: incorrect length",
+		},
+		{
+			name:  "BlockHashMissing",
+			input: []byte(`{"slot":"123","parent_hash":"0x0101010101010101010101010101010101010101010101010101010101010101","builder_pubkey":"0x030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"12345678","value":"123456789012345678","block_number":"1000","num_tx":"150","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":true}`),
+			err:   "block hash missing",
+		},
+		{
+			name:  "BlockHashInvalid",
+			input: []byte(`{"slot":"123","parent_hash":"0x0101010101010101010101010101010101010101010101010101010101010101","block_hash":"invalid","builder_pubkey":"0x030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"12345678","value":"123456789012345678","block_number":"1000","num_tx":"150","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":true}`),
+			err:   "invalid value for block hash: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "BlockHashShort",
+			input: []byte(`{"slot":"123","parent_hash":"0x0101010101010101010101010101010101010101010101010101010101010101","block_hash":"0x02020202020202020202020202020202020202020202020202020202020202","builder_pubkey":"0x030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"12345678","value":"123456789012345678","block_number":"1000","num_tx":"150","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":true}`),
+			err:   "invalid value for block hash: incorrect length",
+		},
+		{
+			name:  "BuilderPubkeyMissing",
+			input: []byte(`{"slot":"123","parent_hash":"0x0101010101010101010101010101010101010101010101010101010101010101","block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","proposer_pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"12345678","value":"123456789012345678","block_number":"1000","num_tx":"150","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":true}`),
+			err:   "builder public key missing",
+		},
+		{
+			name:  "BuilderPubkeyInvalid",
+			input: []byte(`{"slot":"123","parent_hash":"0x0101010101010101010101010101010101010101010101010101010101010101","block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","builder_pubkey":"invalid","proposer_pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"12345678","value":"123456789012345678","block_number":"1000","num_tx":"150","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":true}`),
+			err:   "invalid value for builder public key: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "BuilderPubkeyShort",
+			input: []byte(`{"slot":"123","parent_hash":"0x0101010101010101010101010101010101010101010101010101010101010101","block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","builder_pubkey":"0x0303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"12345678","value":"123456789012345678","block_number":"1000","num_tx":"150","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":true}`),
+			err:   "invalid value for builder public key: incorrect length",
+		},
+		{
+			name:  "ProposerPubkeyMissing",
+			input: []byte(`{"slot":"123","parent_hash":"0x0101010101010101010101010101010101010101010101010101010101010101","block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","builder_pubkey":"0x030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"12345678","value":"123456789012345678","block_number":"1000","num_tx":"150","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":true}`),
+			err:   "proposer public key missing",
+		},
+		{
+			name:  "ProposerPubkeyInvalid",
+			input: []byte(`{"slot":"123","parent_hash":"0x0101010101010101010101010101010101010101010101010101010101010101","block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","builder_pubkey":"0x030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_pubkey":"invalid","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"12345678","value":"123456789012345678","block_number":"1000","num_tx":"150","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":true}`),
+			err:   "invalid value for proposer public key: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "ProposerPubkeyShort",
+			input: []byte(`{"slot":"123","parent_hash":"0x0101010101010101010101010101010101010101010101010101010101010101","block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","builder_pubkey":"0x030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_pubkey":"0x0404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"12345678","value":"123456789012345678","block_number":"1000","num_tx":"150","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":true}`),
+			err:   "invalid value for proposer public key: incorrect length",
+		},
+		{
+			name:  "ProposerFeeRecipientMissing",
+			input: []byte(`{"slot":"123","parent_hash":"0x0101010101010101010101010101010101010101010101010101010101010101","block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","builder_pubkey":"0x030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","gas_limit":"30000000","gas_used":"12345678","value":"123456789012345678","block_number":"1000","num_tx":"150","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":true}`),
+			err:   "proposer fee recipient missing",
+		},
+		{
+			name:  "ProposerFeeRecipientInvalid",
+			input: []byte(`{"slot":"123","parent_hash":"0x0101010101010101010101010101010101010101010101010101010101010101","block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","builder_pubkey":"0x030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","proposer_fee_recipient":"invalid","gas_limit":"30000000","gas_used":"12345678","value":"123456789012345678","block_number":"1000","num_tx":"150","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":true}`),
+			err:   "invalid value for proposer fee recipient: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "ProposerFeeRecipientShort",
+			input: []byte(`{"slot":"123","parent_hash":"0x0101010101010101010101010101010101010101010101010101010101010101","block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","builder_pubkey":"0x030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f101112","gas_limit":"30000000","gas_used":"12345678","value":"123456789012345678","block_number":"1000","num_tx":"150","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":true}`),
+			err:   "invalid value for proposer fee recipient: incorrect length",
+		},
+		{
+			name:  "GasLimitMissing",
+			input: []byte(`{"slot":"123","parent_hash":"0x0101010101010101010101010101010101010101010101010101010101010101","block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","builder_pubkey":"0x030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_used":"12345678","value":"123456789012345678","block_number":"1000","num_tx":"150","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":true}`),
+			err:   "gas limit missing",
+		},
+		{
+			name:  "GasLimitInvalid",
+			input: []byte(`{"slot":"123","parent_hash":"0x0101010101010101010101010101010101010101010101010101010101010101","block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","builder_pubkey":"0x030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"-1","gas_used":"12345678","value":"123456789012345678","block_number":"1000","num_tx":"150","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":true}`),
+			err:   "invalid value for gas limit: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "GasUsedMissing",
+			input: []byte(`{"slot":"123","parent_hash":"0x0101010101010101010101010101010101010101010101010101010101010101","block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","builder_pubkey":"0x030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","value":"123456789012345678","block_number":"1000","num_tx":"150","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":true}`),
+			err:   "gas used missing",
+		},
+		{
+			name:  "GasUsedInvalid",
+			input: []byte(`{"slot":"123","parent_hash":"0x0101010101010101010101010101010101010101010101010101010101010101","block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","builder_pubkey":"0x030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"-1","value":"123456789012345678","block_number":"1000","num_tx":"150","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":true}`),
+			err:   "invalid value for gas used: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "ValueMissing",
+			input: []byte(`{"slot":"123","parent_hash":"0x0101010101010101010101010101010101010101010101010101010101010101","block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","builder_pubkey":"0x030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"12345678","block_number":"1000","num_tx":"150","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":true}`),
+			err:   "value missing",
+		},
+		{
+			name:  "ValueInvalid",
+			input: []byte(`{"slot":"123","parent_hash":"0x0101010101010101010101010101010101010101010101010101010101010101","block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","builder_pubkey":"0x030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"12345678","value":"-1","block_number":"1000","num_tx":"150","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":true}`),
+			err:   "invalid value for value: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "BlockNumberMissing",
+			input: []byte(`{"slot":"123","parent_hash":"0x0101010101010101010101010101010101010101010101010101010101010101","block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","builder_pubkey":"0x030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"12345678","value":"123456789012345678","num_tx":"150","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":true}`),
+			err:   "block number missing",
+		},
+		{
+			name:  "BlockNumberInvalid",
+			input: []byte(`{"slot":"123","parent_hash":"0x0101010101010101010101010101010101010101010101010101010101010101","block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","builder_pubkey":"0x030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"12345678","value":"123456789012345678","block_number":"-1","num_tx":"150","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":true}`),
+			err:   "invalid value for block number: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "NumTxMissing",
+			input: []byte(`{"slot":"123","parent_hash":"0x0101010101010101010101010101010101010101010101010101010101010101","block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","builder_pubkey":"0x030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"12345678","value":"123456789012345678","block_number":"1000","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":true}`),
+			err:   "number of transactions missing",
+		},
+		{
+			name:  "NumTxInvalid",
+			input: []byte(`{"slot":"123","parent_hash":"0x0101010101010101010101010101010101010101010101010101010101010101","block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","builder_pubkey":"0x030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"12345678","value":"123456789012345678","block_number":"1000","num_tx":"-1","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":true}`),
+			err:   "invalid value for number of transactions: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "TimestampMissing",
+			input: []byte(`{"slot":"123","parent_hash":"0x0101010101010101010101010101010101010101010101010101010101010101","block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","builder_pubkey":"0x030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"12345678","value":"123456789012345678","block_number":"1000","num_tx":"150","optimistic_submission":true}`),
+			err:   "timestamp missing",
+		},
+		{
+			name:  "TimestampInvalid",
+			input: []byte(`{"slot":"123","parent_hash":"0x0101010101010101010101010101010101010101010101010101010101010101","block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","builder_pubkey":"0x030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"12345678","value":"123456789012345678","block_number":"1000","num_tx":"150","timestamp":"bad","optimistic_submission":true}`),
+			err:   "invalid value for timestamp: strconv.ParseInt: parsing \"bad\": invalid syntax",
+		},
+		{
+			name:  "TimestampMsInvalid",
+			input: []byte(`{"slot":"123","parent_hash":"0x0101010101010101010101010101010101010101010101010101010101010101","block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","builder_pubkey":"0x030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"12345678","value":"123456789012345678","block_number":"1000","num_tx":"150","timestamp":"1700000000","timestamp_ms":"bad","optimistic_submission":true}`),
+			err:   "invalid value for timestamp ms: strconv.ParseInt: parsing \"bad\": invalid syntax",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"slot":"123","parent_hash":"0x0101010101010101010101010101010101010101010101010101010101010101","block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","builder_pubkey":"0x030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"12345678","value":"123456789012345678","block_number":"1000","num_tx":"150","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":true}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.ReceivedBidTrace
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
@@ -27,11 +27,11 @@ import (
 // SyncCommitteeDuty is the data regarding which validators have the duty to contribute to sync committees in a slot.
 type SyncCommitteeDuty struct {
 	// PubKey is the public key of the validator that should contribute.
-	PubKey phase0.BLSPubKey
+	PubKey phase0.BLSPubKey `ssz-size:"48"`
 	// ValidatorIndex is the index of the validator that should contribute.
 	ValidatorIndex phase0.ValidatorIndex
 	// ValidatorSyncCommitteeIndices is the index of the validator in the list of validators in the committee.
-	ValidatorSyncCommitteeIndices []phase0.CommitteeIndex
+	ValidatorSyncCommitteeIndices []phase0.CommitteeIndex `dynssz-max:"SYNC_COMMITTEE_SIZE" ssz-max:"512"`
 }
 
 // syncCommitteeDutyJSON is the spec representation of the struct.
@@ -119,3 +119,18 @@ func TestSyncCommitteeDutyJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestSyncCommitteeDutySSZ(t *testing.T) {
+	input := []byte(`{"pubkey":"0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b","validator_index":"1","validator_sync_committee_indices":["2","3","4"]}`)
+
+	var datum api.SyncCommitteeDuty
+	require.NoError(t, json.Unmarshal(input, &datum))
+
+	data, err := datum.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, datum.SizeSSZ())
+
+	var res api.SyncCommitteeDuty
+	require.NoError(t, res.UnmarshalSSZ(data))
+	assert.Equal(t, datum, res)
+}
@@ -39,6 +39,16 @@ type validatorJSON struct {
 	Validator *phase0.Validator `json:"validator"`
 }
 
+// validatorSSZ is the SSZ representation of the struct.  Status is encoded as
+// its position in the list of spec validator statuses, starting at
+// pending_initialized.
+type validatorSSZ struct {
+	Index     phase0.ValidatorIndex
+	Balance   phase0.Gwei
+	Status    uint8
+	Validator *phase0.Validator
+}
+
 // MarshalJSON implements json.Marshaler.
 func (v *Validator) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&validatorJSON{
@@ -90,6 +100,50 @@ func (v *Validator) UnmarshalJSON(input []byte) error {
 	return nil
 }
 
+// MarshalSSZ marshals the validator to SSZ-encoded bytes.
+func (v *Validator) MarshalSSZ() ([]byte, error) {
+	return v.MarshalSSZTo(nil)
+}
+
+// MarshalSSZTo marshals the validator to SSZ-encoded bytes, appending to the provided buffer.
+func (v *Validator) MarshalSSZTo(buf []byte) ([]byte, error) {
+	if !v.Status.valid() || v.Status == ValidatorStateUnknown {
+		return nil, fmt.Errorf("invalid status %d", v.Status)
+	}
+
+	return (&validatorSSZ{
+		Index:     v.Index,
+		Balance:   v.Balance,
+		Status:    uint8(v.Status - 1),
+		Validator: v.Validator,
+	}).MarshalSSZTo(buf)
+}
+
+// UnmarshalSSZ unmarshals the validator from SSZ-encoded bytes.
+func (v *Validator) UnmarshalSSZ(buf []byte) error {
+	var data validatorSSZ
+	if err := data.UnmarshalSSZ(buf); err != nil {
+		return err
+	}
+
+	status := ValidatorState(data.Status) + 1
+	if !status.valid() {
+		return fmt.Errorf("invalid status %d", data.Status)
+	}
+
+	v.Index = data.Index
+	v.Balance = data.Balance
+	v.Status = status
+	v.Validator = data.Validator
+
+	return nil
+}
+
+// SizeSSZ returns the size of the SSZ-encoded validator.
+func (*Validator) SizeSSZ() int {
+	return (&validatorSSZ{}).SizeSSZ()
+}
+
 // String returns a string version of the structure.
 func (v *Validator) String() string {
 	data, err := json.Marshal(v)
@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	require "github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/assert"
 )
@@ -114,3 +115,28 @@ func TestValidatorJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatorSSZ(t *testing.T) {
+	input := []byte(`{"index":"1","balance":"32000000000","status":"active_ongoing","validator":{"pubkey":"0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b","withdrawal_credentials":"0x00ec7ef7780c9d151597924036262dd28dc60e1228f4da6fecf9d402cb3f3594","effective_balance":"32000000000","slashed":false,"activation_eligibility_epoch":"0","activation_epoch":"0","exit_epoch":"18446744073709551615","withdrawable_epoch":"18446744073709551615"}}`)
+
+	var datum api.Validator
+	require.NoError(t, json.Unmarshal(input, &datum))
+
+	data, err := datum.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, datum.SizeSSZ())
+
+	var res api.Validator
+	require.NoError(t, res.UnmarshalSSZ(data))
+	assert.Equal(t, datum, res)
+}
+
+func TestValidatorSSZInvalidStatus(t *testing.T) {
+	_, err := (&api.Validator{Validator: &phase0.Validator{}}).MarshalSSZ()
+	require.EqualError(t, err, "invalid status 0")
+
+	var res api.Validator
+	data := make([]byte, res.SizeSSZ())
+	data[16] = 0xff
+	require.EqualError(t, res.UnmarshalSSZ(data), "invalid status 255")
+}
@@ -89,3 +89,18 @@ func TestValidatorBalanceJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatorBalanceSSZ(t *testing.T) {
+	input := []byte(`{"index":"1","balance":"32000000000"}`)
+
+	var datum api.ValidatorBalance
+	require.NoError(t, json.Unmarshal(input, &datum))
+
+	data, err := datum.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, datum.SizeSSZ())
+
+	var res api.ValidatorBalance
+	require.NoError(t, res.UnmarshalSSZ(data))
+	assert.Equal(t, datum, res)
+}
@@ -0,0 +1,100 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ValidatorIdentity contains the minimal identifying information for a validator.
+type ValidatorIdentity struct {
+	// Index is the index of the validator.
+	Index phase0.ValidatorIndex
+	// Pubkey is the public key of the validator.
+	Pubkey phase0.BLSPubKey `ssz-size:"48"`
+	// ActivationEpoch is the epoch at which the validator was activated.
+	ActivationEpoch phase0.Epoch
+}
+
+// validatorIdentityJSON is the spec representation of the struct.
+type validatorIdentityJSON struct {
+	Index           string `json:"index"`
+	Pubkey          string `json:"pubkey"`
+	ActivationEpoch string `json:"activation_epoch"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v *ValidatorIdentity) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&validatorIdentityJSON{
+		Index:           fmt.Sprintf("%d", v.Index),
+		Pubkey:          fmt.Sprintf("%#x", v.Pubkey),
+		ActivationEpoch: fmt.Sprintf("%d", v.ActivationEpoch),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *ValidatorIdentity) UnmarshalJSON(input []byte) error {
+	var err error
+
+	var validatorIdentityJSON validatorIdentityJSON
+	if err = json.Unmarshal(input, &validatorIdentityJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	if validatorIdentityJSON.Index == "" {
+		return errors.New("index missing")
+	}
+	index, err := strconv.ParseUint(validatorIdentityJSON.Index, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for index")
+	}
+	v.Index = phase0.ValidatorIndex(index)
+	if validatorIdentityJSON.Pubkey == "" {
+		return errors.New("public key missing")
+	}
+	pubKey, err := hex.DecodeString(strings.TrimPrefix(validatorIdentityJSON.Pubkey, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for public key")
+	}
+	if len(pubKey) != publicKeyLength {
+		return errors.New("incorrect length for public key")
+	}
+	copy(v.Pubkey[:], pubKey)
+	if validatorIdentityJSON.ActivationEpoch == "" {
+		return errors.New("activation epoch missing")
+	}
+	activationEpoch, err := strconv.ParseUint(validatorIdentityJSON.ActivationEpoch, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for activation epoch")
+	}
+	v.ActivationEpoch = phase0.Epoch(activationEpoch)
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (v *ValidatorIdentity) String() string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,131 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestValidatorIdentityJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.validatorIdentityJSON",
+		},
+		{
+			name:  "IndexMissing",
+			input: []byte(`{"pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","activation_epoch":"2"}`),
+			err:   "index missing",
+		},
+		{
+			name:  "IndexWrongType",
+			input: []byte(`{"index":true,"pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","activation_epoch":"2"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field validatorIdentityJSON.index of type string",
+		},
+		{
+			name:  "IndexInvalid",
+			input: []byte(`{"index":"-1","pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","activation_epoch":"2"}`),
+			err:   "invalid value for index: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "PubkeyMissing",
+			input: []byte(`{"index":"1","activation_epoch":"2"}`),
+			err:   "public key missing",
+		},
+		{
+			name:  "PubkeyWrongType",
+			input: []byte(`{"index":"1","pubkey":true,"activation_epoch":"2"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field validatorIdentityJSON.pubkey of type string",
+		},
+		{
+			name:  "PubkeyInvalid",
+			input: []byte(`{"index":"1","pubkey":"invalid","activation_epoch":"2"}`),
+			err:   "invalid value for public key: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "PubkeyShort",
+			input: []byte(`{"index":"1","pubkey":"0x9a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","activation_epoch":"2"}`),
+			err:   "incorrect length for public key",
+		},
+		{
+			name:  "PubkeyLong",
+			input: []byte(`{"index":"1","pubkey":"0xa9a99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","activation_epoch":"2"}`),
+			err:   "incorrect length for public key",
+		},
+		{
+			name:  "ActivationEpochMissing",
+			input: []byte(`{"index":"1","pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c"}`),
+			err:   "activation epoch missing",
+		},
+		{
+			name:  "ActivationEpochWrongType",
+			input: []byte(`{"index":"1","pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","activation_epoch":true}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field validatorIdentityJSON.activation_epoch of type string",
+		},
+		{
+			name:  "ActivationEpochInvalid",
+			input: []byte(`{"index":"1","pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","activation_epoch":"-1"}`),
+			err:   "invalid value for activation epoch: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"index":"1","pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","activation_epoch":"2"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.ValidatorIdentity
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
+
+func TestValidatorIdentitySSZ(t *testing.T) {
+	input := []byte(`{"index":"1","pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","activation_epoch":"2"}`)
+
+	var identity api.ValidatorIdentity
+	require.NoError(t, json.Unmarshal(input, &identity))
+
+	data, err := identity.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, identity.SizeSSZ())
+
+	var res api.ValidatorIdentity
+	require.NoError(t, res.UnmarshalSSZ(data))
+	assert.Equal(t, identity, res)
+}
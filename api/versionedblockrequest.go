@@ -20,6 +20,7 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
 	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/gloas"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -31,6 +32,7 @@ type VersionedBlockRequest struct {
 	Deneb     *deneb.SignedBeaconBlock
 	Electra   *electra.SignedBeaconBlock
 	Fulu      *electra.SignedBeaconBlock
+	Gloas     *gloas.SignedBeaconBlock
 }
 
 // Slot returns the slot of the signed beacon block.
@@ -71,6 +73,13 @@ func (v *VersionedBlockRequest) Slot() (phase0.Slot, error) {
 		}
 
 		return v.Fulu.Message.Slot, nil
+	case spec.DataVersionGloas:
+		if v.Gloas == nil ||
+			v.Gloas.Message == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Gloas.Message.Slot, nil
 	default:
 		return 0, ErrUnsupportedVersion
 	}
@@ -124,6 +133,16 @@ func (v *VersionedBlockRequest) ExecutionBlockHash() (phase0.Hash32, error) {
 		}
 
 		return v.Fulu.Message.Body.ExecutionPayload.BlockHash, nil
+	case spec.DataVersionGloas:
+		if v.Gloas == nil ||
+			v.Gloas.Message == nil ||
+			v.Gloas.Message.Body == nil ||
+			v.Gloas.Message.Body.SignedExecutionPayloadBid == nil ||
+			v.Gloas.Message.Body.SignedExecutionPayloadBid.Message == nil {
+			return phase0.Hash32{}, ErrDataMissing
+		}
+
+		return v.Gloas.Message.Body.SignedExecutionPayloadBid.Message.BlockHash, nil
 	default:
 		return phase0.Hash32{}, ErrUnsupportedVersion
 	}
@@ -211,6 +230,22 @@ func (v *VersionedBlockRequest) Attestations() ([]spec.VersionedAttestation, err
 			}
 		}
 
+		return versionedAttestations, nil
+	case spec.DataVersionGloas:
+		if v.Gloas == nil ||
+			v.Gloas.Message == nil ||
+			v.Gloas.Message.Body == nil {
+			return nil, ErrDataMissing
+		}
+
+		versionedAttestations := make([]spec.VersionedAttestation, len(v.Gloas.Message.Body.Attestations))
+		for i, attestation := range v.Gloas.Message.Body.Attestations {
+			versionedAttestations[i] = spec.VersionedAttestation{
+				Version: spec.DataVersionGloas,
+				Gloas:   attestation,
+			}
+		}
+
 		return versionedAttestations, nil
 	default:
 		return nil, ErrUnsupportedVersion
@@ -255,6 +290,13 @@ func (v *VersionedBlockRequest) Root() (phase0.Root, error) {
 		}
 
 		return v.Fulu.Message.HashTreeRoot()
+	case spec.DataVersionGloas:
+		if v.Gloas == nil ||
+			v.Gloas.Message == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Gloas.Message.HashTreeRoot()
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -303,6 +345,14 @@ func (v *VersionedBlockRequest) BodyRoot() (phase0.Root, error) {
 		}
 
 		return v.Fulu.Message.Body.HashTreeRoot()
+	case spec.DataVersionGloas:
+		if v.Gloas == nil ||
+			v.Gloas.Message == nil ||
+			v.Gloas.Message.Body == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Gloas.Message.Body.HashTreeRoot()
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -346,6 +396,13 @@ func (v *VersionedBlockRequest) ParentRoot() (phase0.Root, error) {
 		}
 
 		return v.Fulu.Message.ParentRoot, nil
+	case spec.DataVersionGloas:
+		if v.Gloas == nil ||
+			v.Gloas.Message == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Gloas.Message.ParentRoot, nil
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -389,6 +446,13 @@ func (v *VersionedBlockRequest) StateRoot() (phase0.Root, error) {
 		}
 
 		return v.Fulu.Message.StateRoot, nil
+	case spec.DataVersionGloas:
+		if v.Gloas == nil ||
+			v.Gloas.Message == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Gloas.Message.StateRoot, nil
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -476,6 +540,22 @@ func (v *VersionedBlockRequest) AttesterSlashings() ([]spec.VersionedAttesterSla
 			}
 		}
 
+		return versionedAttesterSlashings, nil
+	case spec.DataVersionGloas:
+		if v.Gloas == nil ||
+			v.Gloas.Message == nil ||
+			v.Gloas.Message.Body == nil {
+			return nil, ErrDataMissing
+		}
+
+		versionedAttesterSlashings := make([]spec.VersionedAttesterSlashing, len(v.Gloas.Message.Body.AttesterSlashings))
+		for i, attesterSlashing := range v.Gloas.Message.Body.AttesterSlashings {
+			versionedAttesterSlashings[i] = spec.VersionedAttesterSlashing{
+				Version: spec.DataVersionGloas,
+				Gloas:   attesterSlashing,
+			}
+		}
+
 		return versionedAttesterSlashings, nil
 	default:
 		return nil, ErrUnsupportedVersion
@@ -525,6 +605,14 @@ func (v *VersionedBlockRequest) ProposerSlashings() ([]*phase0.ProposerSlashing,
 		}
 
 		return v.Fulu.Message.Body.ProposerSlashings, nil
+	case spec.DataVersionGloas:
+		if v.Gloas == nil ||
+			v.Gloas.Message == nil ||
+			v.Gloas.Message.Body == nil {
+			return nil, ErrDataMissing
+		}
+
+		return v.Gloas.Message.Body.ProposerSlashings, nil
 	default:
 		return nil, ErrUnsupportedVersion
 	}
@@ -573,6 +661,14 @@ func (v *VersionedBlockRequest) SyncAggregate() (*altair.SyncAggregate, error) {
 		}
 
 		return v.Fulu.Message.Body.SyncAggregate, nil
+	case spec.DataVersionGloas:
+		if v.Gloas == nil ||
+			v.Gloas.Message == nil ||
+			v.Gloas.Message.Body == nil {
+			return nil, ErrDataMissing
+		}
+
+		return v.Gloas.Message.Body.SyncAggregate, nil
 	default:
 		return nil, ErrUnsupportedVersion
 	}
@@ -611,6 +707,12 @@ func (v *VersionedBlockRequest) String() string {
 		}
 
 		return v.Fulu.String()
+	case spec.DataVersionGloas:
+		if v.Gloas == nil {
+			return ""
+		}
+
+		return v.Gloas.String()
 	default:
 		return "unsupported version"
 	}
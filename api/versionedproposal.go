@@ -26,6 +26,7 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/gloas"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -47,6 +48,7 @@ type VersionedProposal struct {
 	ElectraBlinded   *apiv1electra.BlindedBeaconBlock
 	Fulu             *apiv1fulu.BlockContents
 	FuluBlinded      *apiv1electra.BlindedBeaconBlock
+	Gloas            *gloas.BeaconBlock
 }
 
 // IsEmpty returns true if there is no proposal.
@@ -62,7 +64,8 @@ func (v *VersionedProposal) IsEmpty() bool {
 		v.Electra == nil &&
 		v.ElectraBlinded == nil &&
 		v.Fulu == nil &&
-		v.FuluBlinded == nil
+		v.FuluBlinded == nil &&
+		v.Gloas == nil
 }
 
 // BodyRoot returns the body root of the proposal.
@@ -106,6 +109,8 @@ func (v *VersionedProposal) BodyRoot() (phase0.Root, error) {
 		}
 
 		return v.Fulu.Block.Body.HashTreeRoot()
+	case spec.DataVersionGloas:
+		return v.Gloas.Body.HashTreeRoot()
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -152,6 +157,8 @@ func (v *VersionedProposal) ParentRoot() (phase0.Root, error) {
 		}
 
 		return v.Fulu.Block.ParentRoot, nil
+	case spec.DataVersionGloas:
+		return v.Gloas.ParentRoot, nil
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -198,6 +205,8 @@ func (v *VersionedProposal) ProposerIndex() (phase0.ValidatorIndex, error) {
 		}
 
 		return v.Fulu.Block.ProposerIndex, nil
+	case spec.DataVersionGloas:
+		return v.Gloas.ProposerIndex, nil
 	default:
 		return 0, ErrUnsupportedVersion
 	}
@@ -244,6 +253,8 @@ func (v *VersionedProposal) Root() (phase0.Root, error) {
 		}
 
 		return v.Fulu.Block.HashTreeRoot()
+	case spec.DataVersionGloas:
+		return v.Gloas.HashTreeRoot()
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -290,6 +301,8 @@ func (v *VersionedProposal) Slot() (phase0.Slot, error) {
 		}
 
 		return v.Fulu.Block.Slot, nil
+	case spec.DataVersionGloas:
+		return v.Gloas.Slot, nil
 	default:
 		return 0, ErrUnsupportedVersion
 	}
@@ -336,6 +349,8 @@ func (v *VersionedProposal) StateRoot() (phase0.Root, error) {
 		}
 
 		return v.Fulu.Block.StateRoot, nil
+	case spec.DataVersionGloas:
+		return v.Gloas.StateRoot, nil
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -477,6 +492,16 @@ func (v *VersionedProposal) Attestations() ([]spec.VersionedAttestation, error)
 			}
 		}
 
+		return versionedAttestations, nil
+	case spec.DataVersionGloas:
+		versionedAttestations := make([]spec.VersionedAttestation, len(v.Gloas.Body.Attestations))
+		for i, attestation := range v.Gloas.Body.Attestations {
+			versionedAttestations[i] = spec.VersionedAttestation{
+				Version: spec.DataVersionGloas,
+				Gloas:   attestation,
+			}
+		}
+
 		return versionedAttestations, nil
 	default:
 		return nil, ErrUnsupportedVersion
@@ -524,6 +549,8 @@ func (v *VersionedProposal) Graffiti() ([32]byte, error) {
 		}
 
 		return v.Fulu.Block.Body.Graffiti, nil
+	case spec.DataVersionGloas:
+		return v.Gloas.Body.Graffiti, nil
 	default:
 		return [32]byte{}, ErrUnsupportedVersion
 	}
@@ -570,6 +597,8 @@ func (v *VersionedProposal) RandaoReveal() (phase0.BLSSignature, error) {
 		}
 
 		return v.Fulu.Block.Body.RANDAOReveal, nil
+	case spec.DataVersionGloas:
+		return v.Gloas.Body.RANDAOReveal, nil
 	default:
 		return phase0.BLSSignature{}, ErrUnsupportedVersion
 	}
@@ -654,6 +683,8 @@ func (v *VersionedProposal) FeeRecipient() (bellatrix.ExecutionAddress, error) {
 		}
 
 		return v.Fulu.Block.Body.ExecutionPayload.FeeRecipient, nil
+	case spec.DataVersionGloas:
+		return v.Gloas.Body.SignedExecutionPayloadBid.Message.FeeRecipient, nil
 	default:
 		return bellatrix.ExecutionAddress{}, ErrUnsupportedVersion
 	}
@@ -738,6 +769,8 @@ func (v *VersionedProposal) GasLimit() (uint64, error) {
 		}
 
 		return v.Fulu.Block.Body.ExecutionPayload.GasLimit, nil
+	case spec.DataVersionGloas:
+		return v.Gloas.Body.SignedExecutionPayloadBid.Message.GasLimit, nil
 	default:
 		return 0, ErrUnsupportedVersion
 	}
@@ -862,6 +895,12 @@ func (v *VersionedProposal) String() string {
 		}
 
 		return v.Fulu.String()
+	case spec.DataVersionGloas:
+		if v.Gloas == nil {
+			return ""
+		}
+
+		return v.Gloas.String()
 	default:
 		return "unknown version"
 	}
@@ -903,6 +942,8 @@ func (v *VersionedProposal) proposalPresent() bool {
 		}
 
 		return v.Fulu.Block != nil
+	case spec.DataVersionGloas:
+		return v.Gloas != nil
 	}
 
 	return false
@@ -944,6 +985,8 @@ func (v *VersionedProposal) bodyPresent() bool {
 		}
 
 		return v.Fulu != nil && v.Fulu.Block != nil && v.Fulu.Block.Body != nil
+	case spec.DataVersionGloas:
+		return v.Gloas != nil && v.Gloas.Body != nil
 	}
 
 	return false
@@ -989,6 +1032,11 @@ func (v *VersionedProposal) payloadPresent() bool {
 		}
 
 		return v.Fulu != nil && v.Fulu.Block != nil && v.Fulu.Block.Body != nil && v.Fulu.Block.Body.ExecutionPayload != nil
+	case spec.DataVersionGloas:
+		return v.Gloas != nil &&
+			v.Gloas.Body != nil &&
+			v.Gloas.Body.SignedExecutionPayloadBid != nil &&
+			v.Gloas.Body.SignedExecutionPayloadBid.Message != nil
 	}
 
 	return false
@@ -0,0 +1,695 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+)
+
+// VersionedSignedBuilderBid contains a versioned signed builder bid.
+type VersionedSignedBuilderBid struct {
+	Version   spec.DataVersion
+	Bellatrix *apiv1bellatrix.SignedBuilderBid
+	Capella   *apiv1capella.SignedBuilderBid
+	Deneb     *apiv1deneb.SignedBuilderBid
+	Electra   *apiv1electra.SignedBuilderBid
+	Fulu      *apiv1electra.SignedBuilderBid
+}
+
+// IsEmpty returns true if there is no bid.
+func (v *VersionedSignedBuilderBid) IsEmpty() bool {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		return v.Bellatrix == nil
+	case spec.DataVersionCapella:
+		return v.Capella == nil
+	case spec.DataVersionDeneb:
+		return v.Deneb == nil
+	case spec.DataVersionElectra:
+		return v.Electra == nil
+	case spec.DataVersionFulu:
+		return v.Fulu == nil
+	default:
+		return true
+	}
+}
+
+// Builder returns the builder public key of the bid.
+func (v *VersionedSignedBuilderBid) Builder() (phase0.BLSPubKey, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil ||
+			v.Bellatrix.Message == nil {
+			return phase0.BLSPubKey{}, ErrDataMissing
+		}
+
+		return v.Bellatrix.Message.Pubkey, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil ||
+			v.Capella.Message == nil {
+			return phase0.BLSPubKey{}, ErrDataMissing
+		}
+
+		return v.Capella.Message.Pubkey, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil ||
+			v.Deneb.Message == nil {
+			return phase0.BLSPubKey{}, ErrDataMissing
+		}
+
+		return v.Deneb.Message.Pubkey, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil {
+			return phase0.BLSPubKey{}, ErrDataMissing
+		}
+
+		return v.Electra.Message.Pubkey, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Message == nil {
+			return phase0.BLSPubKey{}, ErrDataMissing
+		}
+
+		return v.Fulu.Message.Pubkey, nil
+	default:
+		return phase0.BLSPubKey{}, ErrUnsupportedVersion
+	}
+}
+
+// Value returns the value of the bid.
+func (v *VersionedSignedBuilderBid) Value() (*uint256.Int, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil ||
+			v.Bellatrix.Message == nil {
+			return nil, ErrDataMissing
+		}
+
+		return v.Bellatrix.Message.Value, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil ||
+			v.Capella.Message == nil {
+			return nil, ErrDataMissing
+		}
+
+		return v.Capella.Message.Value, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil ||
+			v.Deneb.Message == nil {
+			return nil, ErrDataMissing
+		}
+
+		return v.Deneb.Message.Value, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil {
+			return nil, ErrDataMissing
+		}
+
+		return v.Electra.Message.Value, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Message == nil {
+			return nil, ErrDataMissing
+		}
+
+		return v.Fulu.Message.Value, nil
+	default:
+		return nil, ErrUnsupportedVersion
+	}
+}
+
+// ParentHash returns the parent hash of the execution payload header of the bid.
+func (v *VersionedSignedBuilderBid) ParentHash() (phase0.Hash32, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil ||
+			v.Bellatrix.Message == nil ||
+			v.Bellatrix.Message.Header == nil {
+			return phase0.Hash32{}, ErrDataMissing
+		}
+
+		return v.Bellatrix.Message.Header.ParentHash, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil ||
+			v.Capella.Message == nil ||
+			v.Capella.Message.Header == nil {
+			return phase0.Hash32{}, ErrDataMissing
+		}
+
+		return v.Capella.Message.Header.ParentHash, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil ||
+			v.Deneb.Message == nil ||
+			v.Deneb.Message.Header == nil {
+			return phase0.Hash32{}, ErrDataMissing
+		}
+
+		return v.Deneb.Message.Header.ParentHash, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil ||
+			v.Electra.Message.Header == nil {
+			return phase0.Hash32{}, ErrDataMissing
+		}
+
+		return v.Electra.Message.Header.ParentHash, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Message == nil ||
+			v.Fulu.Message.Header == nil {
+			return phase0.Hash32{}, ErrDataMissing
+		}
+
+		return v.Fulu.Message.Header.ParentHash, nil
+	default:
+		return phase0.Hash32{}, ErrUnsupportedVersion
+	}
+}
+
+// BlockHash returns the block hash of the execution payload header of the bid.
+func (v *VersionedSignedBuilderBid) BlockHash() (phase0.Hash32, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil ||
+			v.Bellatrix.Message == nil ||
+			v.Bellatrix.Message.Header == nil {
+			return phase0.Hash32{}, ErrDataMissing
+		}
+
+		return v.Bellatrix.Message.Header.BlockHash, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil ||
+			v.Capella.Message == nil ||
+			v.Capella.Message.Header == nil {
+			return phase0.Hash32{}, ErrDataMissing
+		}
+
+		return v.Capella.Message.Header.BlockHash, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil ||
+			v.Deneb.Message == nil ||
+			v.Deneb.Message.Header == nil {
+			return phase0.Hash32{}, ErrDataMissing
+		}
+
+		return v.Deneb.Message.Header.BlockHash, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil ||
+			v.Electra.Message.Header == nil {
+			return phase0.Hash32{}, ErrDataMissing
+		}
+
+		return v.Electra.Message.Header.BlockHash, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Message == nil ||
+			v.Fulu.Message.Header == nil {
+			return phase0.Hash32{}, ErrDataMissing
+		}
+
+		return v.Fulu.Message.Header.BlockHash, nil
+	default:
+		return phase0.Hash32{}, ErrUnsupportedVersion
+	}
+}
+
+// BlockNumber returns the block number of the execution payload header of the bid.
+func (v *VersionedSignedBuilderBid) BlockNumber() (uint64, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil ||
+			v.Bellatrix.Message == nil ||
+			v.Bellatrix.Message.Header == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Bellatrix.Message.Header.BlockNumber, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil ||
+			v.Capella.Message == nil ||
+			v.Capella.Message.Header == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Capella.Message.Header.BlockNumber, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil ||
+			v.Deneb.Message == nil ||
+			v.Deneb.Message.Header == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Deneb.Message.Header.BlockNumber, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil ||
+			v.Electra.Message.Header == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Electra.Message.Header.BlockNumber, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Message == nil ||
+			v.Fulu.Message.Header == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Fulu.Message.Header.BlockNumber, nil
+	default:
+		return 0, ErrUnsupportedVersion
+	}
+}
+
+// FeeRecipient returns the fee recipient of the execution payload header of the bid.
+func (v *VersionedSignedBuilderBid) FeeRecipient() (bellatrix.ExecutionAddress, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil ||
+			v.Bellatrix.Message == nil ||
+			v.Bellatrix.Message.Header == nil {
+			return bellatrix.ExecutionAddress{}, ErrDataMissing
+		}
+
+		return v.Bellatrix.Message.Header.FeeRecipient, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil ||
+			v.Capella.Message == nil ||
+			v.Capella.Message.Header == nil {
+			return bellatrix.ExecutionAddress{}, ErrDataMissing
+		}
+
+		return v.Capella.Message.Header.FeeRecipient, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil ||
+			v.Deneb.Message == nil ||
+			v.Deneb.Message.Header == nil {
+			return bellatrix.ExecutionAddress{}, ErrDataMissing
+		}
+
+		return v.Deneb.Message.Header.FeeRecipient, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil ||
+			v.Electra.Message.Header == nil {
+			return bellatrix.ExecutionAddress{}, ErrDataMissing
+		}
+
+		return v.Electra.Message.Header.FeeRecipient, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Message == nil ||
+			v.Fulu.Message.Header == nil {
+			return bellatrix.ExecutionAddress{}, ErrDataMissing
+		}
+
+		return v.Fulu.Message.Header.FeeRecipient, nil
+	default:
+		return bellatrix.ExecutionAddress{}, ErrUnsupportedVersion
+	}
+}
+
+// GasLimit returns the gas limit of the execution payload header of the bid.
+func (v *VersionedSignedBuilderBid) GasLimit() (uint64, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil ||
+			v.Bellatrix.Message == nil ||
+			v.Bellatrix.Message.Header == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Bellatrix.Message.Header.GasLimit, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil ||
+			v.Capella.Message == nil ||
+			v.Capella.Message.Header == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Capella.Message.Header.GasLimit, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil ||
+			v.Deneb.Message == nil ||
+			v.Deneb.Message.Header == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Deneb.Message.Header.GasLimit, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil ||
+			v.Electra.Message.Header == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Electra.Message.Header.GasLimit, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Message == nil ||
+			v.Fulu.Message.Header == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Fulu.Message.Header.GasLimit, nil
+	default:
+		return 0, ErrUnsupportedVersion
+	}
+}
+
+// Timestamp returns the timestamp of the execution payload header of the bid.
+func (v *VersionedSignedBuilderBid) Timestamp() (uint64, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil ||
+			v.Bellatrix.Message == nil ||
+			v.Bellatrix.Message.Header == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Bellatrix.Message.Header.Timestamp, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil ||
+			v.Capella.Message == nil ||
+			v.Capella.Message.Header == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Capella.Message.Header.Timestamp, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil ||
+			v.Deneb.Message == nil ||
+			v.Deneb.Message.Header == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Deneb.Message.Header.Timestamp, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil ||
+			v.Electra.Message.Header == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Electra.Message.Header.Timestamp, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Message == nil ||
+			v.Fulu.Message.Header == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Fulu.Message.Header.Timestamp, nil
+	default:
+		return 0, ErrUnsupportedVersion
+	}
+}
+
+// TransactionsRoot returns the transactions root of the execution payload header of the bid.
+func (v *VersionedSignedBuilderBid) TransactionsRoot() (phase0.Root, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil ||
+			v.Bellatrix.Message == nil ||
+			v.Bellatrix.Message.Header == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Bellatrix.Message.Header.TransactionsRoot, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil ||
+			v.Capella.Message == nil ||
+			v.Capella.Message.Header == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Capella.Message.Header.TransactionsRoot, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil ||
+			v.Deneb.Message == nil ||
+			v.Deneb.Message.Header == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Deneb.Message.Header.TransactionsRoot, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil ||
+			v.Electra.Message.Header == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Electra.Message.Header.TransactionsRoot, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Message == nil ||
+			v.Fulu.Message.Header == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Fulu.Message.Header.TransactionsRoot, nil
+	default:
+		return phase0.Root{}, ErrUnsupportedVersion
+	}
+}
+
+// BlobKZGCommitments returns the blob KZG commitments of the bid.
+func (v *VersionedSignedBuilderBid) BlobKZGCommitments() ([]deneb.KZGCommitment, error) {
+	switch v.Version {
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil ||
+			v.Deneb.Message == nil {
+			return nil, ErrDataMissing
+		}
+
+		return v.Deneb.Message.BlobKZGCommitments, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil {
+			return nil, ErrDataMissing
+		}
+
+		return v.Electra.Message.BlobKZGCommitments, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Message == nil {
+			return nil, ErrDataMissing
+		}
+
+		return v.Fulu.Message.BlobKZGCommitments, nil
+	default:
+		return nil, ErrUnsupportedVersion
+	}
+}
+
+// ExecutionRequests returns the execution requests of the bid.
+func (v *VersionedSignedBuilderBid) ExecutionRequests() (*electra.ExecutionRequests, error) {
+	switch v.Version {
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil {
+			return nil, ErrDataMissing
+		}
+
+		return v.Electra.Message.ExecutionRequests, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Message == nil {
+			return nil, ErrDataMissing
+		}
+
+		return v.Fulu.Message.ExecutionRequests, nil
+	default:
+		return nil, ErrUnsupportedVersion
+	}
+}
+
+// HeaderHashTreeRoot returns the hash tree root of the execution payload header of the bid.
+func (v *VersionedSignedBuilderBid) HeaderHashTreeRoot() (phase0.Root, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil ||
+			v.Bellatrix.Message == nil ||
+			v.Bellatrix.Message.Header == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Bellatrix.Message.Header.HashTreeRoot()
+	case spec.DataVersionCapella:
+		if v.Capella == nil ||
+			v.Capella.Message == nil ||
+			v.Capella.Message.Header == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Capella.Message.Header.HashTreeRoot()
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil ||
+			v.Deneb.Message == nil ||
+			v.Deneb.Message.Header == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Deneb.Message.Header.HashTreeRoot()
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil ||
+			v.Electra.Message.Header == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Electra.Message.Header.HashTreeRoot()
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Message == nil ||
+			v.Fulu.Message.Header == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Fulu.Message.Header.HashTreeRoot()
+	default:
+		return phase0.Root{}, ErrUnsupportedVersion
+	}
+}
+
+// MessageHashTreeRoot returns the hash tree root of the message of the bid.
+func (v *VersionedSignedBuilderBid) MessageHashTreeRoot() (phase0.Root, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil ||
+			v.Bellatrix.Message == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Bellatrix.Message.HashTreeRoot()
+	case spec.DataVersionCapella:
+		if v.Capella == nil ||
+			v.Capella.Message == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Capella.Message.HashTreeRoot()
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil ||
+			v.Deneb.Message == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Deneb.Message.HashTreeRoot()
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Electra.Message.HashTreeRoot()
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Message == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Fulu.Message.HashTreeRoot()
+	default:
+		return phase0.Root{}, ErrUnsupportedVersion
+	}
+}
+
+// Signature returns the signature of the bid.
+func (v *VersionedSignedBuilderBid) Signature() (phase0.BLSSignature, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return phase0.BLSSignature{}, ErrDataMissing
+		}
+
+		return v.Bellatrix.Signature, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return phase0.BLSSignature{}, ErrDataMissing
+		}
+
+		return v.Capella.Signature, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil {
+			return phase0.BLSSignature{}, ErrDataMissing
+		}
+
+		return v.Deneb.Signature, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.BLSSignature{}, ErrDataMissing
+		}
+
+		return v.Electra.Signature, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil {
+			return phase0.BLSSignature{}, ErrDataMissing
+		}
+
+		return v.Fulu.Signature, nil
+	default:
+		return phase0.BLSSignature{}, ErrUnsupportedVersion
+	}
+}
+
+// String returns a string version of the structure.
+func (v *VersionedSignedBuilderBid) String() string {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return ""
+		}
+
+		return v.Bellatrix.String()
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return ""
+		}
+
+		return v.Capella.String()
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil {
+			return ""
+		}
+
+		return v.Deneb.String()
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return ""
+		}
+
+		return v.Electra.String()
+	case spec.DataVersionFulu:
+		if v.Fulu == nil {
+			return ""
+		}
+
+		return v.Fulu.String()
+	default:
+		return "unsupported version"
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/altair"
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/gloas"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -47,6 +48,7 @@ type VersionedSignedProposal struct {
 	ElectraBlinded   *apiv1electra.SignedBlindedBeaconBlock
 	Fulu             *apiv1fulu.SignedBlockContents
 	FuluBlinded      *apiv1electra.SignedBlindedBeaconBlock
+	Gloas            *gloas.SignedBeaconBlock
 }
 
 // AssertPresent throws an error if the expected proposal
@@ -101,6 +103,10 @@ func (v *VersionedSignedProposal) AssertPresent() error {
 		if v.FuluBlinded == nil && v.Blinded {
 			return errors.New("blinded fulu proposal not present")
 		}
+	case spec.DataVersionGloas:
+		if v.Gloas == nil {
+			return errors.New("gloas proposal not present")
+		}
 	default:
 		return errors.New("unsupported version")
 	}
@@ -150,6 +156,8 @@ func (v *VersionedSignedProposal) Slot() (phase0.Slot, error) {
 		}
 
 		return v.Fulu.SignedBlock.Message.Slot, nil
+	case spec.DataVersionGloas:
+		return v.Gloas.Message.Slot, nil
 	default:
 		return 0, ErrUnsupportedVersion
 	}
@@ -196,6 +204,8 @@ func (v *VersionedSignedProposal) ProposerIndex() (phase0.ValidatorIndex, error)
 		}
 
 		return v.Fulu.SignedBlock.Message.ProposerIndex, nil
+	case spec.DataVersionGloas:
+		return v.Gloas.Message.ProposerIndex, nil
 	default:
 		return 0, ErrUnsupportedVersion
 	}
@@ -238,6 +248,8 @@ func (v *VersionedSignedProposal) ExecutionBlockHash() (phase0.Hash32, error) {
 		}
 
 		return v.Fulu.SignedBlock.Message.Body.ExecutionPayload.BlockHash, nil
+	case spec.DataVersionGloas:
+		return v.Gloas.Message.Body.SignedExecutionPayloadBid.Message.BlockHash, nil
 	default:
 		return phase0.Hash32{}, ErrUnsupportedVersion
 	}
@@ -328,6 +340,12 @@ func (v *VersionedSignedProposal) String() string {
 		}
 
 		return v.Fulu.String()
+	case spec.DataVersionGloas:
+		if v.Gloas == nil {
+			return ""
+		}
+
+		return v.Gloas.String()
 	default:
 		return "unsupported version"
 	}
@@ -402,6 +420,11 @@ func (v *VersionedSignedProposal) assertMessagePresent() error {
 				return ErrDataMissing
 			}
 		}
+	case spec.DataVersionGloas:
+		if v.Gloas == nil ||
+			v.Gloas.Message == nil {
+			return ErrDataMissing
+		}
 	default:
 		return ErrUnsupportedVersion
 	}
@@ -496,6 +519,14 @@ func (v *VersionedSignedProposal) assertExecutionPayloadPresent() error {
 				return ErrDataMissing
 			}
 		}
+	case spec.DataVersionGloas:
+		if v.Gloas == nil ||
+			v.Gloas.Message == nil ||
+			v.Gloas.Message.Body == nil ||
+			v.Gloas.Message.Body.SignedExecutionPayloadBid == nil ||
+			v.Gloas.Message.Body.SignedExecutionPayloadBid.Message == nil {
+			return ErrDataMissing
+		}
 	default:
 		return ErrUnsupportedVersion
 	}
@@ -0,0 +1,141 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	consensushttp "github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+// BuilderBid provides a builder bid.
+// The data in the response is nil if the builder has no bid for the given options.
+func (s *Service) BuilderBid(ctx context.Context,
+	opts *api.BuilderBidOpts,
+) (
+	*api.Response[*api.VersionedSignedBuilderBid],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	endpoint := fmt.Sprintf("/eth/v1/builder/header/%d/%#x/%#x", opts.Slot, opts.ParentHash[:], opts.PubKey[:])
+
+	httpResponse, err := s.get(ctx, endpoint, "", &opts.Common, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(httpResponse.body) == 0 {
+		// No bid available.
+		return &api.Response[*api.VersionedSignedBuilderBid]{
+			Metadata: metadataFromHeaders(httpResponse.headers),
+		}, nil
+	}
+
+	var response *api.Response[*api.VersionedSignedBuilderBid]
+
+	switch httpResponse.contentType {
+	case consensushttp.ContentTypeSSZ:
+		response, err = s.builderBidFromSSZ(httpResponse)
+	case consensushttp.ContentTypeJSON:
+		response, err = s.builderBidFromJSON(httpResponse)
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", httpResponse.contentType)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (*Service) builderBidFromSSZ(res *httpResponse) (*api.Response[*api.VersionedSignedBuilderBid], error) {
+	response := &api.Response[*api.VersionedSignedBuilderBid]{
+		Data: &api.VersionedSignedBuilderBid{
+			Version: res.consensusVersion,
+		},
+		Metadata: metadataFromHeaders(res.headers),
+	}
+
+	var err error
+
+	switch res.consensusVersion {
+	case spec.DataVersionBellatrix:
+		response.Data.Bellatrix = &apiv1bellatrix.SignedBuilderBid{}
+		err = response.Data.Bellatrix.UnmarshalSSZ(res.body)
+	case spec.DataVersionCapella:
+		response.Data.Capella = &apiv1capella.SignedBuilderBid{}
+		err = response.Data.Capella.UnmarshalSSZ(res.body)
+	case spec.DataVersionDeneb:
+		response.Data.Deneb = &apiv1deneb.SignedBuilderBid{}
+		err = response.Data.Deneb.UnmarshalSSZ(res.body)
+	case spec.DataVersionElectra:
+		response.Data.Electra = &apiv1electra.SignedBuilderBid{}
+		err = response.Data.Electra.UnmarshalSSZ(res.body)
+	case spec.DataVersionFulu:
+		response.Data.Fulu = &apiv1electra.SignedBuilderBid{}
+		err = response.Data.Fulu.UnmarshalSSZ(res.body)
+	default:
+		return nil, fmt.Errorf("unhandled builder bid version %s", res.consensusVersion)
+	}
+
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to decode %s builder bid", res.consensusVersion), err)
+	}
+
+	return response, nil
+}
+
+func (*Service) builderBidFromJSON(res *httpResponse) (*api.Response[*api.VersionedSignedBuilderBid], error) {
+	response := &api.Response[*api.VersionedSignedBuilderBid]{
+		Data: &api.VersionedSignedBuilderBid{
+			Version: res.consensusVersion,
+		},
+	}
+
+	var err error
+
+	switch res.consensusVersion {
+	case spec.DataVersionBellatrix:
+		response.Data.Bellatrix, response.Metadata, err = decodeJSONResponse(res.body, &apiv1bellatrix.SignedBuilderBid{})
+	case spec.DataVersionCapella:
+		response.Data.Capella, response.Metadata, err = decodeJSONResponse(res.body, &apiv1capella.SignedBuilderBid{})
+	case spec.DataVersionDeneb:
+		response.Data.Deneb, response.Metadata, err = decodeJSONResponse(res.body, &apiv1deneb.SignedBuilderBid{})
+	case spec.DataVersionElectra:
+		response.Data.Electra, response.Metadata, err = decodeJSONResponse(res.body, &apiv1electra.SignedBuilderBid{})
+	case spec.DataVersionFulu:
+		response.Data.Fulu, response.Metadata, err = decodeJSONResponse(res.body, &apiv1electra.SignedBuilderBid{})
+	default:
+		return nil, fmt.Errorf("unhandled version %s", res.consensusVersion)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
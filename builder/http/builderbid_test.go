@@ -0,0 +1,140 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/builder"
+	"github.com/attestantio/go-eth2-client/builder/http"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func testBid() *apiv1deneb.SignedBuilderBid {
+	return &apiv1deneb.SignedBuilderBid{
+		Message: &apiv1deneb.BuilderBid{
+			Header: &deneb.ExecutionPayloadHeader{
+				ParentHash:    phase0.Hash32{0x01},
+				BlockNumber:   12345,
+				GasLimit:      30000000,
+				Timestamp:     1700000000,
+				ExtraData:     []byte{},
+				BaseFeePerGas: uint256.NewInt(7),
+				BlockHash:     phase0.Hash32{0x02},
+			},
+			BlobKZGCommitments: []deneb.KZGCommitment{{0x03}},
+			Value:              uint256.NewInt(1000000000),
+			Pubkey:             phase0.BLSPubKey{0x04},
+		},
+		Signature: phase0.BLSSignature{0x05},
+	}
+}
+
+func TestBuilderBid(t *testing.T) {
+	ctx := context.Background()
+
+	bid := testBid()
+	bidJSON, err := json.Marshal(bid)
+	require.NoError(t, err)
+	bidSSZ, err := bid.MarshalSSZ()
+	require.NoError(t, err)
+
+	var served string
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		switch served {
+		case "none":
+			w.WriteHeader(nethttp.StatusNoContent)
+		case "ssz":
+			w.Header().Set("Content-Type", "application/octet-stream")
+			w.Header().Set("Eth-Consensus-Version", "deneb")
+			_, _ = w.Write(bidSSZ)
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(fmt.Appendf(nil, `{"version":"deneb","data":%s}`, bidJSON))
+		}
+	}))
+	defer srv.Close()
+
+	service, err := http.New(ctx, http.WithAddress(srv.URL), http.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		served string
+		opts   *api.BuilderBidOpts
+		empty  bool
+		err    string
+	}{
+		{
+			name: "NilOpts",
+			err:  "no options specified",
+		},
+		{
+			name:   "NoBid",
+			served: "none",
+			opts:   &api.BuilderBidOpts{Slot: 1},
+			empty:  true,
+		},
+		{
+			name:   "JSON",
+			served: "json",
+			opts:   &api.BuilderBidOpts{Slot: 1},
+		},
+		{
+			name:   "SSZ",
+			served: "ssz",
+			opts:   &api.BuilderBidOpts{Slot: 1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			served = test.served
+			response, err := service.(builder.BuilderBidProvider).BuilderBid(ctx, test.opts)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+			if test.empty {
+				require.Nil(t, response.Data)
+
+				return
+			}
+			require.Equal(t, spec.DataVersionDeneb, response.Data.Version)
+			value, err := response.Data.Value()
+			require.NoError(t, err)
+			require.Equal(t, uint64(1000000000), value.Uint64())
+			blockHash, err := response.Data.BlockHash()
+			require.NoError(t, err)
+			require.Equal(t, phase0.Hash32{0x02}, blockHash)
+			commitments, err := response.Data.BlobKZGCommitments()
+			require.NoError(t, err)
+			require.Len(t, commitments, 1)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// BuilderBlocksReceived provides the traces of blocks received by a relay from builders.
+func (s *Service) BuilderBlocksReceived(ctx context.Context,
+	opts *api.BuilderBlocksReceivedOpts,
+) (
+	*api.Response[[]*apiv1.ReceivedBidTrace],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	if opts.Slot == nil && opts.BlockHash == nil && opts.BlockNumber == nil && opts.BuilderPubkey == nil {
+		return nil, errors.Join(errors.New("no slot, block hash, block number or builder public key specified"), client.ErrInvalidOptions)
+	}
+
+	endpoint := "/relay/v1/data/bidtraces/builder_blocks_received"
+
+	queryItems := make([]string, 0)
+	if opts.Slot != nil {
+		queryItems = append(queryItems, fmt.Sprintf("slot=%d", *opts.Slot))
+	}
+
+	if opts.BlockHash != nil {
+		queryItems = append(queryItems, fmt.Sprintf("block_hash=%#x", opts.BlockHash[:]))
+	}
+
+	if opts.BlockNumber != nil {
+		queryItems = append(queryItems, fmt.Sprintf("block_number=%d", *opts.BlockNumber))
+	}
+
+	if opts.BuilderPubkey != nil {
+		queryItems = append(queryItems, fmt.Sprintf("builder_pubkey=%#x", opts.BuilderPubkey[:]))
+	}
+
+	if opts.Limit != 0 {
+		queryItems = append(queryItems, fmt.Sprintf("limit=%d", opts.Limit))
+	}
+
+	httpResponse, err := s.get(ctx, endpoint, strings.Join(queryItems, "&"), &opts.Common, false)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := decodeJSONList(httpResponse, []*apiv1.ReceivedBidTrace{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[[]*apiv1.ReceivedBidTrace]{
+		Data:     data,
+		Metadata: metadataFromHeaders(httpResponse.headers),
+	}, nil
+}
@@ -0,0 +1,97 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/builder"
+	"github.com/attestantio/go-eth2-client/builder/http"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuilderBlocksReceived(t *testing.T) {
+	ctx := context.Background()
+
+	timestamp := time.UnixMilli(1700000000123)
+
+	var query string
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		query = r.URL.RawQuery
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode([]*apiv1.ReceivedBidTrace{
+			{
+				BidTrace:  *testBidTrace(10),
+				Timestamp: timestamp,
+			},
+		})
+	}))
+	defer srv.Close()
+
+	service, err := http.New(ctx, http.WithAddress(srv.URL), http.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+
+	slot := phase0.Slot(10)
+
+	tests := []struct {
+		name  string
+		opts  *api.BuilderBlocksReceivedOpts
+		query string
+		err   string
+	}{
+		{
+			name: "NilOpts",
+			err:  "no options specified",
+		},
+		{
+			name: "NoFilter",
+			opts: &api.BuilderBlocksReceivedOpts{},
+			err:  "no slot, block hash, block number or builder public key specified",
+		},
+		{
+			name: "Filters",
+			opts: &api.BuilderBlocksReceivedOpts{
+				Slot:          &slot,
+				BuilderPubkey: &phase0.BLSPubKey{0x03},
+				Limit:         10,
+			},
+			query: "slot=10&builder_pubkey=0x030000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000&limit=10",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response, err := service.(builder.BuilderBlocksReceivedProvider).BuilderBlocksReceived(ctx, test.opts)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.query, query)
+			require.Len(t, response.Data, 1)
+			require.Equal(t, phase0.Slot(10), response.Data[0].Slot)
+			require.True(t, timestamp.Equal(response.Data[0].Timestamp))
+		})
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	nethttp "net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/builder"
+	"github.com/attestantio/go-eth2-client/builder/http"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHooks(t *testing.T) {
+	ctx := context.Background()
+
+	var status atomic.Int32
+	status.Store(nethttp.StatusOK)
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, _ *nethttp.Request) {
+		w.WriteHeader(int(status.Load()))
+	}))
+	defer srv.Close()
+
+	active := make(chan struct{}, 4)
+	inactive := make(chan struct{}, 4)
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithHooks(&http.Hooks{
+			OnActive: func(context.Context, *http.Service) {
+				active <- struct{}{}
+			},
+			OnInactive: func(context.Context, *http.Service) {
+				inactive <- struct{}{}
+			},
+		}),
+	)
+	require.NoError(t, err)
+	require.False(t, service.(*http.Service).IsActive())
+
+	// First successful request activates the client.
+	require.NoError(t, service.(builder.StatusProvider).Status(ctx, &api.BuilderStatusOpts{}))
+	require.True(t, service.(*http.Service).IsActive())
+	waitForHook(t, active)
+
+	// Further successful requests do not trigger the hook again.
+	require.NoError(t, service.(builder.StatusProvider).Status(ctx, &api.BuilderStatusOpts{}))
+
+	// Server error deactivates the client.
+	status.Store(nethttp.StatusInternalServerError)
+	require.Error(t, service.(builder.StatusProvider).Status(ctx, &api.BuilderStatusOpts{}))
+	require.False(t, service.(*http.Service).IsActive())
+	waitForHook(t, inactive)
+
+	// Unreachable relay keeps the client inactive without triggering the hook again.
+	srv.Close()
+	require.Error(t, service.(builder.StatusProvider).Status(ctx, &api.BuilderStatusOpts{}))
+	require.False(t, service.(*http.Service).IsActive())
+
+	time.Sleep(50 * time.Millisecond)
+	require.Empty(t, active)
+	require.Empty(t, inactive)
+}
+
+func TestHooksNil(t *testing.T) {
+	_, err := http.New(context.Background(),
+		http.WithAddress("localhost:18550"),
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithHooks(nil),
+	)
+	require.EqualError(t, err, "problem with parameters\nno hooks specified")
+}
+
+func waitForHook(t *testing.T, ch chan struct{}) {
+	t.Helper()
+
+	select {
+	case <-ch:
+	case <-time.After(time.Second):
+		require.Fail(t, "hook not called")
+	}
+}
@@ -0,0 +1,347 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/api"
+	consensushttp "github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// defaultUserAgent is sent with requests if no other user agent has been supplied.
+const defaultUserAgent = "go-eth2-client/0.29.0"
+
+type httpResponse struct {
+	statusCode       int
+	contentType      consensushttp.ContentType
+	headers          map[string]string
+	consensusVersion spec.DataVersion
+	body             []byte
+}
+
+// responseMetadata returns metadata related to responses.
+type responseMetadata struct {
+	Version spec.DataVersion `json:"version"`
+}
+
+// get sends an HTTP get request and returns the response.
+func (s *Service) get(ctx context.Context,
+	endpoint string,
+	query string,
+	opts *api.CommonOpts,
+	supportsSSZ bool,
+) (
+	*httpResponse,
+	error,
+) {
+	ctx, span := otel.Tracer("attestantio.go-eth2-client.builder.http").Start(ctx, "get")
+	defer span.End()
+
+	// #nosec G404
+	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.address).Str("endpoint", endpoint).Logger()
+	log.Trace().Msg("GET request")
+
+	callURL := urlForCall(s.base, endpoint, query)
+	log.Trace().Str("url", callURL.String()).Msg("URL to GET")
+	span.SetAttributes(attribute.String("url", callURL.String()))
+
+	timeout := s.timeout
+	if opts.Timeout != 0 {
+		timeout = opts.Timeout
+	}
+
+	opCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(opCtx, http.MethodGet, callURL.String(), nil)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to create request")
+
+		return nil, errors.Join(errors.New("failed to create GET request"), err)
+	}
+
+	s.addHeaders(req, supportsSSZ, nil)
+
+	res, err := s.do(req, endpoint, log)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		s.monitorGetComplete(ctx, callURL.Path, "failed")
+
+		return nil, err
+	}
+
+	s.monitorGetComplete(ctx, callURL.Path, "succeeded")
+
+	return res, nil
+}
+
+// post sends an HTTP post request and returns the response.
+func (s *Service) post(ctx context.Context,
+	endpoint string,
+	query string,
+	opts *api.CommonOpts,
+	body []byte,
+	contentType consensushttp.ContentType,
+	headers map[string]string,
+	supportsSSZ bool,
+) (
+	*httpResponse,
+	error,
+) {
+	ctx, span := otel.Tracer("attestantio.go-eth2-client.builder.http").Start(ctx, "post")
+	defer span.End()
+
+	// #nosec G404
+	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.address).Str("endpoint", endpoint).Logger()
+	if e := log.Trace(); e.Enabled() {
+		if contentType == consensushttp.ContentTypeJSON {
+			e.RawJSON("body", body).Msg("POST request")
+		} else {
+			e.Str("content_type", contentType.String()).Msg("POST request")
+		}
+	}
+
+	callURL := urlForCall(s.base, endpoint, query)
+	log.Trace().Str("url", callURL.String()).Msg("URL to POST")
+	span.SetAttributes(attribute.String("url", callURL.String()))
+
+	timeout := s.timeout
+	if opts.Timeout != 0 {
+		timeout = opts.Timeout
+	}
+
+	opCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(opCtx, http.MethodPost, callURL.String(), bytes.NewReader(body))
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to create request")
+
+		return nil, errors.Join(errors.New("failed to create POST request"), err)
+	}
+
+	req.Header.Set("Content-Type", contentType.MediaType())
+	s.addHeaders(req, supportsSSZ, headers)
+
+	res, err := s.do(req, endpoint, log)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		s.monitorPostComplete(ctx, callURL.Path, "failed")
+
+		return nil, err
+	}
+
+	s.monitorPostComplete(ctx, callURL.Path, "succeeded")
+
+	return res, nil
+}
+
+// addHeaders adds the accept, user-supplied and call-specific headers to a request.
+func (s *Service) addHeaders(req *http.Request, supportsSSZ bool, headers map[string]string) {
+	for k, v := range s.extraHeaders {
+		req.Header.Add(k, v)
+	}
+
+	if s.enforceJSON || !supportsSSZ {
+		// JSON only.
+		req.Header.Set("Accept", "application/json")
+	} else {
+		// Prefer SSZ, JSON if not.
+		req.Header.Set("Accept", "application/octet-stream;q=1,application/json;q=0.9")
+	}
+
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	if req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", defaultUserAgent)
+	}
+}
+
+// do sends the request and populates the response.
+func (s *Service) do(req *http.Request,
+	endpoint string,
+	log zerolog.Logger,
+) (
+	*httpResponse,
+	error,
+) {
+	// Hooks are called asynchronously, so must not be bound to the lifetime of the request.
+	hookCtx := context.WithoutCancel(req.Context())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		if req.Context().Err() == nil || errors.Is(req.Context().Err(), context.DeadlineExceeded) {
+			s.setConnectionState(hookCtx, false)
+		}
+
+		return nil, errors.Join(fmt.Errorf("failed to call %s endpoint", req.Method), err)
+	}
+	defer resp.Body.Close()
+
+	s.setConnectionState(hookCtx, statusCodeFamily(resp.StatusCode) != 5)
+
+	log = log.With().Int("status_code", resp.StatusCode).Logger()
+
+	res := &httpResponse{
+		statusCode: resp.StatusCode,
+	}
+	populateHeaders(res, resp)
+
+	res.body, err = io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to read %s response", req.Method), err)
+	}
+
+	if len(res.body) == 0 && statusCodeFamily(resp.StatusCode) == 2 {
+		// Nothing returned, for example a 204 when there is no bid.  This is not considered an error.
+		log.Trace().Msg("Endpoint returned no content")
+
+		return res, nil
+	}
+
+	if err := populateContentType(res, resp); err != nil {
+		// For now, assume that unknown type is JSON.
+		log.Debug().Err(err).Msg("Failed to obtain content type; assuming JSON")
+
+		res.contentType = consensushttp.ContentTypeJSON
+	}
+
+	if statusCodeFamily(resp.StatusCode) != 2 {
+		trimmedResponse := bytes.ReplaceAll(bytes.ReplaceAll(res.body, []byte{0x0a}, []byte{}), []byte{0x0d}, []byte{})
+		log.Debug().Str("response", string(trimmedResponse)).Msg(req.Method + " failed")
+
+		return nil, &api.Error{
+			Method:     req.Method,
+			StatusCode: resp.StatusCode,
+			Endpoint:   endpoint,
+			Data:       res.body,
+		}
+	}
+
+	if err := populateConsensusVersion(res, resp); err != nil {
+		return nil, errors.Join(errors.New("failed to parse consensus version"), err)
+	}
+
+	return res, nil
+}
+
+func populateConsensusVersion(res *httpResponse, resp *http.Response) error {
+	res.consensusVersion = spec.DataVersionUnknown
+
+	respConsensusVersions, exists := resp.Header["Eth-Consensus-Version"]
+	if !exists {
+		// No consensus version supplied in response; obtain it from the body if possible.
+		if res.contentType != consensushttp.ContentTypeJSON {
+			return nil
+		}
+
+		if bytes.HasPrefix(bytes.TrimSpace(res.body), []byte("[")) {
+			// Lists, such as those returned by the relay data API, are unversioned.
+			return nil
+		}
+
+		var metadata responseMetadata
+		if err := json.Unmarshal(res.body, &metadata); err != nil {
+			return errors.Join(errors.New("no consensus version header and failed to parse response"), err)
+		}
+
+		res.consensusVersion = metadata.Version
+
+		return nil
+	}
+
+	if len(respConsensusVersions) != 1 {
+		return fmt.Errorf("malformed consensus version (%d entries)", len(respConsensusVersions))
+	}
+
+	if err := res.consensusVersion.UnmarshalJSON(fmt.Appendf(nil, "%q", respConsensusVersions[0])); err != nil {
+		return errors.Join(errors.New("failed to parse consensus version"), err)
+	}
+
+	return nil
+}
+
+func populateHeaders(res *httpResponse, resp *http.Response) {
+	res.headers = make(map[string]string, len(resp.Header))
+	for k, v := range resp.Header {
+		res.headers[k] = strings.Join(v, ";")
+	}
+}
+
+func populateContentType(res *httpResponse, resp *http.Response) error {
+	respContentTypes, exists := resp.Header["Content-Type"]
+	if !exists {
+		return errors.New("no content type supplied in response")
+	}
+
+	if len(respContentTypes) != 1 {
+		return fmt.Errorf("malformed content type (%d entries)", len(respContentTypes))
+	}
+
+	var err error
+
+	res.contentType, err = consensushttp.ParseFromMediaType(respContentTypes[0])
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func metadataFromHeaders(headers map[string]string) map[string]any {
+	metadata := make(map[string]any)
+	for k, v := range headers {
+		metadata[k] = v
+	}
+
+	return metadata
+}
+
+// urlForCall patches together a URL for a call.
+func urlForCall(base *url.URL,
+	endpoint string,
+	query string,
+) *url.URL {
+	callURL := *base
+
+	callURL.Path += endpoint
+	if callURL.RawQuery == "" {
+		callURL.RawQuery = query
+	} else if query != "" {
+		callURL.RawQuery = fmt.Sprintf("%s&%s", callURL.RawQuery, query)
+	}
+
+	return &callURL
+}
+
+func statusCodeFamily(status int) int {
+	return status / 100
+}
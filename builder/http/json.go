@@ -0,0 +1,55 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// decodeJSONResponse decodes the data of a versioned builder response into res,
+// returning any other top-level fields as metadata.
+func decodeJSONResponse[T any](body []byte, res T) (T, map[string]any, error) {
+	decoded := make(map[string]json.RawMessage)
+
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return res, nil, errors.Join(errors.New("failed to parse JSON"), err)
+	}
+
+	metadata := make(map[string]any)
+
+	for k, v := range decoded {
+		switch k {
+		case "data":
+			if err := json.Unmarshal(v, res); err != nil {
+				return res, nil, errors.Join(errors.New("failed to unmarshal data"), err)
+			}
+		default:
+			var val any
+
+			if err := json.Unmarshal(v, &val); err != nil {
+				return res, nil, errors.Join(fmt.Errorf("failed to unmarshal metadata %s", k), err)
+			}
+
+			metadata[k] = val
+		}
+	}
+
+	if _, exists := decoded["data"]; !exists {
+		return res, nil, errors.New("no data in response")
+	}
+
+	return res, metadata, nil
+}
@@ -0,0 +1,81 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"regexp"
+
+	"github.com/attestantio/go-eth2-client/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var requestsMetric *prometheus.CounterVec
+
+func registerMetrics(ctx context.Context, monitor metrics.Service) error {
+	if requestsMetric != nil {
+		// Already registered.
+		return nil
+	}
+
+	if monitor == nil {
+		// No monitor.
+		return nil
+	}
+
+	if monitor.Presenter() == "prometheus" {
+		return registerPrometheusMetrics(ctx)
+	}
+
+	return nil
+}
+
+func registerPrometheusMetrics(_ context.Context) error {
+	requestsMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "builderclient",
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Number of requests",
+	}, []string{"server", "method", "endpoint", "result"})
+	if err := prometheus.Register(requestsMetric); err != nil {
+		return errors.Join(errors.New("failed to register requests_total"), err)
+	}
+
+	return nil
+}
+
+func (s *Service) monitorGetComplete(_ context.Context, endpoint string, result string) {
+	if requestsMetric == nil {
+		return
+	}
+
+	requestsMetric.WithLabelValues(s.address, "GET", reduceEndpoint(endpoint), result).Inc()
+}
+
+func (s *Service) monitorPostComplete(_ context.Context, endpoint string, result string) {
+	if requestsMetric == nil {
+		return
+	}
+
+	requestsMetric.WithLabelValues(s.address, "POST", reduceEndpoint(endpoint), result).Inc()
+}
+
+// headerPattern matches the templated portion of the header endpoint.
+var headerPattern = regexp.MustCompile("/header/[0-9]+/0x[0-9a-fA-F]{64}/0x[0-9a-fA-F]{96}")
+
+// reduceEndpoint reduces an endpoint to its template, to avoid unbounded metric labels.
+func reduceEndpoint(in string) string {
+	return headerPattern.ReplaceAllString(in, "/header/{slot}/{parent_hash}/{pubkey}")
+}
@@ -0,0 +1,117 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	consensushttp "github.com/attestantio/go-eth2-client/http"
+)
+
+// ProposerPayloadsDelivered provides the traces of payloads delivered by a relay to proposers.
+func (s *Service) ProposerPayloadsDelivered(ctx context.Context,
+	opts *api.ProposerPayloadsDeliveredOpts,
+) (
+	*api.Response[[]*apiv1.BidTrace],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	if opts.Slot != nil && opts.Cursor != nil {
+		return nil, errors.Join(errors.New("cannot specify both slot and cursor"), client.ErrInvalidOptions)
+	}
+
+	endpoint := "/relay/v1/data/bidtraces/proposer_payload_delivered"
+
+	queryItems := make([]string, 0)
+	if opts.Slot != nil {
+		queryItems = append(queryItems, fmt.Sprintf("slot=%d", *opts.Slot))
+	}
+
+	if opts.Cursor != nil {
+		queryItems = append(queryItems, fmt.Sprintf("cursor=%d", *opts.Cursor))
+	}
+
+	if opts.Limit != 0 {
+		queryItems = append(queryItems, fmt.Sprintf("limit=%d", opts.Limit))
+	}
+
+	if opts.BlockHash != nil {
+		queryItems = append(queryItems, fmt.Sprintf("block_hash=%#x", opts.BlockHash[:]))
+	}
+
+	if opts.BlockNumber != nil {
+		queryItems = append(queryItems, fmt.Sprintf("block_number=%d", *opts.BlockNumber))
+	}
+
+	if opts.ProposerPubkey != nil {
+		queryItems = append(queryItems, fmt.Sprintf("proposer_pubkey=%#x", opts.ProposerPubkey[:]))
+	}
+
+	if opts.BuilderPubkey != nil {
+		queryItems = append(queryItems, fmt.Sprintf("builder_pubkey=%#x", opts.BuilderPubkey[:]))
+	}
+
+	switch opts.OrderBy {
+	case api.BidTraceOrderDefault:
+		// Nothing to add.
+	case api.BidTraceOrderValueAscending:
+		queryItems = append(queryItems, "order_by=value")
+	case api.BidTraceOrderValueDescending:
+		queryItems = append(queryItems, "order_by=-value")
+	default:
+		return nil, errors.Join(fmt.Errorf("unhandled order %d", opts.OrderBy), client.ErrInvalidOptions)
+	}
+
+	httpResponse, err := s.get(ctx, endpoint, strings.Join(queryItems, "&"), &opts.Common, false)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := decodeJSONList(httpResponse, []*apiv1.BidTrace{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[[]*apiv1.BidTrace]{
+		Data:     data,
+		Metadata: metadataFromHeaders(httpResponse.headers),
+	}, nil
+}
+
+// decodeJSONList decodes an unwrapped JSON list, as returned by the relay data API.
+func decodeJSONList[T any](res *httpResponse, data []T) ([]T, error) {
+	if len(res.body) == 0 {
+		return data, nil
+	}
+
+	if res.contentType != consensushttp.ContentTypeJSON {
+		return nil, fmt.Errorf("unexpected content type %v (expected JSON)", res.contentType)
+	}
+
+	if err := json.Unmarshal(res.body, &data); err != nil {
+		return nil, errors.Join(errors.New("failed to parse JSON"), err)
+	}
+
+	return data, nil
+}
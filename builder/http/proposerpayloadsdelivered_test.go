@@ -0,0 +1,157 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/builder"
+	"github.com/attestantio/go-eth2-client/builder/http"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func testBidTrace(slot phase0.Slot) *apiv1.BidTrace {
+	return &apiv1.BidTrace{
+		Slot:           slot,
+		ParentHash:     phase0.Hash32{0x01},
+		BlockHash:      phase0.Hash32{0x02, byte(slot)},
+		BuilderPubkey:  phase0.BLSPubKey{0x03},
+		ProposerPubkey: phase0.BLSPubKey{0x04},
+		GasLimit:       30000000,
+		GasUsed:        15000000,
+		Value:          uint256.NewInt(uint64(slot) * 1000),
+		BlockNumber:    uint64(slot) + 1000,
+		NumTx:          100,
+	}
+}
+
+func TestProposerPayloadsDelivered(t *testing.T) {
+	ctx := context.Background()
+
+	var query string
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		query = r.URL.RawQuery
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode([]*apiv1.BidTrace{testBidTrace(10)})
+	}))
+	defer srv.Close()
+
+	service, err := http.New(ctx, http.WithAddress(srv.URL), http.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+
+	slot := phase0.Slot(10)
+	blockNumber := uint64(1010)
+
+	tests := []struct {
+		name  string
+		opts  *api.ProposerPayloadsDeliveredOpts
+		query string
+		err   string
+	}{
+		{
+			name: "NilOpts",
+			err:  "no options specified",
+		},
+		{
+			name: "SlotAndCursor",
+			opts: &api.ProposerPayloadsDeliveredOpts{
+				Slot:   &slot,
+				Cursor: &slot,
+			},
+			err: "cannot specify both slot and cursor",
+		},
+		{
+			name:  "Empty",
+			opts:  &api.ProposerPayloadsDeliveredOpts{},
+			query: "",
+		},
+		{
+			name: "Filters",
+			opts: &api.ProposerPayloadsDeliveredOpts{
+				Slot:           &slot,
+				Limit:          5,
+				BlockHash:      &phase0.Hash32{0x02},
+				BlockNumber:    &blockNumber,
+				ProposerPubkey: &phase0.BLSPubKey{0x04},
+				OrderBy:        api.BidTraceOrderValueDescending,
+			},
+			query: "slot=10&limit=5&block_hash=0x0200000000000000000000000000000000000000000000000000000000000000&block_number=1010&proposer_pubkey=0x040000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000&order_by=-value",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response, err := service.(builder.ProposerPayloadsDeliveredProvider).ProposerPayloadsDelivered(ctx, test.opts)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.query, query)
+			require.Len(t, response.Data, 1)
+			require.Equal(t, uint64(10000), response.Data[0].Value.Uint64())
+		})
+	}
+}
+
+func TestProposerPayloadsDeliveredPagination(t *testing.T) {
+	ctx := context.Background()
+
+	// Serve slots 1 to 7 in descending order, honouring the cursor and limit.
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		cursor := uint64(7)
+		if r.URL.Query().Has("cursor") {
+			cursor, _ = strconv.ParseUint(r.URL.Query().Get("cursor"), 10, 64)
+		}
+		limit, _ := strconv.ParseUint(r.URL.Query().Get("limit"), 10, 64)
+
+		traces := make([]*apiv1.BidTrace, 0)
+		for slot := cursor; slot > 0 && uint64(len(traces)) < limit; slot-- {
+			traces = append(traces, testBidTrace(phase0.Slot(slot)))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(traces)
+	}))
+	defer srv.Close()
+
+	service, err := http.New(ctx, http.WithAddress(srv.URL), http.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+
+	slots := make([]phase0.Slot, 0)
+	pages := 0
+	for opts := (&api.ProposerPayloadsDeliveredOpts{Limit: 3}); opts != nil; {
+		response, err := service.(builder.ProposerPayloadsDeliveredProvider).ProposerPayloadsDelivered(ctx, opts)
+		require.NoError(t, err)
+		pages++
+		for _, trace := range response.Data {
+			slots = append(slots, trace.Slot)
+		}
+		opts = opts.NextPage(response.Data)
+	}
+
+	require.Equal(t, 3, pages)
+	require.Equal(t, []phase0.Slot{7, 6, 5, 4, 3, 2, 1}, slots)
+}
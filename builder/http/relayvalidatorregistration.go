@@ -0,0 +1,60 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	consensushttp "github.com/attestantio/go-eth2-client/http"
+)
+
+// RelayValidatorRegistration provides the latest validator registration known to a relay.
+func (s *Service) RelayValidatorRegistration(ctx context.Context,
+	opts *api.RelayValidatorRegistrationOpts,
+) (
+	*api.Response[*apiv1.SignedValidatorRegistration],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	endpoint := "/relay/v1/data/validator_registration"
+	query := fmt.Sprintf("pubkey=%#x", opts.Pubkey[:])
+
+	httpResponse, err := s.get(ctx, endpoint, query, &opts.Common, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if httpResponse.contentType != consensushttp.ContentTypeJSON {
+		return nil, fmt.Errorf("unexpected content type %v (expected JSON)", httpResponse.contentType)
+	}
+
+	data := &apiv1.SignedValidatorRegistration{}
+	if err := json.Unmarshal(httpResponse.body, data); err != nil {
+		return nil, errors.Join(errors.New("failed to parse validator registration"), err)
+	}
+
+	return &api.Response[*apiv1.SignedValidatorRegistration]{
+		Data:     data,
+		Metadata: metadataFromHeaders(httpResponse.headers),
+	}, nil
+}
@@ -0,0 +1,101 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/builder"
+	"github.com/attestantio/go-eth2-client/builder/http"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelayValidatorRegistration(t *testing.T) {
+	ctx := context.Background()
+
+	registration := &apiv1.SignedValidatorRegistration{
+		Message: &apiv1.ValidatorRegistration{
+			FeeRecipient: [20]byte{0x01},
+			GasLimit:     30000000,
+			Timestamp:    time.Unix(1700000000, 0),
+			Pubkey:       phase0.BLSPubKey{0x02},
+		},
+		Signature: phase0.BLSSignature{0x03},
+	}
+
+	var query string
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		query = r.URL.RawQuery
+		if r.URL.Query().Get("pubkey") != "0x020000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(nethttp.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"code":400,"message":"no registration found for validator"}`))
+
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(registration)
+	}))
+	defer srv.Close()
+
+	service, err := http.New(ctx, http.WithAddress(srv.URL), http.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		opts  *api.RelayValidatorRegistrationOpts
+		query string
+		err   string
+	}{
+		{
+			name: "NilOpts",
+			err:  "no options specified",
+		},
+		{
+			name:  "Unknown",
+			opts:  &api.RelayValidatorRegistrationOpts{Pubkey: phase0.BLSPubKey{0x05}},
+			query: "pubkey=0x050000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+			err:   "no registration found for validator",
+		},
+		{
+			name:  "Good",
+			opts:  &api.RelayValidatorRegistrationOpts{Pubkey: phase0.BLSPubKey{0x02}},
+			query: "pubkey=0x020000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response, err := service.(builder.RelayValidatorRegistrationProvider).RelayValidatorRegistration(ctx, test.opts)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.query, query)
+			require.Equal(t, registration.Message.GasLimit, response.Data.Message.GasLimit)
+			require.Equal(t, registration.Message.Pubkey, response.Data.Message.Pubkey)
+		})
+	}
+}
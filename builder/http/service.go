@@ -0,0 +1,180 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/builder"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service is a builder client service.
+type Service struct {
+	// log is a service-wide logger.
+	log zerolog.Logger
+
+	base         *url.URL
+	address      string
+	client       *http.Client
+	timeout      time.Duration
+	extraHeaders map[string]string
+	enforceJSON  bool
+	hooks        *Hooks
+
+	// Connection state.
+	connectionMu     sync.RWMutex
+	connectionActive bool
+}
+
+// New creates a new builder client service, connecting with a standard HTTP.
+func New(ctx context.Context, params ...Parameter) (builder.Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "builder").Str("impl", "http").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	if parameters.monitor != nil {
+		if err := registerMetrics(ctx, parameters.monitor); err != nil {
+			return nil, errors.Join(errors.New("failed to register metrics"), err)
+		}
+	}
+
+	httpClient := parameters.client
+	if httpClient == nil {
+		httpClient = &http.Client{
+			Transport: &http.Transport{
+				DialContext: (&net.Dialer{
+					Timeout:   parameters.timeout,
+					KeepAlive: 30 * time.Second,
+				}).DialContext,
+				MaxIdleConns:        64,
+				MaxConnsPerHost:     64,
+				MaxIdleConnsPerHost: 64,
+				IdleConnTimeout:     600 * time.Second,
+			},
+		}
+	}
+
+	base, address, err := parseAddress(parameters.address)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Service{
+		log:          log,
+		base:         base,
+		address:      address.String(),
+		client:       httpClient,
+		timeout:      parameters.timeout,
+		extraHeaders: parameters.extraHeaders,
+		enforceJSON:  parameters.enforceJSON,
+		hooks:        parameters.hooks,
+	}
+
+	return s, nil
+}
+
+// Name provides the name of the service.
+func (*Service) Name() string {
+	return "Builder (HTTP)"
+}
+
+// Address provides the address for the connection.
+func (s *Service) Address() string {
+	return s.address
+}
+
+// IsActive returns true if the relay responded to the most recent request.
+func (s *Service) IsActive() bool {
+	s.connectionMu.RLock()
+	defer s.connectionMu.RUnlock()
+
+	return s.connectionActive
+}
+
+// setConnectionState sets the connection state of the relay.
+// This will call hooks supplied when creating the client if the state changes.
+func (s *Service) setConnectionState(ctx context.Context, active bool) {
+	s.connectionMu.Lock()
+	wasActive := s.connectionActive
+	s.connectionActive = active
+	s.connectionMu.Unlock()
+
+	if wasActive == active {
+		return
+	}
+
+	s.log.Trace().Bool("active", active).Msg("Connection state changed")
+
+	// Call hooks if present.
+	if active && s.hooks.OnActive != nil {
+		go s.hooks.OnActive(ctx, s)
+	}
+
+	if !active && s.hooks.OnInactive != nil {
+		go s.hooks.OnInactive(ctx, s)
+	}
+}
+
+func parseAddress(address string) (*url.URL, *url.URL, error) {
+	if !strings.HasPrefix(address, "http") {
+		address = fmt.Sprintf("http://%s", address)
+	}
+
+	base, err := url.Parse(address)
+	if err != nil {
+		return nil, nil, errors.Join(errors.New("invalid URL"), err)
+	}
+	// Remove any trailing slash from the path.
+	base.Path = strings.TrimSuffix(base.Path, "/")
+
+	// Attempt to mask any sensitive information in the URL, for logging purposes.
+	// Relay URLs commonly carry the relay public key as the user, which is not sensitive.
+	baseAddress := *base
+	if _, pwExists := baseAddress.User.Password(); pwExists {
+		// Mask the password.
+		user := baseAddress.User.Username()
+		baseAddress.User = url.UserPassword(user, "xxxxx")
+	}
+
+	if baseAddress.Path != "" {
+		// Mask the path.
+		baseAddress.Path = "xxxxx"
+	}
+
+	if baseAddress.RawQuery != "" {
+		// Mask all query values.
+		sensitiveRegex := regexp.MustCompile("=([^&]*)(&)?")
+		baseAddress.RawQuery = sensitiveRegex.ReplaceAllString(baseAddress.RawQuery, "=xxxxx$2")
+	}
+
+	return base, &baseAddress, nil
+}
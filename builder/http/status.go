@@ -0,0 +1,37 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// Status returns nil if the builder is ready to serve requests, otherwise an error.
+func (s *Service) Status(ctx context.Context,
+	opts *api.BuilderStatusOpts,
+) error {
+	if opts == nil {
+		return client.ErrNoOptions
+	}
+
+	if _, err := s.get(ctx, "/eth/v1/builder/status", "", &opts.Common, false); err != nil {
+		return errors.Join(errors.New("builder not ready"), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/builder"
+	"github.com/attestantio/go-eth2-client/builder/http"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatus(t *testing.T) {
+	ctx := context.Background()
+
+	status := nethttp.StatusOK
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		require.Equal(t, "/eth/v1/builder/status", r.URL.Path)
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	service, err := http.New(ctx, http.WithAddress(srv.URL), http.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+
+	require.EqualError(t, service.(builder.StatusProvider).Status(ctx, nil), "no options specified")
+	require.NoError(t, service.(builder.StatusProvider).Status(ctx, &api.BuilderStatusOpts{}))
+
+	status = nethttp.StatusInternalServerError
+	require.ErrorContains(t, service.(builder.StatusProvider).Status(ctx, &api.BuilderStatusOpts{}), "builder not ready")
+}
@@ -0,0 +1,240 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	consensushttp "github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+)
+
+// SubmitBlindedProposal submits a blinded proposal, returning the unblinded execution payload.
+// The data in the response is nil for versions where the builder does not return the payload.
+func (s *Service) SubmitBlindedProposal(ctx context.Context,
+	opts *api.SubmitBuilderBlindedProposalOpts,
+) (
+	*api.Response[*api.VersionedSubmitBlindedBlockResponse],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	if opts.Proposal == nil {
+		return nil, errors.Join(errors.New("no proposal supplied"), client.ErrInvalidOptions)
+	}
+
+	// From Fulu onwards the builder publishes the block itself and returns no payload.
+	endpoint := "/eth/v1/builder/blinded_blocks"
+	if opts.Proposal.Version >= spec.DataVersionFulu {
+		endpoint = "/eth/v2/builder/blinded_blocks"
+	}
+
+	headers := make(map[string]string)
+	headers["Eth-Consensus-Version"] = strings.ToLower(opts.Proposal.Version.String())
+
+	var (
+		httpResponse *httpResponse
+		err          error
+	)
+
+	if !s.enforceJSON {
+		body, err := submitBlindedProposalSSZ(opts.Proposal)
+		if err != nil {
+			return nil, err
+		}
+
+		httpResponse, err = s.post(ctx, endpoint, "", &opts.Common, body, consensushttp.ContentTypeSSZ, headers, true)
+		if err != nil && !unsupportedMediaType(err) {
+			return nil, errors.Join(errors.New("failed to submit blinded proposal"), err)
+		}
+	}
+
+	if httpResponse == nil {
+		body, err := submitBlindedProposalJSON(opts.Proposal)
+		if err != nil {
+			return nil, err
+		}
+
+		httpResponse, err = s.post(ctx, endpoint, "", &opts.Common, body, consensushttp.ContentTypeJSON, headers, true)
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to submit blinded proposal"), err)
+		}
+	}
+
+	if len(httpResponse.body) == 0 {
+		return &api.Response[*api.VersionedSubmitBlindedBlockResponse]{
+			Metadata: metadataFromHeaders(httpResponse.headers),
+		}, nil
+	}
+
+	if httpResponse.consensusVersion == spec.DataVersionUnknown {
+		// Not all builders supply the version; it must match the proposal.
+		httpResponse.consensusVersion = opts.Proposal.Version
+	}
+
+	var response *api.Response[*api.VersionedSubmitBlindedBlockResponse]
+
+	switch httpResponse.contentType {
+	case consensushttp.ContentTypeSSZ:
+		response, err = s.submitBlindedBlockResponseFromSSZ(httpResponse)
+	case consensushttp.ContentTypeJSON:
+		response, err = s.submitBlindedBlockResponseFromJSON(httpResponse)
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", httpResponse.contentType)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func submitBlindedProposalSSZ(proposal *api.VersionedSignedBlindedProposal) ([]byte, error) {
+	var (
+		specSSZ []byte
+		err     error
+	)
+
+	switch proposal.Version {
+	case spec.DataVersionBellatrix:
+		specSSZ, err = proposal.Bellatrix.MarshalSSZ()
+	case spec.DataVersionCapella:
+		specSSZ, err = proposal.Capella.MarshalSSZ()
+	case spec.DataVersionDeneb:
+		specSSZ, err = proposal.Deneb.MarshalSSZ()
+	case spec.DataVersionElectra:
+		specSSZ, err = proposal.Electra.MarshalSSZ()
+	case spec.DataVersionFulu:
+		specSSZ, err = proposal.Fulu.MarshalSSZ()
+	default:
+		err = errors.New("unknown proposal version")
+	}
+
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to marshal SSZ"), err)
+	}
+
+	return specSSZ, nil
+}
+
+func submitBlindedProposalJSON(proposal *api.VersionedSignedBlindedProposal) ([]byte, error) {
+	var (
+		specJSON []byte
+		err      error
+	)
+
+	switch proposal.Version {
+	case spec.DataVersionBellatrix:
+		specJSON, err = json.Marshal(proposal.Bellatrix)
+	case spec.DataVersionCapella:
+		specJSON, err = json.Marshal(proposal.Capella)
+	case spec.DataVersionDeneb:
+		specJSON, err = json.Marshal(proposal.Deneb)
+	case spec.DataVersionElectra:
+		specJSON, err = json.Marshal(proposal.Electra)
+	case spec.DataVersionFulu:
+		specJSON, err = json.Marshal(proposal.Fulu)
+	default:
+		err = errors.New("unknown proposal version")
+	}
+
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to marshal JSON"), err)
+	}
+
+	return specJSON, nil
+}
+
+func (*Service) submitBlindedBlockResponseFromSSZ(res *httpResponse,
+) (
+	*api.Response[*api.VersionedSubmitBlindedBlockResponse],
+	error,
+) {
+	response := &api.Response[*api.VersionedSubmitBlindedBlockResponse]{
+		Data: &api.VersionedSubmitBlindedBlockResponse{
+			Version: res.consensusVersion,
+		},
+		Metadata: metadataFromHeaders(res.headers),
+	}
+
+	var err error
+
+	switch res.consensusVersion {
+	case spec.DataVersionBellatrix:
+		response.Data.Bellatrix = &bellatrix.ExecutionPayload{}
+		err = response.Data.Bellatrix.UnmarshalSSZ(res.body)
+	case spec.DataVersionCapella:
+		response.Data.Capella = &capella.ExecutionPayload{}
+		err = response.Data.Capella.UnmarshalSSZ(res.body)
+	case spec.DataVersionDeneb:
+		response.Data.Deneb = &apiv1deneb.ExecutionPayloadAndBlobsBundle{}
+		err = response.Data.Deneb.UnmarshalSSZ(res.body)
+	case spec.DataVersionElectra:
+		response.Data.Electra = &apiv1deneb.ExecutionPayloadAndBlobsBundle{}
+		err = response.Data.Electra.UnmarshalSSZ(res.body)
+	default:
+		return nil, fmt.Errorf("unhandled execution payload version %s", res.consensusVersion)
+	}
+
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to decode %s execution payload", res.consensusVersion), err)
+	}
+
+	return response, nil
+}
+
+func (*Service) submitBlindedBlockResponseFromJSON(res *httpResponse,
+) (
+	*api.Response[*api.VersionedSubmitBlindedBlockResponse],
+	error,
+) {
+	response := &api.Response[*api.VersionedSubmitBlindedBlockResponse]{
+		Data: &api.VersionedSubmitBlindedBlockResponse{
+			Version: res.consensusVersion,
+		},
+	}
+
+	var err error
+
+	switch res.consensusVersion {
+	case spec.DataVersionBellatrix:
+		response.Data.Bellatrix, response.Metadata, err = decodeJSONResponse(res.body, &bellatrix.ExecutionPayload{})
+	case spec.DataVersionCapella:
+		response.Data.Capella, response.Metadata, err = decodeJSONResponse(res.body, &capella.ExecutionPayload{})
+	case spec.DataVersionDeneb:
+		response.Data.Deneb, response.Metadata, err = decodeJSONResponse(res.body, &apiv1deneb.ExecutionPayloadAndBlobsBundle{})
+	case spec.DataVersionElectra:
+		response.Data.Electra, response.Metadata, err = decodeJSONResponse(res.body, &apiv1deneb.ExecutionPayloadAndBlobsBundle{})
+	default:
+		return nil, fmt.Errorf("unhandled version %s", res.consensusVersion)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,149 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	"github.com/attestantio/go-eth2-client/builder"
+	"github.com/attestantio/go-eth2-client/builder/http"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitBlindedProposal(t *testing.T) {
+	ctx := context.Background()
+
+	payload := &apiv1deneb.ExecutionPayloadAndBlobsBundle{
+		ExecutionPayload: &deneb.ExecutionPayload{
+			ExtraData:     []byte{},
+			BaseFeePerGas: uint256.NewInt(7),
+			BlockHash:     phase0.Hash32{0x02},
+			Transactions:  []bellatrix.Transaction{{0x03}},
+			Withdrawals:   []*capella.Withdrawal{},
+		},
+		BlobsBundle: &apiv1deneb.BlobsBundle{
+			Commitments: []deneb.KZGCommitment{},
+			Proofs:      []deneb.KZGProof{},
+			Blobs:       []deneb.Blob{},
+		},
+	}
+	payloadJSON, err := json.Marshal(payload)
+	require.NoError(t, err)
+
+	var (
+		paths    []string
+		versions []string
+	)
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		paths = append(paths, r.URL.Path)
+		versions = append(versions, r.Header.Get("Eth-Consensus-Version"))
+		switch r.URL.Path {
+		case "/eth/v1/builder/blinded_blocks":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(fmt.Appendf(nil, `{"version":"deneb","data":%s}`, payloadJSON))
+		case "/eth/v2/builder/blinded_blocks":
+			w.WriteHeader(nethttp.StatusAccepted)
+		default:
+			w.WriteHeader(nethttp.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	service, err := http.New(ctx, http.WithAddress(srv.URL), http.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		opts    *api.SubmitBuilderBlindedProposalOpts
+		path    string
+		version string
+		empty   bool
+		err     string
+	}{
+		{
+			name: "NilOpts",
+			err:  "no options specified",
+		},
+		{
+			name: "NoProposal",
+			opts: &api.SubmitBuilderBlindedProposalOpts{},
+			err:  "no proposal supplied",
+		},
+		{
+			name: "Deneb",
+			opts: &api.SubmitBuilderBlindedProposalOpts{
+				Proposal: &api.VersionedSignedBlindedProposal{
+					Version: spec.DataVersionDeneb,
+					Deneb:   &apiv1deneb.SignedBlindedBeaconBlock{},
+				},
+			},
+			path:    "/eth/v1/builder/blinded_blocks",
+			version: "deneb",
+		},
+		{
+			name: "Fulu",
+			opts: &api.SubmitBuilderBlindedProposalOpts{
+				Proposal: &api.VersionedSignedBlindedProposal{
+					Version: spec.DataVersionFulu,
+					Fulu:    &apiv1electra.SignedBlindedBeaconBlock{},
+				},
+			},
+			path:    "/eth/v2/builder/blinded_blocks",
+			version: "fulu",
+			empty:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			paths = nil
+			versions = nil
+			response, err := service.(builder.BlindedProposalSubmitter).SubmitBlindedProposal(ctx, test.opts)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []string{test.path}, paths)
+			require.Equal(t, []string{test.version}, versions)
+			if test.empty {
+				require.Nil(t, response.Data)
+
+				return
+			}
+			blockHash, err := response.Data.BlockHash()
+			require.NoError(t, err)
+			require.Equal(t, phase0.Hash32{0x02}, blockHash)
+			transactions, err := response.Data.Transactions()
+			require.NoError(t, err)
+			require.Len(t, transactions, 1)
+		})
+	}
+}
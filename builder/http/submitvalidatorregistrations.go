@@ -0,0 +1,102 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	consensushttp "github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+// SubmitValidatorRegistrations submits validator registrations.
+func (s *Service) SubmitValidatorRegistrations(ctx context.Context,
+	opts *api.SubmitBuilderValidatorRegistrationsOpts,
+) error {
+	if opts == nil {
+		return client.ErrNoOptions
+	}
+
+	if len(opts.Registrations) == 0 {
+		return errors.Join(errors.New("no registrations supplied"), client.ErrInvalidOptions)
+	}
+
+	registrations := make([]*apiv1.SignedValidatorRegistration, len(opts.Registrations))
+	for i := range opts.Registrations {
+		if opts.Registrations[i] == nil {
+			return errors.Join(errors.New("nil registration supplied"), client.ErrInvalidOptions)
+		}
+
+		switch opts.Registrations[i].Version {
+		case spec.BuilderVersionV1:
+			if opts.Registrations[i].V1 == nil {
+				return errors.Join(errors.New("registration data missing"), client.ErrInvalidOptions)
+			}
+
+			registrations[i] = opts.Registrations[i].V1
+		default:
+			return errors.Join(errors.New("unknown validator registration version"), client.ErrInvalidOptions)
+		}
+	}
+
+	endpoint := "/eth/v1/builder/validators"
+
+	if !s.enforceJSON {
+		// The SSZ representation of a list of fixed-size containers is their concatenation.
+		body := make([]byte, 0, len(registrations)*registrations[0].SizeSSZ())
+		for _, registration := range registrations {
+			var err error
+
+			body, err = registration.MarshalSSZTo(body)
+			if err != nil {
+				return errors.Join(errors.New("failed to marshal SSZ"), err)
+			}
+		}
+
+		_, err := s.post(ctx, endpoint, "", &opts.Common, body, consensushttp.ContentTypeSSZ, nil, false)
+		if err == nil {
+			return nil
+		}
+
+		if !unsupportedMediaType(err) {
+			return errors.Join(errors.New("failed to submit validator registrations"), err)
+		}
+
+		s.log.Trace().Msg("Builder does not support SSZ registrations; falling back to JSON")
+	}
+
+	body, err := json.Marshal(registrations)
+	if err != nil {
+		return errors.Join(errors.New("failed to marshal JSON"), err)
+	}
+
+	if _, err := s.post(ctx, endpoint, "", &opts.Common, body, consensushttp.ContentTypeJSON, nil, false); err != nil {
+		return errors.Join(errors.New("failed to submit validator registrations"), err)
+	}
+
+	return nil
+}
+
+// unsupportedMediaType returns true if the error is due to the server rejecting the content type of the request.
+func unsupportedMediaType(err error) bool {
+	var apiErr *api.Error
+
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusUnsupportedMediaType
+}
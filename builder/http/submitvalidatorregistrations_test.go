@@ -0,0 +1,143 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/builder"
+	"github.com/attestantio/go-eth2-client/builder/http"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitValidatorRegistrations(t *testing.T) {
+	ctx := context.Background()
+
+	registrations := []*api.VersionedSignedValidatorRegistration{
+		{
+			Version: spec.BuilderVersionV1,
+			V1: &apiv1.SignedValidatorRegistration{
+				Message: &apiv1.ValidatorRegistration{
+					GasLimit:  30000000,
+					Timestamp: time.Unix(1700000000, 0),
+					Pubkey:    phase0.BLSPubKey{0x01},
+				},
+			},
+		},
+		{
+			Version: spec.BuilderVersionV1,
+			V1: &apiv1.SignedValidatorRegistration{
+				Message: &apiv1.ValidatorRegistration{
+					GasLimit:  30000000,
+					Timestamp: time.Unix(1700000000, 0),
+					Pubkey:    phase0.BLSPubKey{0x02},
+				},
+			},
+		},
+	}
+
+	var (
+		acceptSSZ    bool
+		contentTypes []string
+		bodyLen      int
+	)
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		require.Equal(t, "/eth/v1/builder/validators", r.URL.Path)
+		contentTypes = append(contentTypes, r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		bodyLen = len(body)
+		if r.Header.Get("Content-Type") == "application/octet-stream" && !acceptSSZ {
+			w.WriteHeader(nethttp.StatusUnsupportedMediaType)
+
+			return
+		}
+		w.WriteHeader(nethttp.StatusOK)
+	}))
+	defer srv.Close()
+
+	tests := []struct {
+		name         string
+		enforceJSON  bool
+		acceptSSZ    bool
+		opts         *api.SubmitBuilderValidatorRegistrationsOpts
+		contentTypes []string
+		bodyLen      int
+		err          string
+	}{
+		{
+			name: "NilOpts",
+			err:  "no options specified",
+		},
+		{
+			name: "NoRegistrations",
+			opts: &api.SubmitBuilderValidatorRegistrationsOpts{},
+			err:  "no registrations supplied",
+		},
+		{
+			name:         "SSZ",
+			acceptSSZ:    true,
+			opts:         &api.SubmitBuilderValidatorRegistrationsOpts{Registrations: registrations},
+			contentTypes: []string{"application/octet-stream"},
+			bodyLen:      2 * 180,
+		},
+		{
+			name:         "SSZFallback",
+			opts:         &api.SubmitBuilderValidatorRegistrationsOpts{Registrations: registrations},
+			contentTypes: []string{"application/octet-stream", "application/json"},
+		},
+		{
+			name:         "EnforceJSON",
+			enforceJSON:  true,
+			acceptSSZ:    true,
+			opts:         &api.SubmitBuilderValidatorRegistrationsOpts{Registrations: registrations},
+			contentTypes: []string{"application/json"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service, err := http.New(ctx,
+				http.WithAddress(srv.URL),
+				http.WithLogLevel(zerolog.Disabled),
+				http.WithEnforceJSON(test.enforceJSON),
+			)
+			require.NoError(t, err)
+
+			acceptSSZ = test.acceptSSZ
+			contentTypes = nil
+			err = service.(builder.ValidatorRegistrationsSubmitter).SubmitValidatorRegistrations(ctx, test.opts)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.contentTypes, contentTypes)
+			if test.bodyLen != 0 {
+				require.Equal(t, test.bodyLen, bodyLen)
+			}
+		})
+	}
+}
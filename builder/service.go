@@ -0,0 +1,103 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// Service is the service providing a connection to a builder.
+type Service interface {
+	// Name returns the name of the builder implementation.
+	Name() string
+
+	// Address returns the address of the builder.
+	Address() string
+}
+
+// StatusProvider is the interface for providing the status of a builder.
+type StatusProvider interface {
+	// Status returns nil if the builder is ready to serve requests, otherwise an error.
+	Status(ctx context.Context,
+		opts *api.BuilderStatusOpts,
+	) error
+}
+
+// ValidatorRegistrationsSubmitter is the interface for submitting validator registrations.
+type ValidatorRegistrationsSubmitter interface {
+	// SubmitValidatorRegistrations submits validator registrations.
+	SubmitValidatorRegistrations(ctx context.Context,
+		opts *api.SubmitBuilderValidatorRegistrationsOpts,
+	) error
+}
+
+// BuilderBidProvider is the interface for providing builder bids.
+type BuilderBidProvider interface {
+	// BuilderBid provides a builder bid.
+	// The data in the response is nil if the builder has no bid for the given options.
+	BuilderBid(ctx context.Context,
+		opts *api.BuilderBidOpts,
+	) (
+		*api.Response[*api.VersionedSignedBuilderBid],
+		error,
+	)
+}
+
+// BlindedProposalSubmitter is the interface for submitting blinded proposals.
+type BlindedProposalSubmitter interface {
+	// SubmitBlindedProposal submits a blinded proposal, returning the unblinded execution payload.
+	// The data in the response is nil for versions where the builder does not return the payload.
+	SubmitBlindedProposal(ctx context.Context,
+		opts *api.SubmitBuilderBlindedProposalOpts,
+	) (
+		*api.Response[*api.VersionedSubmitBlindedBlockResponse],
+		error,
+	)
+}
+
+// ProposerPayloadsDeliveredProvider is the interface for providing payloads delivered by a relay to proposers.
+type ProposerPayloadsDeliveredProvider interface {
+	// ProposerPayloadsDelivered provides the traces of payloads delivered by a relay to proposers.
+	ProposerPayloadsDelivered(ctx context.Context,
+		opts *api.ProposerPayloadsDeliveredOpts,
+	) (
+		*api.Response[[]*apiv1.BidTrace],
+		error,
+	)
+}
+
+// BuilderBlocksReceivedProvider is the interface for providing blocks received by a relay from builders.
+type BuilderBlocksReceivedProvider interface {
+	// BuilderBlocksReceived provides the traces of blocks received by a relay from builders.
+	BuilderBlocksReceived(ctx context.Context,
+		opts *api.BuilderBlocksReceivedOpts,
+	) (
+		*api.Response[[]*apiv1.ReceivedBidTrace],
+		error,
+	)
+}
+
+// RelayValidatorRegistrationProvider is the interface for providing validator registrations known to a relay.
+type RelayValidatorRegistrationProvider interface {
+	// RelayValidatorRegistration provides the latest validator registration known to a relay.
+	RelayValidatorRegistration(ctx context.Context,
+		opts *api.RelayValidatorRegistrationOpts,
+	) (
+		*api.Response[*apiv1.SignedValidatorRegistration],
+		error,
+	)
+}
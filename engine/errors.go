@@ -0,0 +1,35 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"fmt"
+)
+
+// RPCError is the error returned when the execution client responds to a
+// JSON-RPC request with an error object.
+type RPCError struct {
+	// Method is the JSON-RPC method that was called.
+	Method string
+	// Code is the JSON-RPC error code.
+	Code int
+	// Message is the JSON-RPC error message.
+	Message string
+	// Data is any additional data supplied with the error.
+	Data []byte
+}
+
+func (e *RPCError) Error() string {
+	return fmt.Sprintf("%s failed with code %d: %s", e.Method, e.Code, e.Message)
+}
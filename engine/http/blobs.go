@@ -0,0 +1,83 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiengine "github.com/attestantio/go-eth2-client/api/v1/engine"
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+// Blobs provides blobs from the execution client's blob pool.
+// The result has an entry for each versioned hash requested, which is nil if the blob is unknown.
+// engine_getBlobsV2 returns no blobs if any are unknown, in which case all entries are nil.
+func (s *Service) Blobs(ctx context.Context,
+	opts *api.EngineBlobsOpts,
+) (
+	*api.Response[[]*apiengine.BlobAndProof],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	if len(opts.VersionedHashes) == 0 {
+		return nil, errors.Join(errors.New("no versioned hashes specified"), client.ErrInvalidOptions)
+	}
+
+	var method string
+
+	switch opts.Version {
+	case spec.DataVersionDeneb, spec.DataVersionElectra:
+		method = "engine_getBlobsV1"
+	case spec.DataVersionFulu:
+		method = "engine_getBlobsV2"
+	default:
+		return nil, errors.Join(fmt.Errorf("unsupported version %s", opts.Version), client.ErrInvalidOptions)
+	}
+
+	versionedHashes := make([]string, len(opts.VersionedHashes))
+	for i := range opts.VersionedHashes {
+		versionedHashes[i] = fmt.Sprintf("%#x", opts.VersionedHashes[i])
+	}
+
+	res, err := s.call(ctx, method, []any{versionedHashes}, &opts.Common)
+	if err != nil {
+		return nil, err
+	}
+
+	var data []*apiengine.BlobAndProof
+	if err := json.Unmarshal(res, &data); err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to parse %s result", method), err)
+	}
+
+	if data == nil {
+		data = make([]*apiengine.BlobAndProof, len(opts.VersionedHashes))
+	}
+
+	if len(data) != len(opts.VersionedHashes) {
+		return nil, fmt.Errorf("%s returned %d entries for %d versioned hashes", method, len(data), len(opts.VersionedHashes))
+	}
+
+	return &api.Response[[]*apiengine.BlobAndProof]{
+		Data:     data,
+		Metadata: make(map[string]any),
+	}, nil
+}
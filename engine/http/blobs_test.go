@@ -0,0 +1,75 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/engine"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobs(t *testing.T) {
+	ctx := context.Background()
+	blob := "0x" + strings.Repeat("00", 131072)
+	proof := "0x" + strings.Repeat("aa", 48)
+	service, fake := newTestService(t, map[string]string{
+		"engine_getBlobsV1": fmt.Sprintf(`[{"blob":"%s","proof":"%s"},null]`, blob, proof),
+		"engine_getBlobsV2": `null`,
+	})
+	provider := service.(engine.BlobsProvider)
+
+	_, err := provider.Blobs(ctx, nil)
+	require.EqualError(t, err, "no options specified")
+
+	_, err = provider.Blobs(ctx, &api.EngineBlobsOpts{
+		Version: spec.DataVersionDeneb,
+	})
+	require.ErrorContains(t, err, "no versioned hashes specified")
+
+	_, err = provider.Blobs(ctx, &api.EngineBlobsOpts{
+		Version:         spec.DataVersionCapella,
+		VersionedHashes: []deneb.VersionedHash{{0x01}},
+	})
+	require.ErrorContains(t, err, "unsupported version capella")
+
+	// V1 returns individual misses.
+	response, err := provider.Blobs(ctx, &api.EngineBlobsOpts{
+		Version:         spec.DataVersionElectra,
+		VersionedHashes: []deneb.VersionedHash{{0x01}, {0x02}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "engine_getBlobsV1", fake.lastCall().method)
+	require.Len(t, response.Data, 2)
+	require.NotNil(t, response.Data[0])
+	require.Len(t, response.Data[0].Proofs, 1)
+	require.Nil(t, response.Data[1])
+
+	// V2 returns null if any blob is missing.
+	response, err = provider.Blobs(ctx, &api.EngineBlobsOpts{
+		Version:         spec.DataVersionFulu,
+		VersionedHashes: []deneb.VersionedHash{{0x01}, {0x02}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "engine_getBlobsV2", fake.lastCall().method)
+	require.Len(t, response.Data, 2)
+	require.Nil(t, response.Data[0])
+	require.Nil(t, response.Data[1])
+}
@@ -0,0 +1,55 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// ExchangeCapabilities exchanges the list of supported Engine API methods with the execution client.
+func (s *Service) ExchangeCapabilities(ctx context.Context,
+	opts *api.EngineCapabilitiesOpts,
+) (
+	*api.Response[[]string],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	capabilities := opts.Capabilities
+	if capabilities == nil {
+		capabilities = make([]string, 0)
+	}
+
+	res, err := s.call(ctx, "engine_exchangeCapabilities", []any{capabilities}, &opts.Common)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]string, 0)
+	if err := json.Unmarshal(res, &data); err != nil {
+		return nil, errors.Join(errors.New("failed to parse engine_exchangeCapabilities result"), err)
+	}
+
+	return &api.Response[[]string]{
+		Data:     data,
+		Metadata: make(map[string]any),
+	}, nil
+}
@@ -0,0 +1,54 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/engine"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExchangeCapabilities(t *testing.T) {
+	ctx := context.Background()
+	service, fake := newTestService(t, map[string]string{
+		"engine_exchangeCapabilities": `["engine_newPayloadV4","engine_getBlobsV1"]`,
+	})
+
+	_, err := service.(engine.CapabilitiesExchanger).ExchangeCapabilities(ctx, nil)
+	require.EqualError(t, err, "no options specified")
+
+	response, err := service.(engine.CapabilitiesExchanger).ExchangeCapabilities(ctx, &api.EngineCapabilitiesOpts{
+		Capabilities: []string{"engine_newPayloadV4"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"engine_newPayloadV4", "engine_getBlobsV1"}, response.Data)
+	require.Equal(t, "engine_exchangeCapabilities", fake.lastCall().method)
+	require.JSONEq(t, `["engine_newPayloadV4"]`, string(fake.lastCall().params[0]))
+}
+
+func TestRPCError(t *testing.T) {
+	service, _ := newTestService(t, map[string]string{})
+
+	_, err := service.(engine.CapabilitiesExchanger).ExchangeCapabilities(context.Background(), &api.EngineCapabilitiesOpts{})
+	require.Error(t, err)
+
+	var rpcErr *engine.RPCError
+	require.True(t, errors.As(err, &rpcErr))
+	require.Equal(t, "engine_exchangeCapabilities", rpcErr.Method)
+	require.Equal(t, -32601, rpcErr.Code)
+}
@@ -0,0 +1,72 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiengine "github.com/attestantio/go-eth2-client/api/v1/engine"
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+// ForkchoiceUpdated updates the execution client's fork choice, optionally starting a payload build.
+func (s *Service) ForkchoiceUpdated(ctx context.Context,
+	opts *api.EngineForkchoiceUpdatedOpts,
+) (
+	*api.Response[*apiengine.ForkchoiceUpdatedResult],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	if opts.State == nil {
+		return nil, errors.Join(errors.New("no fork choice state specified"), client.ErrInvalidOptions)
+	}
+
+	var method string
+
+	switch opts.Version {
+	case spec.DataVersionBellatrix:
+		method = "engine_forkchoiceUpdatedV1"
+	case spec.DataVersionCapella:
+		method = "engine_forkchoiceUpdatedV2"
+	case spec.DataVersionDeneb, spec.DataVersionElectra, spec.DataVersionFulu:
+		method = "engine_forkchoiceUpdatedV3"
+	default:
+		return nil, errors.Join(fmt.Errorf("unsupported version %s", opts.Version), client.ErrInvalidOptions)
+	}
+
+	params := []any{opts.State, opts.Attributes}
+
+	res, err := s.call(ctx, method, params, &opts.Common)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &apiengine.ForkchoiceUpdatedResult{}
+	if err := json.Unmarshal(res, data); err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to parse %s result", method), err)
+	}
+
+	return &api.Response[*apiengine.ForkchoiceUpdatedResult]{
+		Data:     data,
+		Metadata: make(map[string]any),
+	}, nil
+}
@@ -0,0 +1,95 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiengine "github.com/attestantio/go-eth2-client/api/v1/engine"
+	"github.com/attestantio/go-eth2-client/engine"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForkchoiceUpdated(t *testing.T) {
+	ctx := context.Background()
+	result := `{"payloadStatus":{"status":"VALID","latestValidHash":null,"validationError":null},"payloadId":"0x0102030405060708"}`
+	service, fake := newTestService(t, map[string]string{
+		"engine_forkchoiceUpdatedV1": result,
+		"engine_forkchoiceUpdatedV2": result,
+		"engine_forkchoiceUpdatedV3": result,
+	})
+	updater := service.(engine.ForkchoiceUpdater)
+
+	_, err := updater.ForkchoiceUpdated(ctx, nil)
+	require.EqualError(t, err, "no options specified")
+
+	_, err = updater.ForkchoiceUpdated(ctx, &api.EngineForkchoiceUpdatedOpts{
+		Version: spec.DataVersionDeneb,
+	})
+	require.ErrorContains(t, err, "no fork choice state specified")
+
+	_, err = updater.ForkchoiceUpdated(ctx, &api.EngineForkchoiceUpdatedOpts{
+		Version: spec.DataVersionPhase0,
+		State:   &apiengine.ForkchoiceState{},
+	})
+	require.ErrorContains(t, err, "unsupported version phase0")
+
+	tests := []struct {
+		version spec.DataVersion
+		method  string
+	}{
+		{version: spec.DataVersionBellatrix, method: "engine_forkchoiceUpdatedV1"},
+		{version: spec.DataVersionCapella, method: "engine_forkchoiceUpdatedV2"},
+		{version: spec.DataVersionDeneb, method: "engine_forkchoiceUpdatedV3"},
+		{version: spec.DataVersionElectra, method: "engine_forkchoiceUpdatedV3"},
+		{version: spec.DataVersionFulu, method: "engine_forkchoiceUpdatedV3"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.version.String(), func(t *testing.T) {
+			response, err := updater.ForkchoiceUpdated(ctx, &api.EngineForkchoiceUpdatedOpts{
+				Version: test.version,
+				State: &apiengine.ForkchoiceState{
+					HeadBlockHash: phase0.Hash32{0x01},
+				},
+			})
+			require.NoError(t, err)
+			require.Equal(t, apiengine.PayloadID{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}, *response.Data.PayloadID)
+
+			call := fake.lastCall()
+			require.Equal(t, test.method, call.method)
+			require.Len(t, call.params, 2)
+			require.Equal(t, "null", string(call.params[1]))
+		})
+	}
+
+	// With attributes.
+	_, err = updater.ForkchoiceUpdated(ctx, &api.EngineForkchoiceUpdatedOpts{
+		Version: spec.DataVersionDeneb,
+		State:   &apiengine.ForkchoiceState{},
+		Attributes: &apiengine.PayloadAttributes{
+			Timestamp:             1,
+			Withdrawals:           []*capella.Withdrawal{},
+			ParentBeaconBlockRoot: &phase0.Root{},
+		},
+	})
+	require.NoError(t, err)
+	require.Contains(t, string(fake.lastCall().params[1]), `"withdrawals":[]`)
+	require.Contains(t, string(fake.lastCall().params[1]), `"parentBeaconBlockRoot"`)
+}
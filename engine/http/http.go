@@ -0,0 +1,218 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/engine"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// defaultUserAgent is sent with requests if no other user agent has been supplied.
+const defaultUserAgent = "go-eth2-client/0.29.0"
+
+// requestID is the ID of the next JSON-RPC request.
+var requestID atomic.Uint64
+
+type rpcRequest struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      uint64 `json:"id"`
+	Method  string `json:"method"`
+	Params  []any  `json:"params"`
+}
+
+type rpcResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      uint64          `json:"id"`
+	Result  json.RawMessage `json:"result"`
+	Error   *rpcError       `json:"error"`
+}
+
+type rpcError struct {
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// call sends a JSON-RPC request and returns the raw result.
+func (s *Service) call(ctx context.Context,
+	method string,
+	params []any,
+	opts *api.CommonOpts,
+) (
+	json.RawMessage,
+	error,
+) {
+	ctx, span := otel.Tracer("attestantio.go-eth2-client.engine.http").Start(ctx, method)
+	defer span.End()
+
+	// #nosec G404
+	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.address).Str("method", method).Logger()
+	log.Trace().Msg("Request")
+
+	span.SetAttributes(attribute.String("url", s.base.String()))
+
+	if params == nil {
+		params = make([]any, 0)
+	}
+
+	body, err := json.Marshal(&rpcRequest{
+		JSONRPC: "2.0",
+		ID:      requestID.Add(1),
+		Method:  method,
+		Params:  params,
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to marshal request")
+
+		return nil, errors.Join(fmt.Errorf("failed to marshal %s request", method), err)
+	}
+
+	if e := log.Trace(); e.Enabled() {
+		e.RawJSON("body", body).Msg("Request body")
+	}
+
+	timeout := s.timeout
+	if opts.Timeout != 0 {
+		timeout = opts.Timeout
+	}
+
+	opCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(opCtx, http.MethodPost, s.base.String(), bytes.NewReader(body))
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to create request")
+
+		return nil, errors.Join(fmt.Errorf("failed to create %s request", method), err)
+	}
+
+	if err := s.addHeaders(req); err != nil {
+		span.SetStatus(codes.Error, "Failed to add headers")
+
+		return nil, err
+	}
+
+	res, err := s.do(req, method, log)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		s.monitorComplete(ctx, method, "failed")
+
+		return nil, err
+	}
+
+	s.monitorComplete(ctx, method, "succeeded")
+
+	return res, nil
+}
+
+// addHeaders adds the content type, authorization and user-supplied headers to a request.
+func (s *Service) addHeaders(req *http.Request) error {
+	for k, v := range s.extraHeaders {
+		req.Header.Add(k, v)
+	}
+
+	// A fresh token is generated for each request, as execution clients
+	// reject tokens whose issued-at time is too far from the current time.
+	token, err := jwtToken(s.jwtSecret, time.Now())
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	if req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", defaultUserAgent)
+	}
+
+	return nil
+}
+
+// do sends the request and returns the result of the call.
+func (s *Service) do(req *http.Request,
+	method string,
+	log zerolog.Logger,
+) (
+	json.RawMessage,
+	error,
+) {
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to call %s", method), err)
+	}
+	defer resp.Body.Close()
+
+	log = log.With().Int("status_code", resp.StatusCode).Logger()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to read %s response", method), err)
+	}
+
+	if statusCodeFamily(resp.StatusCode) != 2 {
+		trimmedResponse := bytes.ReplaceAll(bytes.ReplaceAll(body, []byte{0x0a}, []byte{}), []byte{0x0d}, []byte{})
+		log.Debug().Str("response", string(trimmedResponse)).Msg("Request failed")
+
+		return nil, &api.Error{
+			Method:     req.Method,
+			StatusCode: resp.StatusCode,
+			Endpoint:   method,
+			Data:       body,
+		}
+	}
+
+	var res rpcResponse
+	if err := json.Unmarshal(body, &res); err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to parse %s response", method), err)
+	}
+
+	if res.Error != nil {
+		log.Debug().Int("code", res.Error.Code).Str("message", res.Error.Message).Msg("Request returned error")
+
+		return nil, &engine.RPCError{
+			Method:  method,
+			Code:    res.Error.Code,
+			Message: res.Error.Message,
+			Data:    res.Error.Data,
+		}
+	}
+
+	if res.JSONRPC != "2.0" {
+		return nil, fmt.Errorf("unexpected JSON-RPC version %q in %s response", res.JSONRPC, method)
+	}
+
+	log.Trace().Msg("Request succeeded")
+
+	return res.Result, nil
+}
+
+func statusCodeFamily(status int) int {
+	return status / 100
+}
@@ -0,0 +1,71 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// jwtSecretLength is the length of the shared secret required by the Engine API.
+const jwtSecretLength = 32
+
+// jwtHeader is the pre-encoded header for HS256 tokens.
+var jwtHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+// ParseJWTSecret parses a JWT secret from its hex representation, as found
+// in the JWT secret file shared between consensus and execution clients.
+func ParseJWTSecret(input string) ([]byte, error) {
+	input = strings.TrimPrefix(strings.TrimSpace(input), "0x")
+
+	secret, err := hex.DecodeString(input)
+	if err != nil {
+		return nil, errors.Join(errors.New("invalid JWT secret"), err)
+	}
+
+	if len(secret) != jwtSecretLength {
+		return nil, fmt.Errorf("JWT secret must be %d bytes", jwtSecretLength)
+	}
+
+	return secret, nil
+}
+
+// jwtClaims are the claims included in the token.
+type jwtClaims struct {
+	IssuedAt int64 `json:"iat"`
+}
+
+// jwtToken generates an HS256 token for the Engine API, issued at the given time.
+func jwtToken(secret []byte, issuedAt time.Time) (string, error) {
+	claims, err := json.Marshal(&jwtClaims{
+		IssuedAt: issuedAt.Unix(),
+	})
+	if err != nil {
+		return "", errors.Join(errors.New("failed to marshal JWT claims"), err)
+	}
+
+	unsigned := fmt.Sprintf("%s.%s", jwtHeader, base64.RawURLEncoding.EncodeToString(claims))
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(unsigned))
+
+	return fmt.Sprintf("%s.%s", unsigned, base64.RawURLEncoding.EncodeToString(mac.Sum(nil))), nil
+}
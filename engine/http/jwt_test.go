@@ -0,0 +1,85 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseJWTSecret(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "Invalid",
+			input: "0xzz",
+			err:   "invalid JWT secret\nencoding/hex: invalid byte: U+007A 'z'",
+		},
+		{
+			name:  "Short",
+			input: "0x0102",
+			err:   "JWT secret must be 32 bytes",
+		},
+		{
+			name:  "Good",
+			input: "0x0101010101010101010101010101010101010101010101010101010101010101",
+		},
+		{
+			name:  "GoodNoPrefix",
+			input: "0101010101010101010101010101010101010101010101010101010101010101\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			secret, err := ParseJWTSecret(test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, secret, jwtSecretLength)
+			}
+		})
+	}
+}
+
+func TestJWTToken(t *testing.T) {
+	secret := make([]byte, jwtSecretLength)
+	token, err := jwtToken(secret, time.Unix(1700000000, 0))
+	require.NoError(t, err)
+
+	parts := strings.Split(token, ".")
+	require.Len(t, parts, 3)
+
+	header, err := base64.RawURLEncoding.DecodeString(parts[0])
+	require.NoError(t, err)
+	require.JSONEq(t, `{"alg":"HS256","typ":"JWT"}`, string(header))
+
+	claims, err := base64.RawURLEncoding.DecodeString(parts[1])
+	require.NoError(t, err)
+	require.JSONEq(t, `{"iat":1700000000}`, string(claims))
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	require.Equal(t, base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), parts[2])
+}
@@ -0,0 +1,110 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiengine "github.com/attestantio/go-eth2-client/api/v1/engine"
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+// NewPayload submits a new payload to the execution client for validation.
+func (s *Service) NewPayload(ctx context.Context,
+	opts *api.EngineNewPayloadOpts,
+) (
+	*api.Response[*apiengine.PayloadStatus],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	if opts.Payload == nil {
+		return nil, errors.Join(errors.New("no payload specified"), client.ErrInvalidOptions)
+	}
+
+	payload, err := apiengine.MarshalExecutionPayload(opts.Payload)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to encode payload"), err)
+	}
+
+	var method string
+
+	params := []any{json.RawMessage(payload)}
+
+	switch opts.Payload.Version {
+	case spec.DataVersionBellatrix:
+		method = "engine_newPayloadV1"
+	case spec.DataVersionCapella:
+		method = "engine_newPayloadV2"
+	case spec.DataVersionDeneb, spec.DataVersionElectra, spec.DataVersionFulu:
+		if opts.ParentBeaconBlockRoot == nil {
+			return nil, errors.Join(errors.New("no parent beacon block root specified"), client.ErrInvalidOptions)
+		}
+
+		// A payload without blobs has no versioned hashes, which is sent as an empty list.
+		versionedHashes := make([]string, len(opts.VersionedHashes))
+		for i := range opts.VersionedHashes {
+			versionedHashes[i] = fmt.Sprintf("%#x", opts.VersionedHashes[i])
+		}
+
+		params = append(params, versionedHashes, fmt.Sprintf("%#x", *opts.ParentBeaconBlockRoot))
+
+		if opts.Payload.Version == spec.DataVersionDeneb {
+			method = "engine_newPayloadV3"
+
+			break
+		}
+
+		if opts.ExecutionRequests == nil {
+			return nil, errors.Join(errors.New("no execution requests specified"), client.ErrInvalidOptions)
+		}
+
+		executionRequests, err := apiengine.MarshalExecutionRequests(opts.ExecutionRequests)
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to encode execution requests"), err)
+		}
+
+		encodedRequests := make([]string, len(executionRequests))
+		for i := range executionRequests {
+			encodedRequests[i] = fmt.Sprintf("%#x", executionRequests[i])
+		}
+
+		params = append(params, encodedRequests)
+		method = "engine_newPayloadV4"
+	default:
+		return nil, errors.Join(fmt.Errorf("unsupported payload version %s", opts.Payload.Version), client.ErrInvalidOptions)
+	}
+
+	res, err := s.call(ctx, method, params, &opts.Common)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &apiengine.PayloadStatus{}
+	if err := json.Unmarshal(res, data); err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to parse %s result", method), err)
+	}
+
+	return &api.Response[*apiengine.PayloadStatus]{
+		Data:     data,
+		Metadata: make(map[string]any),
+	}, nil
+}
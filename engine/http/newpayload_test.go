@@ -0,0 +1,153 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiengine "github.com/attestantio/go-eth2-client/api/v1/engine"
+	"github.com/attestantio/go-eth2-client/engine"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+func testExecutionPayload(version spec.DataVersion) *spec.VersionedExecutionPayload {
+	res := &spec.VersionedExecutionPayload{
+		Version: version,
+	}
+
+	switch version {
+	case spec.DataVersionBellatrix:
+		res.Bellatrix = &bellatrix.ExecutionPayload{
+			BlockHash:    phase0.Hash32{0x01},
+			ExtraData:    []byte{},
+			Transactions: []bellatrix.Transaction{},
+		}
+	case spec.DataVersionCapella:
+		res.Capella = &capella.ExecutionPayload{
+			BlockHash:    phase0.Hash32{0x01},
+			ExtraData:    []byte{},
+			Transactions: []bellatrix.Transaction{},
+			Withdrawals:  []*capella.Withdrawal{},
+		}
+	default:
+		payload := &deneb.ExecutionPayload{
+			BlockHash:     phase0.Hash32{0x01},
+			ExtraData:     []byte{},
+			BaseFeePerGas: uint256.NewInt(1),
+			Transactions:  []bellatrix.Transaction{},
+			Withdrawals:   []*capella.Withdrawal{},
+		}
+		switch version {
+		case spec.DataVersionDeneb:
+			res.Deneb = payload
+		case spec.DataVersionElectra:
+			res.Electra = payload
+		default:
+			res.Fulu = payload
+		}
+	}
+
+	return res
+}
+
+func TestNewPayload(t *testing.T) {
+	ctx := context.Background()
+	result := `{"status":"VALID","latestValidHash":"0x0100000000000000000000000000000000000000000000000000000000000000","validationError":null}`
+	service, fake := newTestService(t, map[string]string{
+		"engine_newPayloadV1": result,
+		"engine_newPayloadV2": result,
+		"engine_newPayloadV3": result,
+		"engine_newPayloadV4": result,
+	})
+	submitter := service.(engine.NewPayloadSubmitter)
+
+	_, err := submitter.NewPayload(ctx, nil)
+	require.EqualError(t, err, "no options specified")
+
+	_, err = submitter.NewPayload(ctx, &api.EngineNewPayloadOpts{})
+	require.ErrorContains(t, err, "no payload specified")
+
+	_, err = submitter.NewPayload(ctx, &api.EngineNewPayloadOpts{
+		Payload:         testExecutionPayload(spec.DataVersionDeneb),
+		VersionedHashes: []deneb.VersionedHash{},
+	})
+	require.ErrorContains(t, err, "no parent beacon block root specified")
+
+	// Parent beacon block root is not required before Deneb.
+	_, err = submitter.NewPayload(ctx, &api.EngineNewPayloadOpts{
+		Payload: testExecutionPayload(spec.DataVersionCapella),
+	})
+	require.NoError(t, err)
+	require.Len(t, fake.lastCall().params, 1)
+
+	// Blobless payload with nil versioned hashes sends an empty list.
+	_, err = submitter.NewPayload(ctx, &api.EngineNewPayloadOpts{
+		Payload:               testExecutionPayload(spec.DataVersionDeneb),
+		ParentBeaconBlockRoot: &phase0.Root{0x02},
+	})
+	require.NoError(t, err)
+	call := fake.lastCall()
+	require.Equal(t, "engine_newPayloadV3", call.method)
+	require.Len(t, call.params, 3)
+	require.JSONEq(t, `[]`, string(call.params[1]))
+
+	_, err = submitter.NewPayload(ctx, &api.EngineNewPayloadOpts{
+		Payload:               testExecutionPayload(spec.DataVersionElectra),
+		VersionedHashes:       []deneb.VersionedHash{},
+		ParentBeaconBlockRoot: &phase0.Root{},
+	})
+	require.ErrorContains(t, err, "no execution requests specified")
+
+	tests := []struct {
+		version spec.DataVersion
+		method  string
+		params  int
+	}{
+		{version: spec.DataVersionBellatrix, method: "engine_newPayloadV1", params: 1},
+		{version: spec.DataVersionCapella, method: "engine_newPayloadV2", params: 1},
+		{version: spec.DataVersionDeneb, method: "engine_newPayloadV3", params: 3},
+		{version: spec.DataVersionElectra, method: "engine_newPayloadV4", params: 4},
+		{version: spec.DataVersionFulu, method: "engine_newPayloadV4", params: 4},
+	}
+
+	for _, test := range tests {
+		t.Run(test.version.String(), func(t *testing.T) {
+			response, err := submitter.NewPayload(ctx, &api.EngineNewPayloadOpts{
+				Payload:               testExecutionPayload(test.version),
+				VersionedHashes:       []deneb.VersionedHash{{0x01}},
+				ParentBeaconBlockRoot: &phase0.Root{0x02},
+				ExecutionRequests:     &electra.ExecutionRequests{},
+			})
+			require.NoError(t, err)
+			require.Equal(t, apiengine.PayloadStatusValid, response.Data.Status)
+			require.Equal(t, phase0.Hash32{0x01}, *response.Data.LatestValidHash)
+
+			call := fake.lastCall()
+			require.Equal(t, test.method, call.method)
+			require.Len(t, call.params, test.params)
+			if test.params == 4 {
+				require.JSONEq(t, `[]`, string(call.params[3]))
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiengine "github.com/attestantio/go-eth2-client/api/v1/engine"
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+// Payload provides a payload built by the execution client.
+func (s *Service) Payload(ctx context.Context,
+	opts *api.EnginePayloadOpts,
+) (
+	*api.Response[*apiengine.BuiltPayload],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	var method string
+
+	switch opts.Version {
+	case spec.DataVersionBellatrix:
+		method = "engine_getPayloadV1"
+	case spec.DataVersionCapella:
+		method = "engine_getPayloadV2"
+	case spec.DataVersionDeneb:
+		method = "engine_getPayloadV3"
+	case spec.DataVersionElectra:
+		method = "engine_getPayloadV4"
+	case spec.DataVersionFulu:
+		method = "engine_getPayloadV5"
+	default:
+		return nil, errors.Join(fmt.Errorf("unsupported version %s", opts.Version), client.ErrInvalidOptions)
+	}
+
+	res, err := s.call(ctx, method, []any{opts.PayloadID.String()}, &opts.Common)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := apiengine.UnmarshalBuiltPayload(opts.Version, res)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to parse %s result", method), err)
+	}
+
+	return &api.Response[*apiengine.BuiltPayload]{
+		Data:     data,
+		Metadata: make(map[string]any),
+	}, nil
+}
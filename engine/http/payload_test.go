@@ -0,0 +1,94 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiengine "github.com/attestantio/go-eth2-client/api/v1/engine"
+	"github.com/attestantio/go-eth2-client/engine"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayload(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		version spec.DataVersion
+		method  string
+	}{
+		{version: spec.DataVersionBellatrix, method: "engine_getPayloadV1"},
+		{version: spec.DataVersionCapella, method: "engine_getPayloadV2"},
+		{version: spec.DataVersionDeneb, method: "engine_getPayloadV3"},
+		{version: spec.DataVersionElectra, method: "engine_getPayloadV4"},
+		{version: spec.DataVersionFulu, method: "engine_getPayloadV5"},
+	}
+
+	results := make(map[string]string)
+	for _, test := range tests {
+		builtPayload := &apiengine.BuiltPayload{
+			ExecutionPayload: testExecutionPayload(test.version),
+			BlockValue:       uint256.NewInt(100),
+			BlobsBundle: &apiv1deneb.BlobsBundle{
+				Commitments: []deneb.KZGCommitment{},
+				Proofs:      []deneb.KZGProof{},
+				Blobs:       []deneb.Blob{},
+			},
+			ExecutionRequests: &electra.ExecutionRequests{},
+		}
+		data, err := json.Marshal(builtPayload)
+		require.NoError(t, err)
+		results[test.method] = string(data)
+	}
+
+	service, fake := newTestService(t, results)
+	provider := service.(engine.PayloadProvider)
+
+	_, err := provider.Payload(ctx, nil)
+	require.EqualError(t, err, "no options specified")
+
+	_, err = provider.Payload(ctx, &api.EnginePayloadOpts{
+		Version: spec.DataVersionAltair,
+	})
+	require.ErrorContains(t, err, "unsupported version altair")
+
+	for _, test := range tests {
+		t.Run(test.version.String(), func(t *testing.T) {
+			response, err := provider.Payload(ctx, &api.EnginePayloadOpts{
+				Version:   test.version,
+				PayloadID: apiengine.PayloadID{0x01},
+			})
+			require.NoError(t, err)
+			require.Equal(t, test.version, response.Data.ExecutionPayload.Version)
+
+			call := fake.lastCall()
+			require.Equal(t, test.method, call.method)
+			require.Equal(t, `"0x0100000000000000"`, string(call.params[0]))
+
+			if test.version == spec.DataVersionBellatrix {
+				require.Nil(t, response.Data.BlockValue)
+			} else {
+				require.Equal(t, uint256.NewInt(100), response.Data.BlockValue)
+			}
+		})
+	}
+}
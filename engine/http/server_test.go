@@ -0,0 +1,144 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/engine"
+	"github.com/attestantio/go-eth2-client/engine/http"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+var testJWTSecret = []byte("0123456789abcdef0123456789abcdef")
+
+// fakeEngine is an in-memory Engine API server that returns canned results.
+type fakeEngine struct {
+	mu      sync.Mutex
+	results map[string]string
+	calls   []fakeCall
+}
+
+type fakeCall struct {
+	method string
+	params []json.RawMessage
+}
+
+func (f *fakeEngine) lastCall() fakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.calls[len(f.calls)-1]
+}
+
+func (f *fakeEngine) ServeHTTP(w nethttp.ResponseWriter, r *nethttp.Request) {
+	if !validToken(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")) {
+		w.WriteHeader(nethttp.StatusUnauthorized)
+
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(nethttp.StatusBadRequest)
+
+		return
+	}
+
+	var req struct {
+		JSONRPC string            `json:"jsonrpc"`
+		ID      uint64            `json:"id"`
+		Method  string            `json:"method"`
+		Params  []json.RawMessage `json:"params"`
+	}
+	if err := json.Unmarshal(body, &req); err != nil || req.JSONRPC != "2.0" {
+		w.WriteHeader(nethttp.StatusBadRequest)
+
+		return
+	}
+
+	f.mu.Lock()
+	f.calls = append(f.calls, fakeCall{method: req.Method, params: req.Params})
+	result, exists := f.results[req.Method]
+	f.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if !exists {
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%d,"error":{"code":-32601,"message":"the method %s does not exist/is not available"}}`, req.ID, req.Method)
+
+		return
+	}
+
+	fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%d,"result":%s}`, req.ID, result)
+}
+
+// validToken checks the token is an HS256 token signed with the test secret.
+func validToken(token string) bool {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, testJWTSecret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil)) == parts[2]
+}
+
+func newTestService(t *testing.T, results map[string]string) (engine.Service, *fakeEngine) {
+	t.Helper()
+
+	fake := &fakeEngine{
+		results: results,
+	}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	service, err := http.New(context.Background(),
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(server.URL),
+		http.WithJWTSecret(testJWTSecret),
+	)
+	require.NoError(t, err)
+
+	return service, fake
+}
+
+func TestUnauthorized(t *testing.T) {
+	server := httptest.NewServer(&fakeEngine{})
+	defer server.Close()
+
+	service, err := http.New(context.Background(),
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(server.URL),
+		http.WithJWTSecret(make([]byte, 32)),
+	)
+	require.NoError(t, err)
+
+	_, err = service.(engine.CapabilitiesExchanger).ExchangeCapabilities(context.Background(), &api.EngineCapabilitiesOpts{})
+	require.ErrorContains(t, err, "401")
+}
@@ -0,0 +1,93 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/engine/http"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+	secret := make([]byte, 32)
+
+	tests := []struct {
+		name       string
+		parameters []http.Parameter
+		address    string
+		err        string
+	}{
+		{
+			name: "AddressMissing",
+			parameters: []http.Parameter{
+				http.WithLogLevel(zerolog.Disabled),
+				http.WithJWTSecret(secret),
+			},
+			err: "problem with parameters\nno address specified",
+		},
+		{
+			name: "JWTSecretMissing",
+			parameters: []http.Parameter{
+				http.WithLogLevel(zerolog.Disabled),
+				http.WithAddress("localhost:8551"),
+			},
+			err: "problem with parameters\nno JWT secret specified",
+		},
+		{
+			name: "JWTSecretShort",
+			parameters: []http.Parameter{
+				http.WithLogLevel(zerolog.Disabled),
+				http.WithAddress("localhost:8551"),
+				http.WithJWTSecret([]byte{0x01}),
+			},
+			err: "problem with parameters\nJWT secret must be 32 bytes",
+		},
+		{
+			name: "TimeoutZero",
+			parameters: []http.Parameter{
+				http.WithLogLevel(zerolog.Disabled),
+				http.WithAddress("localhost:8551"),
+				http.WithJWTSecret(secret),
+				http.WithTimeout(0),
+			},
+			err: "problem with parameters\nno timeout specified",
+		},
+		{
+			name: "Good",
+			parameters: []http.Parameter{
+				http.WithLogLevel(zerolog.Disabled),
+				http.WithAddress("localhost:8551"),
+				http.WithJWTSecret(secret),
+			},
+			address: "http://localhost:8551",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service, err := http.New(ctx, test.parameters...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "Engine (HTTP)", service.Name())
+				require.Equal(t, test.address, service.Address())
+			}
+		})
+	}
+}
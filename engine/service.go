@@ -0,0 +1,86 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiengine "github.com/attestantio/go-eth2-client/api/v1/engine"
+)
+
+// Service is the service providing a connection to an execution client's Engine API.
+type Service interface {
+	// Name returns the name of the execution client implementation.
+	Name() string
+
+	// Address returns the address of the execution client.
+	Address() string
+}
+
+// NewPayloadSubmitter is the interface for submitting new payloads.
+type NewPayloadSubmitter interface {
+	// NewPayload submits a new payload to the execution client for validation.
+	NewPayload(ctx context.Context,
+		opts *api.EngineNewPayloadOpts,
+	) (
+		*api.Response[*apiengine.PayloadStatus],
+		error,
+	)
+}
+
+// ForkchoiceUpdater is the interface for updating fork choice.
+type ForkchoiceUpdater interface {
+	// ForkchoiceUpdated updates the execution client's fork choice, optionally starting a payload build.
+	ForkchoiceUpdated(ctx context.Context,
+		opts *api.EngineForkchoiceUpdatedOpts,
+	) (
+		*api.Response[*apiengine.ForkchoiceUpdatedResult],
+		error,
+	)
+}
+
+// PayloadProvider is the interface for providing built payloads.
+type PayloadProvider interface {
+	// Payload provides a payload built by the execution client.
+	Payload(ctx context.Context,
+		opts *api.EnginePayloadOpts,
+	) (
+		*api.Response[*apiengine.BuiltPayload],
+		error,
+	)
+}
+
+// BlobsProvider is the interface for providing blobs from the blob pool.
+type BlobsProvider interface {
+	// Blobs provides blobs from the execution client's blob pool.
+	// The result has an entry for each versioned hash requested, which is nil if the blob is unknown.
+	Blobs(ctx context.Context,
+		opts *api.EngineBlobsOpts,
+	) (
+		*api.Response[[]*apiengine.BlobAndProof],
+		error,
+	)
+}
+
+// CapabilitiesExchanger is the interface for exchanging capabilities.
+type CapabilitiesExchanger interface {
+	// ExchangeCapabilities exchanges the list of supported Engine API methods with the execution client.
+	ExchangeCapabilities(ctx context.Context,
+		opts *api.EngineCapabilitiesOpts,
+	) (
+		*api.Response[[]string],
+		error,
+	)
+}
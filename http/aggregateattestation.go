@@ -172,6 +172,16 @@ func decodeAggregateAttestation(httpResponse *httpResponse) (*spec.VersionedAtte
 			return &spec.VersionedAttestation{}, nil, decodeErr
 		}
 
+		return data, metadata, nil
+	case spec.DataVersionGloas:
+		fuluData, fuluMetadata, decodeErr := decodeJSONResponse(bytes.NewReader(httpResponse.body), &electra.Attestation{})
+		metadata = fuluMetadata
+		data.Gloas = fuluData
+
+		if decodeErr != nil {
+			return &spec.VersionedAttestation{}, nil, decodeErr
+		}
+
 		return data, metadata, nil
 	default:
 		return &spec.VersionedAttestation{}, nil, errors.New("unknown consensus version")
@@ -53,12 +53,14 @@ func (s *Service) AttestationPool(ctx context.Context,
 		queryItems = append(queryItems, fmt.Sprintf("committee_index=%d", *opts.CommitteeIndex))
 	}
 
-	httpResponse, err := s.get(ctx, endpoint, strings.Join(queryItems, "&"), &opts.Common, false)
+	httpResponse, err := s.get(ctx, endpoint, strings.Join(queryItems, "&"), &opts.Common, true)
 	if err != nil {
 		return nil, err
 	}
 
 	switch httpResponse.contentType {
+	case ContentTypeSSZ:
+		return s.attestationPoolFromSSZ(ctx, opts, httpResponse)
 	case ContentTypeJSON:
 		return s.attestationPoolFromJSON(ctx, opts, httpResponse)
 	default:
@@ -66,6 +68,90 @@ func (s *Service) AttestationPool(ctx context.Context,
 	}
 }
 
+func (s *Service) attestationPoolFromSSZ(ctx context.Context,
+	opts *api.AttestationPoolOpts,
+	httpResponse *httpResponse,
+) (
+	*api.Response[[]*spec.VersionedAttestation],
+	error,
+) {
+	dynSSZ, err := s.dynSSZ(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	version := httpResponse.consensusVersion
+
+	data := make([]*spec.VersionedAttestation, 0)
+	if len(httpResponse.body) == 0 {
+		// An empty pool may not carry a consensus version.
+		return &api.Response[[]*spec.VersionedAttestation]{
+			Metadata: metadataFromHeaders(httpResponse.headers),
+			Data:     data,
+		}, nil
+	}
+
+	switch version {
+	case spec.DataVersionPhase0,
+		spec.DataVersionAltair,
+		spec.DataVersionBellatrix,
+		spec.DataVersionCapella,
+		spec.DataVersionDeneb:
+		attestations, err := decodeSSZList[phase0.Attestation](dynSSZ, httpResponse.body, true)
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to decode attestation pool"), err)
+		}
+
+		data = make([]*spec.VersionedAttestation, len(attestations))
+		for i := range attestations {
+			data[i] = &spec.VersionedAttestation{Version: version}
+			switch version {
+			case spec.DataVersionPhase0:
+				data[i].Phase0 = attestations[i]
+			case spec.DataVersionAltair:
+				data[i].Altair = attestations[i]
+			case spec.DataVersionBellatrix:
+				data[i].Bellatrix = attestations[i]
+			case spec.DataVersionCapella:
+				data[i].Capella = attestations[i]
+			default:
+				data[i].Deneb = attestations[i]
+			}
+		}
+	case spec.DataVersionElectra,
+		spec.DataVersionFulu,
+		spec.DataVersionGloas:
+		attestations, err := decodeSSZList[electra.Attestation](dynSSZ, httpResponse.body, true)
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to decode attestation pool"), err)
+		}
+
+		data = make([]*spec.VersionedAttestation, len(attestations))
+		for i := range attestations {
+			data[i] = &spec.VersionedAttestation{Version: version}
+			switch version {
+			case spec.DataVersionElectra:
+				data[i].Electra = attestations[i]
+			case spec.DataVersionFulu:
+				data[i].Fulu = attestations[i]
+			default:
+				data[i].Gloas = attestations[i]
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unhandled attestation pool version %s", version)
+	}
+
+	if err := verifyAttestationPool(opts, data); err != nil {
+		return nil, err
+	}
+
+	return &api.Response[[]*spec.VersionedAttestation]{
+		Metadata: metadataFromHeaders(httpResponse.headers),
+		Data:     data,
+	}, nil
+}
+
 func (*Service) attestationPoolFromJSON(_ context.Context,
 	opts *api.AttestationPoolOpts,
 	httpResponse *httpResponse,
@@ -119,6 +205,10 @@ func verifyAttestationPool(opts *api.AttestationPoolOpts, data []*spec.Versioned
 			if err := verifyElectraAttestation(opts, datum.Fulu); err != nil {
 				return err
 			}
+		case spec.DataVersionGloas:
+			if err := verifyElectraAttestation(opts, datum.Gloas); err != nil {
+				return err
+			}
 		default:
 			return errors.New("unsupported attestation version")
 		}
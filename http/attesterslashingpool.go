@@ -0,0 +1,127 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// AttesterSlashingPool obtains the attester slashing pool.
+func (s *Service) AttesterSlashingPool(ctx context.Context,
+	opts *api.AttesterSlashingPoolOpts,
+) (
+	*api.Response[[]*spec.VersionedAttesterSlashing],
+	error,
+) {
+	if err := s.assertIsActive(ctx); err != nil {
+		return nil, err
+	}
+
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	endpoint := "/eth/v2/beacon/pool/attester_slashings"
+
+	httpResponse, err := s.get(ctx, endpoint, "", &opts.Common, false)
+	if err != nil {
+		return nil, err
+	}
+
+	switch httpResponse.contentType {
+	case ContentTypeJSON:
+		return s.attesterSlashingPoolFromJSON(httpResponse)
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", httpResponse.contentType)
+	}
+}
+
+func (*Service) attesterSlashingPoolFromJSON(res *httpResponse) (*api.Response[[]*spec.VersionedAttesterSlashing], error) {
+	var (
+		data     []*spec.VersionedAttesterSlashing
+		metadata map[string]any
+		err      error
+	)
+
+	switch res.consensusVersion {
+	case spec.DataVersionPhase0,
+		spec.DataVersionAltair,
+		spec.DataVersionBellatrix,
+		spec.DataVersionCapella,
+		spec.DataVersionDeneb:
+		var slashings []*phase0.AttesterSlashing
+
+		slashings, metadata, err = decodeJSONResponse(bytes.NewReader(res.body), []*phase0.AttesterSlashing{})
+		if err != nil {
+			return nil, err
+		}
+
+		data = make([]*spec.VersionedAttesterSlashing, len(slashings))
+		for i := range slashings {
+			data[i] = &spec.VersionedAttesterSlashing{
+				Version: res.consensusVersion,
+			}
+
+			switch res.consensusVersion {
+			case spec.DataVersionPhase0:
+				data[i].Phase0 = slashings[i]
+			case spec.DataVersionAltair:
+				data[i].Altair = slashings[i]
+			case spec.DataVersionBellatrix:
+				data[i].Bellatrix = slashings[i]
+			case spec.DataVersionCapella:
+				data[i].Capella = slashings[i]
+			default:
+				data[i].Deneb = slashings[i]
+			}
+		}
+	case spec.DataVersionElectra, spec.DataVersionFulu, spec.DataVersionGloas:
+		var slashings []*electra.AttesterSlashing
+
+		slashings, metadata, err = decodeJSONResponse(bytes.NewReader(res.body), []*electra.AttesterSlashing{})
+		if err != nil {
+			return nil, err
+		}
+
+		data = make([]*spec.VersionedAttesterSlashing, len(slashings))
+		for i := range slashings {
+			data[i] = &spec.VersionedAttesterSlashing{
+				Version: res.consensusVersion,
+			}
+			switch res.consensusVersion {
+			case spec.DataVersionElectra:
+				data[i].Electra = slashings[i]
+			case spec.DataVersionFulu:
+				data[i].Fulu = slashings[i]
+			default:
+				data[i].Gloas = slashings[i]
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unhandled version %s", res.consensusVersion)
+	}
+
+	return &api.Response[[]*spec.VersionedAttesterSlashing]{
+		Data:     data,
+		Metadata: metadata,
+	}, nil
+}
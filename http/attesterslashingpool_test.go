@@ -0,0 +1,85 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttesterSlashingPool(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "Good",
+		},
+	}
+
+	service := testService(ctx, t).(client.Service)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			attesterSlashingPool, err := service.(client.AttesterSlashingPoolProvider).AttesterSlashingPool(ctx, &api.AttesterSlashingPoolOpts{})
+			require.NoError(t, err)
+			require.NotNil(t, attesterSlashingPool)
+		})
+	}
+}
+
+func TestAttesterSlashingPoolVersions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	indexedAttestation := `{"attesting_indices":["1"],"data":{"slot":"1","index":"0","beacon_block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","source":{"epoch":"0","root":"0x0000000000000000000000000000000000000000000000000000000000000000"},"target":{"epoch":"0","root":"0x0101010101010101010101010101010101010101010101010101010101010101"}},"signature":"0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"}`
+	slashing := `{"attestation_1":` + indexedAttestation + `,"attestation_2":` + indexedAttestation + `}`
+
+	service := newFakeService(ctx, t, map[string]fakeResponse{
+		"/eth/v2/beacon/pool/attester_slashings": {
+			version: "electra",
+			body:    `{"version":"electra","data":[` + slashing + `]}`,
+		},
+	})
+
+	response, err := service.(client.AttesterSlashingPoolProvider).AttesterSlashingPool(ctx, &api.AttesterSlashingPoolOpts{})
+	require.NoError(t, err)
+	require.Len(t, response.Data, 1)
+	require.Equal(t, spec.DataVersionElectra, response.Data[0].Version)
+	require.NotNil(t, response.Data[0].Electra)
+	require.Equal(t, "electra", response.Metadata["version"])
+
+	service = newFakeService(ctx, t, map[string]fakeResponse{
+		"/eth/v2/beacon/pool/attester_slashings": {
+			version: "gloas",
+			body:    `{"version":"gloas","data":[` + slashing + `]}`,
+		},
+	})
+
+	response, err = service.(client.AttesterSlashingPoolProvider).AttesterSlashingPool(ctx, &api.AttesterSlashingPoolOpts{})
+	require.NoError(t, err)
+	require.Len(t, response.Data, 1)
+	require.Equal(t, spec.DataVersionGloas, response.Data[0].Version)
+	require.NotNil(t, response.Data[0].Gloas)
+	attestation1, err := response.Data[0].Attestation1()
+	require.NoError(t, err)
+	require.NotNil(t, attestation1)
+}
@@ -0,0 +1,73 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// BeaconBlockHeaders provides the block headers matching the given slot and parent root.
+func (s *Service) BeaconBlockHeaders(ctx context.Context,
+	opts *api.BeaconBlockHeadersOpts,
+) (
+	*api.Response[[]*apiv1.BeaconBlockHeader],
+	error,
+) {
+	if err := s.assertIsActive(ctx); err != nil {
+		return nil, err
+	}
+
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	endpoint := "/eth/v1/beacon/headers"
+
+	queryItems := make([]string, 0)
+	if opts.Slot != nil {
+		queryItems = append(queryItems, fmt.Sprintf("slot=%d", *opts.Slot))
+	}
+
+	if opts.ParentRoot != nil {
+		queryItems = append(queryItems, fmt.Sprintf("parent_root=%#x", *opts.ParentRoot))
+	}
+
+	httpResponse, err := s.get(ctx, endpoint, strings.Join(queryItems, "&"), &opts.Common, false)
+	if err != nil {
+		return nil, err
+	}
+
+	data, metadata, err := decodeJSONResponse(bytes.NewReader(httpResponse.body), make([]*apiv1.BeaconBlockHeader, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range data {
+		if data[i] == nil || !isBlockHeaderResponseValid(data[i]) {
+			return nil, fmt.Errorf("invalid beacon block header %d", i)
+		}
+	}
+
+	return &api.Response[[]*apiv1.BeaconBlockHeader]{
+		Metadata: metadata,
+		Data:     data,
+	}, nil
+}
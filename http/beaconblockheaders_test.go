@@ -0,0 +1,96 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBeaconBlockHeaders(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	service := testService(ctx, t).(client.Service)
+
+	// Obtain the head to find a slot and parent root with data.
+	headResponse, err := service.(client.BeaconBlockHeadersProvider).BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{Block: "head"})
+	require.NoError(t, err)
+	headSlot := headResponse.Data.Header.Message.Slot
+	headParentRoot := headResponse.Data.Header.Message.ParentRoot
+
+	tests := []struct {
+		name     string
+		opts     *api.BeaconBlockHeadersOpts
+		err      string
+		expected phase0.Root
+	}{
+		{
+			name: "NilOpts",
+			err:  "no options specified",
+		},
+		{
+			name:     "Head",
+			opts:     &api.BeaconBlockHeadersOpts{},
+			expected: headResponse.Data.Root,
+		},
+		{
+			name:     "Slot",
+			opts:     &api.BeaconBlockHeadersOpts{Slot: &headSlot},
+			expected: headResponse.Data.Root,
+		},
+		{
+			name:     "ParentRoot",
+			opts:     &api.BeaconBlockHeadersOpts{ParentRoot: &headParentRoot},
+			expected: headResponse.Data.Root,
+		},
+		{
+			name:     "SlotAndParentRoot",
+			opts:     &api.BeaconBlockHeadersOpts{Slot: &headSlot, ParentRoot: &headParentRoot},
+			expected: headResponse.Data.Root,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response, err := service.(client.BeaconBlockHeadersProvider).BeaconBlockHeaders(ctx, test.opts)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, response)
+			require.NotEmpty(t, response.Data)
+
+			// The head block will be present and canonical, although the head may have moved on.
+			found := false
+			for _, header := range response.Data {
+				if header.Root == test.expected {
+					found = true
+					require.True(t, header.Canonical)
+				}
+			}
+			if !found {
+				t.Logf("head %#x not found; head may have moved", test.expected)
+			}
+		})
+	}
+}
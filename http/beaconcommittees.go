@@ -50,11 +50,48 @@ func (s *Service) BeaconCommittees(ctx context.Context,
 		query = fmt.Sprintf("epoch=%d", *opts.Epoch)
 	}
 
-	httpResponse, err := s.get(ctx, endpoint, query, &opts.Common, false)
+	httpResponse, err := s.get(ctx, endpoint, query, &opts.Common, true)
 	if err != nil {
 		return nil, err
 	}
 
+	switch httpResponse.contentType {
+	case ContentTypeSSZ:
+		return s.beaconCommitteesFromSSZ(ctx, httpResponse)
+	case ContentTypeJSON:
+		return s.beaconCommitteesFromJSON(httpResponse)
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", httpResponse.contentType)
+	}
+}
+
+func (s *Service) beaconCommitteesFromSSZ(ctx context.Context,
+	httpResponse *httpResponse,
+) (
+	*api.Response[[]*apiv1.BeaconCommittee],
+	error,
+) {
+	dynSSZ, err := s.dynSSZ(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := decodeSSZList[apiv1.BeaconCommittee](dynSSZ, httpResponse.body, true)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to decode beacon committees"), err)
+	}
+
+	return &api.Response[[]*apiv1.BeaconCommittee]{
+		Metadata: metadataFromHeaders(httpResponse.headers),
+		Data:     data,
+	}, nil
+}
+
+func (*Service) beaconCommitteesFromJSON(httpResponse *httpResponse,
+) (
+	*api.Response[[]*apiv1.BeaconCommittee],
+	error,
+) {
 	data, metadata, err := decodeJSONResponse(bytes.NewReader(httpResponse.body), []*apiv1.BeaconCommittee{})
 	if err != nil {
 		return nil, err
@@ -0,0 +1,77 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBeaconHeads(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	service := newFakeService(ctx, t, map[string]fakeResponse{
+		"/eth/v2/debug/beacon/heads": {
+			body: `{"data":[{"root":"0x0100000000000000000000000000000000000000000000000000000000000000","slot":"10","execution_optimistic":false},{"root":"0x0200000000000000000000000000000000000000000000000000000000000000","slot":"11","execution_optimistic":true}]}`,
+		},
+	})
+
+	tests := []struct {
+		name     string
+		opts     *api.BeaconHeadsOpts
+		expected []*apiv1.BeaconHead
+		err      string
+	}{
+		{
+			name: "NilOpts",
+			err:  "no options specified",
+		},
+		{
+			name: "Good",
+			opts: &api.BeaconHeadsOpts{},
+			expected: []*apiv1.BeaconHead{
+				{
+					Slot: 10,
+					Root: phase0.Root{0x01},
+				},
+				{
+					Slot:                11,
+					Root:                phase0.Root{0x02},
+					ExecutionOptimistic: true,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response, err := service.(client.BeaconHeadsProvider).BeaconHeads(ctx, test.opts)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.expected, response.Data)
+		})
+	}
+}
@@ -0,0 +1,220 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// BlockAttestations fetches the attestations included in a block given a set of options.
+func (s *Service) BlockAttestations(ctx context.Context,
+	opts *api.BlockAttestationsOpts,
+) (
+	*api.Response[[]*spec.VersionedAttestation],
+	error,
+) {
+	if err := s.assertIsActive(ctx); err != nil {
+		return nil, err
+	}
+
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	if opts.Block == "" {
+		return nil, errors.Join(errors.New("no block specified"), client.ErrInvalidOptions)
+	}
+
+	endpoint := fmt.Sprintf("/eth/v2/beacon/blocks/%s/attestations", opts.Block)
+
+	httpResponse, err := s.get(ctx, endpoint, "", &opts.Common, true)
+	if err != nil {
+		return nil, err
+	}
+
+	switch httpResponse.contentType {
+	case ContentTypeSSZ:
+		return s.blockAttestationsFromSSZ(ctx, httpResponse)
+	case ContentTypeJSON:
+		return s.blockAttestationsFromJSON(httpResponse)
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", httpResponse.contentType)
+	}
+}
+
+func (s *Service) blockAttestationsFromSSZ(ctx context.Context,
+	res *httpResponse,
+) (
+	*api.Response[[]*spec.VersionedAttestation],
+	error,
+) {
+	dynSSZ, err := s.dynSSZ(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var data []*spec.VersionedAttestation
+
+	switch res.consensusVersion {
+	case spec.DataVersionPhase0,
+		spec.DataVersionAltair,
+		spec.DataVersionBellatrix,
+		spec.DataVersionCapella,
+		spec.DataVersionDeneb:
+		attestations, err := decodeSSZList[phase0.Attestation](dynSSZ, res.body, true)
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to decode block attestations"), err)
+		}
+
+		data, err = versionedPhase0Attestations(res.consensusVersion, attestations)
+		if err != nil {
+			return nil, err
+		}
+	case spec.DataVersionElectra,
+		spec.DataVersionFulu,
+		spec.DataVersionGloas:
+		attestations, err := decodeSSZList[electra.Attestation](dynSSZ, res.body, true)
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to decode block attestations"), err)
+		}
+
+		data, err = versionedElectraAttestations(res.consensusVersion, attestations)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unhandled version %s", res.consensusVersion)
+	}
+
+	return &api.Response[[]*spec.VersionedAttestation]{
+		Data:     data,
+		Metadata: metadataFromHeaders(res.headers),
+	}, nil
+}
+
+func (*Service) blockAttestationsFromJSON(res *httpResponse) (*api.Response[[]*spec.VersionedAttestation], error) {
+	var (
+		data     []*spec.VersionedAttestation
+		metadata map[string]any
+	)
+
+	switch res.consensusVersion {
+	case spec.DataVersionPhase0,
+		spec.DataVersionAltair,
+		spec.DataVersionBellatrix,
+		spec.DataVersionCapella,
+		spec.DataVersionDeneb:
+		attestations, md, err := decodeJSONResponse(bytes.NewReader(res.body), []*phase0.Attestation{})
+		if err != nil {
+			return nil, err
+		}
+
+		data, err = versionedPhase0Attestations(res.consensusVersion, attestations)
+		if err != nil {
+			return nil, err
+		}
+
+		metadata = md
+	case spec.DataVersionElectra,
+		spec.DataVersionFulu,
+		spec.DataVersionGloas:
+		attestations, md, err := decodeJSONResponse(bytes.NewReader(res.body), []*electra.Attestation{})
+		if err != nil {
+			return nil, err
+		}
+
+		data, err = versionedElectraAttestations(res.consensusVersion, attestations)
+		if err != nil {
+			return nil, err
+		}
+
+		metadata = md
+	default:
+		return nil, fmt.Errorf("unhandled version %s", res.consensusVersion)
+	}
+
+	return &api.Response[[]*spec.VersionedAttestation]{
+		Data:     data,
+		Metadata: metadata,
+	}, nil
+}
+
+// versionedPhase0Attestations wraps pre-Electra attestations in their versioned form.
+func versionedPhase0Attestations(version spec.DataVersion,
+	attestations []*phase0.Attestation,
+) (
+	[]*spec.VersionedAttestation,
+	error,
+) {
+	res := make([]*spec.VersionedAttestation, len(attestations))
+	for i := range attestations {
+		res[i] = &spec.VersionedAttestation{
+			Version: version,
+		}
+
+		switch version {
+		case spec.DataVersionPhase0:
+			res[i].Phase0 = attestations[i]
+		case spec.DataVersionAltair:
+			res[i].Altair = attestations[i]
+		case spec.DataVersionBellatrix:
+			res[i].Bellatrix = attestations[i]
+		case spec.DataVersionCapella:
+			res[i].Capella = attestations[i]
+		case spec.DataVersionDeneb:
+			res[i].Deneb = attestations[i]
+		default:
+			return nil, fmt.Errorf("unhandled version %s for phase 0 attestation", version)
+		}
+	}
+
+	return res, nil
+}
+
+// versionedElectraAttestations wraps Electra and later attestations in their versioned form.
+func versionedElectraAttestations(version spec.DataVersion,
+	attestations []*electra.Attestation,
+) (
+	[]*spec.VersionedAttestation,
+	error,
+) {
+	res := make([]*spec.VersionedAttestation, len(attestations))
+	for i := range attestations {
+		res[i] = &spec.VersionedAttestation{
+			Version: version,
+		}
+
+		switch version {
+		case spec.DataVersionElectra:
+			res[i].Electra = attestations[i]
+		case spec.DataVersionFulu:
+			res[i].Fulu = attestations[i]
+		case spec.DataVersionGloas:
+			res[i].Gloas = attestations[i]
+		default:
+			return nil, fmt.Errorf("unhandled version %s for electra attestation", version)
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,125 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockAttestations(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name string
+		opts *api.BlockAttestationsOpts
+		err  string
+	}{
+		{
+			name: "NilOpts",
+			err:  "no options specified",
+		},
+		{
+			name: "BlockMissing",
+			opts: &api.BlockAttestationsOpts{},
+			err:  "no block specified",
+		},
+		{
+			name: "Head",
+			opts: &api.BlockAttestationsOpts{Block: "head"},
+		},
+	}
+
+	service := testService(ctx, t).(client.Service)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response, err := service.(client.BlockAttestationsProvider).BlockAttestations(ctx, test.opts)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, response)
+			for _, attestation := range response.Data {
+				require.False(t, attestation.IsEmpty())
+			}
+		})
+	}
+}
+
+func TestBlockAttestationsVersions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	phase0Attestation := `{"aggregation_bits":"0x01","data":{"slot":"1","index":"2","beacon_block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","source":{"epoch":"0","root":"0x0000000000000000000000000000000000000000000000000000000000000000"},"target":{"epoch":"0","root":"0x0101010101010101010101010101010101010101010101010101010101010101"}},"signature":"0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"}`
+	electraAttestation := `{"aggregation_bits":"0x01","data":{"slot":"1","index":"0","beacon_block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","source":{"epoch":"0","root":"0x0000000000000000000000000000000000000000000000000000000000000000"},"target":{"epoch":"0","root":"0x0101010101010101010101010101010101010101010101010101010101010101"}},"signature":"0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","committee_bits":"0x0400000000000000"}`
+
+	service := newFakeService(ctx, t, map[string]fakeResponse{
+		"/eth/v2/beacon/blocks/deneb/attestations": {
+			version: "deneb",
+			body:    `{"version":"deneb","execution_optimistic":false,"finalized":true,"data":[` + phase0Attestation + `]}`,
+		},
+		"/eth/v2/beacon/blocks/electra/attestations": {
+			version: "electra",
+			body:    `{"version":"electra","execution_optimistic":false,"finalized":true,"data":[` + electraAttestation + `]}`,
+		},
+		"/eth/v2/beacon/blocks/fulu/attestations": {
+			version: "fulu",
+			body:    `{"version":"fulu","execution_optimistic":false,"finalized":true,"data":[]}`,
+		},
+		"/eth/v2/beacon/blocks/gloas/attestations": {
+			version: "gloas",
+			body:    `{"version":"gloas","execution_optimistic":false,"finalized":true,"data":[` + electraAttestation + `]}`,
+		},
+	})
+	provider := service.(client.BlockAttestationsProvider)
+
+	response, err := provider.BlockAttestations(ctx, &api.BlockAttestationsOpts{Block: "deneb"})
+	require.NoError(t, err)
+	require.Len(t, response.Data, 1)
+	require.Equal(t, spec.DataVersionDeneb, response.Data[0].Version)
+	require.NotNil(t, response.Data[0].Deneb)
+	require.Equal(t, true, response.Metadata["finalized"])
+
+	response, err = provider.BlockAttestations(ctx, &api.BlockAttestationsOpts{Block: "electra"})
+	require.NoError(t, err)
+	require.Len(t, response.Data, 1)
+	require.Equal(t, spec.DataVersionElectra, response.Data[0].Version)
+	require.NotNil(t, response.Data[0].Electra)
+	committeeIndex, err := response.Data[0].CommitteeIndex()
+	require.NoError(t, err)
+	require.EqualValues(t, 2, committeeIndex)
+
+	response, err = provider.BlockAttestations(ctx, &api.BlockAttestationsOpts{Block: "fulu"})
+	require.NoError(t, err)
+	require.Empty(t, response.Data)
+
+	response, err = provider.BlockAttestations(ctx, &api.BlockAttestationsOpts{Block: "gloas"})
+	require.NoError(t, err)
+	require.Len(t, response.Data, 1)
+	require.Equal(t, spec.DataVersionGloas, response.Data[0].Version)
+	require.NotNil(t, response.Data[0].Gloas)
+	committeeIndex, err = response.Data[0].CommitteeIndex()
+	require.NoError(t, err)
+	require.EqualValues(t, 2, committeeIndex)
+}
@@ -0,0 +1,127 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// coalesce makes the call, sharing it with any concurrent call that has the
+// same key.  Keys include the timeout and retry policy, so the call is only
+// shared between callers with the same options.  The shared call is not
+// canceled if the caller that started it goes away, and does not inherit that
+// caller's context deadline, so that other callers still receive the response;
+// it is bounded by the request timeout instead.  Each caller stops waiting when
+// its own context is done.
+func (s *Service) coalesce(ctx context.Context,
+	key string,
+	call func(ctx context.Context) (*httpResponse, error),
+) (
+	*httpResponse,
+	error,
+) {
+	ch := s.requestGroup.DoChan(key, func() (any, error) {
+		return call(context.WithoutCancel(ctx))
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result := <-ch:
+		if result.Err != nil {
+			return nil, result.Err
+		}
+
+		res, isResponse := result.Val.(*httpResponse)
+		if !isResponse || res == nil {
+			return nil, errors.New("coalesced request returned no response")
+		}
+
+		// Each caller receives its own copy of the response, although the
+		// body is shared and so must not be modified.
+		response := *res
+
+		return &response, nil
+	}
+}
+
+// getRequestKey returns the key for coalescing a GET request.
+func getRequestKey(endpoint string,
+	query string,
+	acceptSSZ bool,
+	timeout time.Duration,
+	policy *api.RetryPolicy,
+) string {
+	accept := ContentTypeJSON
+	if acceptSSZ {
+		accept = ContentTypeSSZ
+	}
+
+	return strings.Join([]string{http.MethodGet, endpoint, query, accept.String(), optionsKey(timeout, policy)}, " ")
+}
+
+// postRequestKey returns the key for coalescing a POST request.
+func postRequestKey(endpoint string,
+	query string,
+	contentType ContentType,
+	headers map[string]string,
+	body []byte,
+	timeout time.Duration,
+	policy *api.RetryPolicy,
+) string {
+	hash := sha256.New()
+	hash.Write(body)
+
+	headerKeys := make([]string, 0, len(headers))
+	for k := range headers {
+		headerKeys = append(headerKeys, k)
+	}
+	sort.Strings(headerKeys)
+
+	parts := []string{http.MethodPost, endpoint, query, contentType.String()}
+	for _, k := range headerKeys {
+		parts = append(parts, k+"="+headers[k])
+	}
+	parts = append(parts, hex.EncodeToString(hash.Sum(nil)), optionsKey(timeout, policy))
+
+	return strings.Join(parts, " ")
+}
+
+// optionsKey returns the part of a coalescing key that covers the options
+// controlling how the request is made.
+func optionsKey(timeout time.Duration, policy *api.RetryPolicy) string {
+	if policy == nil {
+		return fmt.Sprintf("timeout=%s retries=none", timeout)
+	}
+
+	return fmt.Sprintf("timeout=%s retries=%d,%s,%s,%g,%g,%t",
+		timeout,
+		policy.MaxAttempts,
+		policy.InitialBackoff,
+		policy.MaxBackoff,
+		policy.Multiplier,
+		policy.Jitter,
+		policy.RetrySubmissions,
+	)
+}
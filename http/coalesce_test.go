@@ -0,0 +1,219 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec/gloas"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// concurrently runs the supplied function the given number of times in parallel.
+func concurrently(count int, fn func()) {
+	var wg sync.WaitGroup
+	for range count {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			fn()
+		}()
+	}
+	wg.Wait()
+}
+
+func TestRequestCoalescingGet(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name     string
+		params   []http.Parameter
+		requests int
+	}{
+		{
+			name:     "Disabled",
+			requests: 5,
+		},
+		{
+			name: "Enabled",
+			params: []http.Parameter{
+				http.WithRequestCoalescing(true),
+			},
+			requests: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			requests := make([]string, 0)
+			service := newFakeService(ctx, t, map[string]fakeResponse{
+				"/eth/v1/beacon/blocks/head/root": {
+					body:     blockRootBody,
+					delay:    200 * time.Millisecond,
+					requests: &requests,
+				},
+			}, test.params...)
+
+			var mu sync.Mutex
+			roots := make([]phase0.Root, 0)
+			concurrently(5, func() {
+				response, err := service.(client.BeaconBlockRootProvider).BeaconBlockRoot(ctx, &api.BeaconBlockRootOpts{
+					Block: "head",
+				})
+				if err != nil {
+					return
+				}
+				mu.Lock()
+				roots = append(roots, *response.Data)
+				mu.Unlock()
+			})
+
+			require.Len(t, roots, 5)
+			require.Len(t, requests, test.requests)
+		})
+	}
+}
+
+func TestRequestCoalescingOptions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	requests := make([]string, 0)
+	service := newFakeService(ctx, t, map[string]fakeResponse{
+		"/eth/v1/beacon/blocks/head/root": {
+			body:     blockRootBody,
+			delay:    200 * time.Millisecond,
+			requests: &requests,
+		},
+	}, http.WithRequestCoalescing(true))
+
+	// Requests with different timeouts or retry policies are not coalesced.
+	opts := []*api.BeaconBlockRootOpts{
+		{Block: "head"},
+		{Block: "head", Common: api.CommonOpts{Timeout: 5 * time.Second}},
+		{Block: "head", Common: api.CommonOpts{RetryPolicy: &api.RetryPolicy{MaxAttempts: 1}}},
+	}
+	var wg sync.WaitGroup
+	for i := range opts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := service.(client.BeaconBlockRootProvider).BeaconBlockRoot(ctx, opts[i])
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	require.Len(t, requests, 3)
+}
+
+func TestRequestCoalescingPost(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	t.Run("Idempotent", func(t *testing.T) {
+		requests := make([]string, 0)
+		service := newFakeService(ctx, t, map[string]fakeResponse{
+			"/eth/v1/beacon/states/head/validator_balances": {
+				body:     `{"data":[{"index":"1","balance":"2"}]}`,
+				delay:    200 * time.Millisecond,
+				requests: &requests,
+			},
+		}, http.WithRequestCoalescing(true))
+
+		concurrently(5, func() {
+			_, _ = service.(client.ValidatorBalancesProvider).ValidatorBalances(ctx, &api.ValidatorBalancesOpts{
+				State:   "head",
+				Indices: []phase0.ValidatorIndex{1},
+			})
+		})
+		require.Len(t, requests, 1)
+
+		// A request with a different body is not coalesced with the first.
+		concurrently(1, func() {
+			_, _ = service.(client.ValidatorBalancesProvider).ValidatorBalances(ctx, &api.ValidatorBalancesOpts{
+				State:   "head",
+				Indices: []phase0.ValidatorIndex{2},
+			})
+		})
+		require.Len(t, requests, 2)
+	})
+
+	t.Run("Submission", func(t *testing.T) {
+		requests := make([]string, 0)
+		service := newFakeService(ctx, t, map[string]fakeResponse{
+			"/eth/v1/beacon/pool/payload_attestations": {
+				delay:    200 * time.Millisecond,
+				requests: &requests,
+			},
+		}, http.WithRequestCoalescing(true))
+
+		concurrently(3, func() {
+			_ = service.(client.PayloadAttestationMessagesSubmitter).SubmitPayloadAttestationMessages(ctx,
+				&api.SubmitPayloadAttestationMessagesOpts{
+					PayloadAttestationMessages: []*gloas.PayloadAttestationMessage{
+						{
+							ValidatorIndex: 1,
+							Data:           &gloas.PayloadAttestationData{Slot: 1},
+						},
+					},
+				},
+			)
+		})
+		require.Len(t, requests, 3)
+	})
+}
+
+func TestRequestCoalescingCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	service := newFakeService(ctx, t, map[string]fakeResponse{
+		"/eth/v1/beacon/blocks/head/root": {
+			body:  blockRootBody,
+			delay: 200 * time.Millisecond,
+		},
+	}, http.WithRequestCoalescing(true))
+
+	// The caller that starts the request goes away; a later caller still receives the response.
+	leaderCtx, leaderCancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer leaderCancel()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, err := service.(client.BeaconBlockRootProvider).BeaconBlockRoot(leaderCtx, &api.BeaconBlockRootOpts{
+			Block: "head",
+		})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	response, err := service.(client.BeaconBlockRootProvider).BeaconBlockRoot(ctx, &api.BeaconBlockRootOpts{
+		Block: "head",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, response.Data)
+	wg.Wait()
+}
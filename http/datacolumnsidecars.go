@@ -0,0 +1,128 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/fulu"
+	dynssz "github.com/pk910/dynamic-ssz"
+)
+
+// DataColumnSidecars fetches the data column sidecars given options.
+func (s *Service) DataColumnSidecars(ctx context.Context,
+	opts *api.DataColumnSidecarsOpts,
+) (
+	*api.Response[[]*fulu.DataColumnSidecar],
+	error,
+) {
+	if err := s.assertIsActive(ctx); err != nil {
+		return nil, err
+	}
+
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	if opts.Block == "" {
+		return nil, errors.Join(errors.New("no block specified"), client.ErrInvalidOptions)
+	}
+
+	endpoint := fmt.Sprintf("/eth/v1/debug/beacon/data_column_sidecars/%s", opts.Block)
+	queryItems := make([]string, 0, len(opts.Indices))
+	for _, index := range opts.Indices {
+		queryItems = append(queryItems, fmt.Sprintf("indices=%d", index))
+	}
+
+	httpResponse, err := s.get(ctx, endpoint, strings.Join(queryItems, "&"), &opts.Common, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *api.Response[[]*fulu.DataColumnSidecar]
+
+	switch httpResponse.contentType {
+	case ContentTypeSSZ:
+		response, err = s.dataColumnSidecarsFromSSZ(ctx, httpResponse)
+	case ContentTypeJSON:
+		response, err = s.dataColumnSidecarsFromJSON(httpResponse)
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", httpResponse.contentType)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (s *Service) dataColumnSidecarsFromSSZ(ctx context.Context,
+	res *httpResponse,
+) (
+	*api.Response[[]*fulu.DataColumnSidecar],
+	error,
+) {
+	response := &api.Response[[]*fulu.DataColumnSidecar]{
+		Metadata: metadataFromHeaders(res.headers),
+	}
+
+	if len(res.body) == 0 {
+		// This is a valid response when there are no columns for the request.
+		response.Data = make([]*fulu.DataColumnSidecar, 0)
+
+		return response, nil
+	}
+
+	data := &api.DataColumnSidecars{}
+
+	// There is no generated SSZ code for the list of sidecars, so always decode through dynamic SSZ.
+	dynSsz := dynssz.GetGlobalDynSsz()
+	if s.customSpecSupport {
+		specs, err := s.Spec(ctx, &api.SpecOpts{})
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to request specs"), err)
+		}
+		dynSsz = dynssz.NewDynSsz(specs.Data)
+	}
+
+	err := dynSsz.UnmarshalSSZ(data, res.body)
+
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to decode data column sidecars"), err)
+	}
+
+	response.Data = data.Sidecars
+
+	return response, nil
+}
+
+func (*Service) dataColumnSidecarsFromJSON(res *httpResponse) (*api.Response[[]*fulu.DataColumnSidecar], error) {
+	response := &api.Response[[]*fulu.DataColumnSidecar]{}
+
+	var err error
+
+	response.Data, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body), []*fulu.DataColumnSidecar{})
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
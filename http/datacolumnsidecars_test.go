@@ -0,0 +1,79 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/fulu"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataColumnSidecars(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	service := testService(ctx, t).(client.Service)
+
+	tests := []struct {
+		name string
+		opts *api.DataColumnSidecarsOpts
+		err  string
+	}{
+		{
+			name: "NilOpts",
+			err:  "no options specified",
+		},
+		{
+			name: "NoBlock",
+			opts: &api.DataColumnSidecarsOpts{},
+			err:  "no block specified",
+		},
+		{
+			name: "Head",
+			opts: &api.DataColumnSidecarsOpts{
+				Block: "head",
+			},
+		},
+		{
+			name: "HeadIndices",
+			opts: &api.DataColumnSidecarsOpts{
+				Block:   "head",
+				Indices: []fulu.ColumnIndex{0, 1},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response, err := service.(client.DataColumnSidecarsProvider).DataColumnSidecars(ctx, test.opts)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+
+				return
+			}
+			if err != nil {
+				t.Skipf("Data column sidecars not available: %v", err)
+			}
+			require.NotNil(t, response)
+			require.NotNil(t, response.Data)
+			if len(test.opts.Indices) > 0 {
+				require.LessOrEqual(t, len(response.Data), len(test.opts.Indices))
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDepositSnapshot(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	snapshot := &apiv1.DepositSnapshot{
+		Finalized:            []phase0.Root{{0x01}, {0x02}},
+		DepositRoot:          phase0.Root{0x03},
+		DepositCount:         3,
+		ExecutionBlockHash:   phase0.Hash32{0x04},
+		ExecutionBlockHeight: 5,
+	}
+	snapshotSSZ, err := snapshot.MarshalSSZ()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		response fakeResponse
+		opts     *api.DepositSnapshotOpts
+		expected *apiv1.DepositSnapshot
+		err      string
+	}{
+		{
+			name: "NilOpts",
+			err:  "no options specified",
+		},
+		{
+			name: "JSON",
+			response: fakeResponse{
+				body: `{"data":{"finalized":["0x0100000000000000000000000000000000000000000000000000000000000000","0x0200000000000000000000000000000000000000000000000000000000000000"],"deposit_root":"0x0300000000000000000000000000000000000000000000000000000000000000","deposit_count":"3","execution_block_hash":"0x0400000000000000000000000000000000000000000000000000000000000000","execution_block_height":"5"}}`,
+			},
+			opts:     &api.DepositSnapshotOpts{},
+			expected: snapshot,
+		},
+		{
+			name: "SSZ",
+			response: fakeResponse{
+				contentType: "application/octet-stream",
+				body:        string(snapshotSSZ),
+			},
+			opts:     &api.DepositSnapshotOpts{},
+			expected: snapshot,
+		},
+		{
+			name: "SSZInvalid",
+			response: fakeResponse{
+				contentType: "application/octet-stream",
+				body:        string(snapshotSSZ[:10]),
+			},
+			opts: &api.DepositSnapshotOpts{},
+			err:  "failed to decode deposit snapshot",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service := newFakeService(ctx, t, map[string]fakeResponse{
+				"/eth/v1/beacon/deposit_snapshot": test.response,
+			})
+
+			response, err := service.(client.DepositSnapshotProvider).DepositSnapshot(ctx, test.opts)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.expected, response.Data)
+		})
+	}
+}
@@ -0,0 +1,274 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/r3labs/sse/v2"
+	"github.com/rs/zerolog"
+)
+
+// defaultMaxBackfillBlocks is the default maximum number of blocks for which events are synthesized.
+const defaultMaxBackfillBlocks = 64
+
+// seenBlock is a block seen on an event stream.
+type seenBlock struct {
+	slot phase0.Slot
+	root phase0.Root
+}
+
+// synthesizedBlockEvent identifies an event synthesized by backfill.
+type synthesizedBlockEvent struct {
+	topic string
+	root  phase0.Root
+}
+
+// backfillBlock is a block for which events are synthesized.
+type backfillBlock struct {
+	header              *apiv1.BeaconBlockHeader
+	executionOptimistic bool
+}
+
+// observeBlock records the block referenced by a head or block event,
+// returning false if the event has already been synthesized by backfill.
+func (e *eventStream) observeBlock(msg *sse.Event) bool {
+	var block *seenBlock
+
+	switch string(msg.Event) {
+	case "head":
+		data := &apiv1.HeadEvent{}
+		if err := json.Unmarshal(msg.Data, data); err != nil {
+			return true
+		}
+		block = &seenBlock{slot: data.Slot, root: data.Block}
+	case "block":
+		data := &apiv1.BlockEvent{}
+		if err := json.Unmarshal(msg.Data, data); err != nil {
+			return true
+		}
+		block = &seenBlock{slot: data.Slot, root: data.Block}
+	default:
+		return true
+	}
+
+	if e.isSynthesized(string(msg.Event), block.root) {
+		return false
+	}
+
+	e.setLastBlock(block)
+	e.pruneSynthesized(block.slot)
+
+	return true
+}
+
+func (e *eventStream) takeBackfillDue() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	due := e.backfillDue
+	e.backfillDue = false
+
+	return due
+}
+
+func (e *eventStream) isSynthesized(topic string, root phase0.Root) bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	_, exists := e.synthesized[synthesizedBlockEvent{topic: topic, root: root}]
+
+	return exists
+}
+
+func (e *eventStream) setSynthesized(topic string, root phase0.Root, slot phase0.Slot) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.synthesized[synthesizedBlockEvent{topic: topic, root: root}] = slot
+}
+
+// pruneSynthesized removes synthesized events for blocks before the given slot,
+// as live events for them will not be seen.
+func (e *eventStream) pruneSynthesized(slot phase0.Slot) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	maps.DeleteFunc(e.synthesized, func(_ synthesizedBlockEvent, synthesizedSlot phase0.Slot) bool {
+		return synthesizedSlot < slot
+	})
+}
+
+func (e *eventStream) setLastBlock(block *seenBlock) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.lastBlock != nil && e.lastBlock.slot > block.slot {
+		return
+	}
+	e.lastBlock = block
+}
+
+func (e *eventStream) getLastBlock() *seenBlock {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	return e.lastBlock
+}
+
+// backfillEvents synthesizes the head and block events for blocks between
+// the last block seen on the stream and the current head.  Failures are logged
+// and not retried, as they do not affect the live stream.
+func (s *Service) backfillEvents(ctx context.Context,
+	stream *eventStream,
+	opts *api.EventsOpts,
+) {
+	log := zerolog.Ctx(ctx)
+
+	sendHead := slices.Contains(opts.Topics, "head")
+	sendBlock := slices.Contains(opts.Topics, "block")
+	if !sendHead && !sendBlock {
+		return
+	}
+
+	lastBlock := stream.getLastBlock()
+	if lastBlock == nil {
+		log.Trace().Msg("No block seen prior to reconnection; nothing to backfill")
+
+		return
+	}
+
+	maxBlocks := opts.MaxBackfillBlocks
+	if maxBlocks <= 0 {
+		maxBlocks = defaultMaxBackfillBlocks
+	}
+
+	// Walk back from the current head until we reach the last block seen.
+	blocks := make([]*backfillBlock, 0)
+	blockID := "head"
+	var parentSlot phase0.Slot
+	for {
+		response, err := s.BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{
+			Block: blockID,
+		})
+		if err != nil {
+			log.Warn().Err(err).Str("block", blockID).Msg("Failed to obtain block header for backfill")
+
+			return
+		}
+
+		header := response.Data
+		if header.Root == lastBlock.root || header.Header.Message.Slot <= lastBlock.slot {
+			parentSlot = header.Header.Message.Slot
+
+			break
+		}
+
+		if len(blocks) == maxBlocks {
+			log.Warn().Int("max_blocks", maxBlocks).Msg("Backfill limit reached; earlier blocks not synthesized")
+			parentSlot = header.Header.Message.Slot
+
+			break
+		}
+
+		optimistic, isBool := response.Metadata["execution_optimistic"].(bool)
+		blocks = append(blocks, &backfillBlock{
+			header:              header,
+			executionOptimistic: isBool && optimistic,
+		})
+		blockID = fmt.Sprintf("%#x", header.Header.Message.ParentRoot)
+	}
+
+	if len(blocks) == 0 {
+		return
+	}
+
+	var slotsPerEpoch uint64
+	if sendHead {
+		var err error
+		slotsPerEpoch, err = s.SlotsPerEpoch(ctx)
+		if err != nil {
+			log.Warn().Err(err).Msg("Failed to obtain slots per epoch for backfill")
+
+			return
+		}
+	}
+
+	log.Debug().Int("blocks", len(blocks)).Msg("Backfilling events")
+
+	ctx = api.WithSynthesizedEvent(ctx)
+	for i := len(blocks) - 1; i >= 0; i-- {
+		header := blocks[i].header
+		slot := header.Header.Message.Slot
+
+		if sendBlock {
+			stream.setSynthesized("block", header.Root, slot)
+			sendSynthesizedBlockEvent(ctx, opts, &apiv1.BlockEvent{
+				Slot:                slot,
+				Block:               header.Root,
+				ExecutionOptimistic: blocks[i].executionOptimistic,
+			})
+		}
+		if sendHead {
+			stream.setSynthesized("head", header.Root, slot)
+			sendSynthesizedHeadEvent(ctx, opts, &apiv1.HeadEvent{
+				Slot:            slot,
+				Block:           header.Root,
+				State:           header.Header.Message.StateRoot,
+				EpochTransition: uint64(slot)/slotsPerEpoch != uint64(parentSlot)/slotsPerEpoch,
+			})
+		}
+
+		stream.setLastBlock(&seenBlock{slot: slot, root: header.Root})
+		parentSlot = slot
+	}
+}
+
+// sendSynthesizedBlockEvent sends a synthesized block event to the relevant handler.
+func sendSynthesizedBlockEvent(ctx context.Context, opts *api.EventsOpts, event *apiv1.BlockEvent) {
+	switch {
+	case opts.BlockHandler != nil:
+		opts.BlockHandler(ctx, event)
+	case opts.Handler != nil:
+		opts.Handler(synthesizedEvent("block", event))
+	}
+}
+
+// sendSynthesizedHeadEvent sends a synthesized head event to the relevant handler.
+func sendSynthesizedHeadEvent(ctx context.Context, opts *api.EventsOpts, event *apiv1.HeadEvent) {
+	switch {
+	case opts.HeadHandler != nil:
+		opts.HeadHandler(ctx, event)
+	case opts.Handler != nil:
+		opts.Handler(synthesizedEvent("head", event))
+	}
+}
+
+// synthesizedEvent creates a generic event flagged as synthesized.
+func synthesizedEvent(topic string, data any) *apiv1.Event {
+	return &apiv1.Event{
+		Topic: topic,
+		Data:  data,
+		Metadata: map[string]any{
+			api.EventMetadataSynthesized: true,
+		},
+	}
+}
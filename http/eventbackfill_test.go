@@ -0,0 +1,187 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	nethttp "net/http"
+	"sync"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// blockHeaderBody returns the body of a block header response for the given slot,
+// with a root and parent root derived from the slot.
+func blockHeaderBody(t *testing.T, slot phase0.Slot) string {
+	t.Helper()
+
+	data, err := json.Marshal(&apiv1.BeaconBlockHeader{
+		Root:      phase0.Root{byte(slot)},
+		Canonical: true,
+		Header: &phase0.SignedBeaconBlockHeader{
+			Message: &phase0.BeaconBlockHeader{
+				Slot:       slot,
+				ParentRoot: phase0.Root{byte(slot - 1)},
+				StateRoot:  phase0.Root{0xff, byte(slot)},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	return fmt.Sprintf(`{"execution_optimistic":false,"finalized":false,"data":%s}`, data)
+}
+
+// writeHeadEvent writes a head event for the given slot, with a root derived from the slot.
+func writeHeadEvent(w nethttp.ResponseWriter, slot phase0.Slot) {
+	_, _ = fmt.Fprintf(w, "event: head\ndata: {\"slot\":\"%d\",\"block\":\"%#x\",\"state\":\"%#x\",\"epoch_transition\":false,\"previous_duty_dependent_root\":\"%#x\",\"current_duty_dependent_root\":\"%#x\"}\n\n",
+		slot, phase0.Root{byte(slot)}, phase0.Root{}, phase0.Root{}, phase0.Root{})
+	w.(nethttp.Flusher).Flush()
+}
+
+func TestEventsBackfill(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var connectionsMu sync.Mutex
+	connections := 0
+	headRequests := 0
+	responses := map[string]fakeResponse{
+		"/eth/v1/events": {
+			handler: func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				connectionsMu.Lock()
+				connections++
+				connection := connections
+				connectionsMu.Unlock()
+
+				if connection == 2 {
+					// The node is unavailable for the first reconnection attempt.
+					w.WriteHeader(nethttp.StatusServiceUnavailable)
+
+					return
+				}
+
+				w.Header().Set("Content-Type", "text/event-stream")
+				w.WriteHeader(nethttp.StatusOK)
+				switch connection {
+				case 1:
+					// The first connection sends a single event and ends.
+					writeHeadEvent(w, 1)
+				case 3:
+					// The next connection sends the current head, which has already
+					// been synthesized, and ends.
+					writeHeadEvent(w, 4)
+				default:
+					// Later connections send the current head again, which was synthesized
+					// before the previous reconnection, and a new head, then hold the
+					// connection open.
+					writeHeadEvent(w, 4)
+					writeHeadEvent(w, 5)
+					<-r.Context().Done()
+				}
+			},
+		},
+		"/eth/v1/beacon/headers/head": {
+			handler: func(w nethttp.ResponseWriter, _ *nethttp.Request) {
+				connectionsMu.Lock()
+				headRequests++
+				connectionsMu.Unlock()
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(blockHeaderBody(t, 4)))
+			},
+		},
+		"/eth/v1/config/spec": {
+			body: `{"data":{"SLOTS_PER_EPOCH":"2"}}`,
+		},
+	}
+	for slot := phase0.Slot(1); slot <= 4; slot++ {
+		responses[fmt.Sprintf("/eth/v1/beacon/headers/%#x", phase0.Root{byte(slot)})] = fakeResponse{
+			body: blockHeaderBody(t, slot),
+		}
+	}
+	service := newFakeService(ctx, t, responses)
+
+	type received struct {
+		topic           string
+		slot            phase0.Slot
+		epochTransition bool
+		synthesized     bool
+	}
+	var mu sync.Mutex
+	var events []received
+	stream, err := service.(client.EventsProvider).Events(ctx, &api.EventsOpts{
+		Topics:           []string{"head", "block"},
+		ReconnectBackoff: 10 * time.Millisecond,
+		Backfill:         true,
+		HeadHandler: func(ctx context.Context, event *apiv1.HeadEvent) {
+			mu.Lock()
+			events = append(events, received{
+				topic:           "head",
+				slot:            event.Slot,
+				epochTransition: event.EpochTransition,
+				synthesized:     api.IsSynthesizedEvent(ctx),
+			})
+			mu.Unlock()
+		},
+		Handler: func(event *apiv1.Event) {
+			blockEvent, isBlockEvent := event.Data.(*apiv1.BlockEvent)
+			if !isBlockEvent {
+				return
+			}
+			synthesized, _ := event.Metadata[api.EventMetadataSynthesized].(bool)
+			mu.Lock()
+			events = append(events, received{
+				topic:       event.Topic,
+				slot:        blockEvent.Slot,
+				synthesized: synthesized,
+			})
+			mu.Unlock()
+		},
+	})
+	require.NoError(t, err)
+	defer stream.Close()
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return len(events) == 8
+	}, 5*time.Second, 10*time.Millisecond)
+
+	mu.Lock()
+	require.Equal(t, []received{
+		{topic: "head", slot: 1},
+		{topic: "block", slot: 2, synthesized: true},
+		{topic: "head", slot: 2, epochTransition: true, synthesized: true},
+		{topic: "block", slot: 3, synthesized: true},
+		{topic: "head", slot: 3, synthesized: true},
+		{topic: "block", slot: 4, synthesized: true},
+		{topic: "head", slot: 4, epochTransition: true, synthesized: true},
+		{topic: "head", slot: 5},
+	}, events)
+	mu.Unlock()
+
+	// Backfill runs once for each reconnection, and not for failed attempts.
+	connectionsMu.Lock()
+	require.Equal(t, 2, headRequests)
+	connectionsMu.Unlock()
+}
@@ -38,17 +38,17 @@ import (
 )
 
 // Events feeds requested events with the given topics to the supplied handler.
-func (s *Service) Events(ctx context.Context, opts *api.EventsOpts) error {
+func (s *Service) Events(ctx context.Context, opts *api.EventsOpts) (api.EventStream, error) {
 	if err := s.assertIsActive(ctx); err != nil {
-		return err
+		return nil, err
 	}
 
 	if opts == nil {
-		return client.ErrNoOptions
+		return nil, client.ErrNoOptions
 	}
 
 	if len(opts.Topics) == 0 {
-		return errors.Join(errors.New("no topics supplied"), client.ErrInvalidOptions)
+		return nil, errors.Join(errors.New("no topics supplied"), client.ErrInvalidOptions)
 	}
 
 	// #nosec G404
@@ -56,7 +56,7 @@ func (s *Service) Events(ctx context.Context, opts *api.EventsOpts) error {
 	ctx = log.WithContext(ctx)
 
 	if err := s.checkEventsOpts(opts); err != nil {
-		return err
+		return nil, err
 	}
 
 	endpoint := "/eth/v1/events"
@@ -64,6 +64,9 @@ func (s *Service) Events(ctx context.Context, opts *api.EventsOpts) error {
 	callURL := urlForCall(s.base, endpoint, query)
 	log.Trace().Str("url", callURL.String()).Msg("GET request to events stream")
 
+	ctx, cancel := context.WithCancel(ctx)
+	stream := newEventStream(cancel)
+
 	sseClient := sse.NewClient(callURL.String())
 	maps.Copy(sseClient.Headers, s.extraHeaders)
 
@@ -79,28 +82,32 @@ func (s *Service) Events(ctx context.Context, opts *api.EventsOpts) error {
 		}).Dial,
 	}
 
-	go func() {
-		for {
-			select {
-			case <-time.After(time.Second):
-				log.Trace().Msg("Connecting to events stream")
+	// Reconnection is handled by runEventStream, so each subscription makes a single attempt.
+	sseClient.ReconnectStrategy = &noReconnect{}
+	sseClient.ResponseValidator = func(_ *sse.Client, resp *http.Response) error {
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
 
-				if err := sseClient.SubscribeRawWithContext(ctx, func(msg *sse.Event) {
-					s.handleEvent(ctx, msg, opts)
-				}); err != nil {
-					log.Error().Err(err).Msg("Failed to subscribe to event stream")
-				}
+			return fmt.Errorf("could not connect to stream: %s", http.StatusText(resp.StatusCode))
+		}
 
-				log.Trace().Msg("Events stream disconnected")
-			case <-ctx.Done():
-				log.Debug().Msg("Context done")
+		stream.setConnected()
+		log.Trace().Msg("Events stream connected")
 
-				return
-			}
+		if opts.ConnectedHandler != nil {
+			opts.ConnectedHandler(ctx)
 		}
-	}()
 
-	return nil
+		return nil
+	}
+
+	if opts.LastEventID != "" {
+		sseClient.LastEventID.Store([]byte(opts.LastEventID))
+	}
+
+	go s.runEventStream(ctx, stream, sseClient, opts)
+
+	return stream, nil
 }
 
 func (s *Service) checkEventsOpts(opts *api.EventsOpts) error {
@@ -149,6 +156,10 @@ func (*Service) checkEventSpecificHandler(opts *api.EventsOpts, topic string) er
 		hasHandler = opts.FinalizedCheckpointHandler != nil
 	case "head":
 		hasHandler = opts.HeadHandler != nil
+	case "light_client_finality_update":
+		hasHandler = opts.LightClientFinalityUpdateHandler != nil
+	case "light_client_optimistic_update":
+		hasHandler = opts.LightClientOptimisticUpdateHandler != nil
 	case "payload_attributes":
 		hasHandler = opts.PayloadAttributesHandler != nil
 	case "proposer_slashing":
@@ -204,6 +215,10 @@ func (s *Service) handleEvent(ctx context.Context,
 		s.handleFinalizedCheckpointEvent(ctx, msg, opts)
 	case "head":
 		s.handleHeadEvent(ctx, msg, opts)
+	case "light_client_finality_update":
+		s.handleLightClientFinalityUpdateEvent(ctx, msg, opts)
+	case "light_client_optimistic_update":
+		s.handleLightClientOptimisticUpdateEvent(ctx, msg, opts)
 	case "payload_attributes":
 		s.handlePayloadAttributesEvent(ctx, msg, opts)
 	case "proposer_slashing":
@@ -489,6 +504,60 @@ func (*Service) handleHeadEvent(ctx context.Context,
 	}
 }
 
+func (*Service) handleLightClientFinalityUpdateEvent(ctx context.Context,
+	msg *sse.Event,
+	opts *api.EventsOpts,
+) {
+	log := zerolog.Ctx(ctx)
+	data := &spec.VersionedLightClientFinalityUpdate{}
+
+	err := json.Unmarshal(msg.Data, data)
+	if err != nil {
+		log.Error().Err(err).RawJSON("data", msg.Data).Msg("Failed to parse light client finality update event")
+
+		return
+	}
+
+	switch {
+	case opts.LightClientFinalityUpdateHandler != nil:
+		opts.LightClientFinalityUpdateHandler(ctx, data)
+	case opts.Handler != nil:
+		opts.Handler(&apiv1.Event{
+			Topic: string(msg.Event),
+			Data:  data,
+		})
+	default:
+		log.Debug().Msg("No specific or generic handler supplied; ignoring")
+	}
+}
+
+func (*Service) handleLightClientOptimisticUpdateEvent(ctx context.Context,
+	msg *sse.Event,
+	opts *api.EventsOpts,
+) {
+	log := zerolog.Ctx(ctx)
+	data := &spec.VersionedLightClientOptimisticUpdate{}
+
+	err := json.Unmarshal(msg.Data, data)
+	if err != nil {
+		log.Error().Err(err).RawJSON("data", msg.Data).Msg("Failed to parse light client optimistic update event")
+
+		return
+	}
+
+	switch {
+	case opts.LightClientOptimisticUpdateHandler != nil:
+		opts.LightClientOptimisticUpdateHandler(ctx, data)
+	case opts.Handler != nil:
+		opts.Handler(&apiv1.Event{
+			Topic: string(msg.Event),
+			Data:  data,
+		})
+	default:
+		log.Debug().Msg("No specific or generic handler supplied; ignoring")
+	}
+}
+
 func (*Service) handlePayloadAttributesEvent(ctx context.Context,
 	msg *sse.Event,
 	opts *api.EventsOpts,
@@ -163,6 +163,15 @@ func TestEventHandler(t *testing.T) {
 			handler: handler,
 			handled: true,
 		},
+		{
+			name: "LightClientOptimisticUpdateGood",
+			message: &sse.Event{
+				Event: []byte("light_client_optimistic_update"),
+				Data:  []byte(`{"version":"altair","data":{"attested_header":{"beacon":{"slot":"1","proposer_index":"2","parent_root":"0x0101010101010101010101010101010101010101010101010101010101010101","state_root":"0x0202020202020202020202020202020202020202020202020202020202020202","body_root":"0x0303030303030303030303030303030303030303030303030303030303030303"}},"sync_aggregate":{"sync_committee_bits":"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff","sync_committee_signature":"0x8e1111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111"},"signature_slot":"2"}}`),
+			},
+			handler: handler,
+			handled: true,
+		},
 	}
 
 	// Note: Rate limiting for internal tests would need to be implemented separately
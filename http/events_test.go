@@ -46,7 +46,7 @@ func TestEvents(t *testing.T) {
 			ctx, cancel := context.WithCancel(context.Background())
 			eventsMu := sync.Mutex{}
 			events := 0
-			err := service.(client.EventsProvider).Events(ctx, &api.EventsOpts{
+			stream, err := service.(client.EventsProvider).Events(ctx, &api.EventsOpts{
 				Topics: test.topics,
 				Handler: func(*apiv1.Event) {
 					eventsMu.Lock()
@@ -59,6 +59,7 @@ func TestEvents(t *testing.T) {
 			eventsMu.Lock()
 			defer eventsMu.Unlock()
 			require.NotEqual(t, 0, events)
+			require.Equal(t, api.EventStreamStateConnected, stream.Status().State)
 			cancel()
 		})
 	}
@@ -0,0 +1,227 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/r3labs/sse/v2"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// defaultReconnectBackoff is the default delay before reconnecting an event stream.
+	defaultReconnectBackoff = time.Second
+	// defaultMaxReconnectBackoff is the default maximum delay before reconnecting an event stream.
+	defaultMaxReconnectBackoff = 30 * time.Second
+)
+
+// errEventStreamEnded is the cause of a disconnection when the server ends the stream.
+var errEventStreamEnded = errors.New("event stream ended by server")
+
+// noReconnect is a reconnection strategy for the SSE client that does not reconnect.
+type noReconnect struct{}
+
+// NextBackOff returns the value that stops the SSE client from reconnecting.
+func (*noReconnect) NextBackOff() time.Duration {
+	return -1
+}
+
+// Reset does nothing.
+func (*noReconnect) Reset() {}
+
+// eventStream is the handle for an event stream.
+type eventStream struct {
+	cancel context.CancelFunc
+
+	mu     sync.RWMutex
+	status api.EventStreamStatus
+
+	// lastBlock is the last block seen on the stream, used for backfill.
+	lastBlock *seenBlock
+	// backfillDue is set when the stream reconnects, and cleared when backfill runs.
+	backfillDue bool
+	// synthesized are the events synthesized by backfill, with the slots of their blocks.
+	// They are kept until a live event is seen for a later slot.
+	synthesized map[synthesizedBlockEvent]phase0.Slot
+}
+
+func newEventStream(cancel context.CancelFunc) *eventStream {
+	return &eventStream{
+		cancel:      cancel,
+		synthesized: make(map[synthesizedBlockEvent]phase0.Slot),
+		status: api.EventStreamStatus{
+			State: api.EventStreamStateConnecting,
+			Since: time.Now(),
+		},
+	}
+}
+
+// Status returns the current status of the stream.
+func (e *eventStream) Status() *api.EventStreamStatus {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	status := e.status
+
+	return &status
+}
+
+// Close closes the stream.
+func (e *eventStream) Close() {
+	e.cancel()
+	e.setClosed()
+}
+
+func (e *eventStream) setConnected() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.status.State == api.EventStreamStateClosed {
+		return
+	}
+
+	if e.status.State == api.EventStreamStateReconnecting {
+		e.status.Reconnects++
+		e.backfillDue = true
+	}
+	e.status.State = api.EventStreamStateConnected
+	e.status.Since = time.Now()
+}
+
+// setDisconnected marks the stream as reconnecting, returning true if it
+// was previously connected.
+func (e *eventStream) setDisconnected(err error) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.status.State == api.EventStreamStateClosed {
+		return false
+	}
+
+	e.status.LastError = err
+	if e.status.State != api.EventStreamStateConnected {
+		return false
+	}
+
+	e.status.State = api.EventStreamStateReconnecting
+	e.status.Since = time.Now()
+
+	return true
+}
+
+func (e *eventStream) setReceived(msg *sse.Event) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.status.LastEventTime = time.Now()
+	if len(msg.ID) > 0 {
+		e.status.LastEventID = string(msg.ID)
+	}
+}
+
+func (e *eventStream) setClosed() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.status.State == api.EventStreamStateClosed {
+		return
+	}
+
+	e.status.State = api.EventStreamStateClosed
+	e.status.Since = time.Now()
+}
+
+// runEventStream keeps the event stream connected until its context is done,
+// reconnecting with exponential backoff.
+func (s *Service) runEventStream(ctx context.Context,
+	stream *eventStream,
+	sseClient *sse.Client,
+	opts *api.EventsOpts,
+) {
+	log := zerolog.Ctx(ctx)
+	defer stream.setClosed()
+
+	initialBackoff := opts.ReconnectBackoff
+	if initialBackoff <= 0 {
+		initialBackoff = defaultReconnectBackoff
+	}
+	maxBackoff := opts.MaxReconnectBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = defaultMaxReconnectBackoff
+	}
+
+	attempt := 0
+	for {
+		log.Trace().Msg("Connecting to events stream")
+
+		err := sseClient.SubscribeRawWithContext(ctx, func(msg *sse.Event) {
+			stream.setReceived(msg)
+			if opts.Backfill {
+				if stream.takeBackfillDue() {
+					// Backfill once the stream has reconnected, before handling live events.
+					s.backfillEvents(ctx, stream, opts)
+				}
+				if !stream.observeBlock(msg) {
+					// Event has already been sent by backfill.
+					return
+				}
+			}
+			s.handleEvent(ctx, msg, opts)
+		})
+		if ctx.Err() != nil {
+			log.Debug().Msg("Context done")
+
+			return
+		}
+
+		if err == nil {
+			err = errEventStreamEnded
+		}
+
+		if stream.setDisconnected(err) {
+			log.Debug().Err(err).Msg("Events stream disconnected")
+			attempt = 0
+
+			if opts.DisconnectedHandler != nil {
+				opts.DisconnectedHandler(ctx, err)
+			}
+		} else {
+			log.Error().Err(err).Msg("Failed to subscribe to event stream")
+		}
+
+		attempt++
+		delay := initialBackoff << min(attempt-1, 30)
+		if delay <= 0 || delay > maxBackoff {
+			delay = maxBackoff
+		}
+
+		if opts.ReconnectingHandler != nil {
+			opts.ReconnectingHandler(ctx, attempt, delay)
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Debug().Msg("Context done")
+
+			return
+		case <-time.After(delay):
+		}
+	}
+}
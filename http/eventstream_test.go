@@ -0,0 +1,178 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	"sync"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+const headEventData = `{"slot":"%d","block":"0x73d83c5f925716c9bd2d1e9c339fb99b0ec4addef3e93f6f35d4c5f1de7ae092","state":"0xead0e6eb4004576546864f10cfa4aeac31afbf96abc405a86c00cbda8f3e8ed0","epoch_transition":false,"previous_duty_dependent_root":"0xeca94cc9180212a2cff2659289cc7e6f2df08a645120e35e25d09c2ddc7db5f1","current_duty_dependent_root":"0xdda286c4a096fc8ec0d6ba9e14e688cbb046bfb33462fdf94953e75d0cea0074","execution_optimistic":false}`
+
+// eventStreamRecorder records the lifecycle of an event stream.
+type eventStreamRecorder struct {
+	mu            sync.Mutex
+	connections   int
+	lastEventIDs  []string
+	connected     int
+	disconnected  []error
+	reconnections []int
+	slots         []phase0.Slot
+}
+
+// handler serves a head event on each connection, ending the first
+// connection after its event and holding subsequent connections open.
+func (r *eventStreamRecorder) handler(w nethttp.ResponseWriter, req *nethttp.Request) {
+	r.mu.Lock()
+	r.connections++
+	connection := r.connections
+	r.lastEventIDs = append(r.lastEventIDs, req.Header.Get("Last-Event-ID"))
+	r.mu.Unlock()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.WriteHeader(nethttp.StatusOK)
+	_, _ = fmt.Fprintf(w, "id: %d\nevent: head\ndata: "+headEventData+"\n\n", connection, connection)
+	w.(nethttp.Flusher).Flush()
+
+	if connection == 1 {
+		return
+	}
+
+	<-req.Context().Done()
+}
+
+func (r *eventStreamRecorder) opts() *api.EventsOpts {
+	return &api.EventsOpts{
+		Topics:           []string{"head"},
+		LastEventID:      "0",
+		ReconnectBackoff: 10 * time.Millisecond,
+		HeadHandler: func(_ context.Context, event *apiv1.HeadEvent) {
+			r.mu.Lock()
+			r.slots = append(r.slots, event.Slot)
+			r.mu.Unlock()
+		},
+		ConnectedHandler: func(context.Context) {
+			r.mu.Lock()
+			r.connected++
+			r.mu.Unlock()
+		},
+		DisconnectedHandler: func(_ context.Context, err error) {
+			r.mu.Lock()
+			r.disconnected = append(r.disconnected, err)
+			r.mu.Unlock()
+		},
+		ReconnectingHandler: func(_ context.Context, attempt int, _ time.Duration) {
+			r.mu.Lock()
+			r.reconnections = append(r.reconnections, attempt)
+			r.mu.Unlock()
+		},
+	}
+}
+
+func TestEventStreamReconnect(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	recorder := &eventStreamRecorder{}
+	service := newFakeService(ctx, t, map[string]fakeResponse{
+		"/eth/v1/events": {
+			handler: recorder.handler,
+		},
+	})
+
+	stream, err := service.(client.EventsProvider).Events(ctx, recorder.opts())
+	require.NoError(t, err)
+	defer stream.Close()
+
+	require.Eventually(t, func() bool {
+		recorder.mu.Lock()
+		defer recorder.mu.Unlock()
+
+		return len(recorder.slots) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	recorder.mu.Lock()
+	require.Equal(t, []phase0.Slot{1, 2}, recorder.slots)
+	require.Equal(t, []string{"0", "1"}, recorder.lastEventIDs)
+	require.Equal(t, 2, recorder.connected)
+	require.Len(t, recorder.disconnected, 1)
+	require.Equal(t, []int{1}, recorder.reconnections)
+	recorder.mu.Unlock()
+
+	status := stream.Status()
+	require.Equal(t, api.EventStreamStateConnected, status.State)
+	require.Equal(t, uint64(1), status.Reconnects)
+	require.Equal(t, "2", status.LastEventID)
+	require.Error(t, status.LastError)
+
+	stream.Close()
+	require.Equal(t, api.EventStreamStateClosed, stream.Status().State)
+}
+
+func TestEventStreamConnectFailure(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	service := newFakeService(ctx, t, map[string]fakeResponse{
+		"/eth/v1/events": {
+			handler: func(w nethttp.ResponseWriter, _ *nethttp.Request) {
+				w.WriteHeader(nethttp.StatusServiceUnavailable)
+			},
+		},
+	})
+
+	var mu sync.Mutex
+	var attempts []int
+	var delays []time.Duration
+	stream, err := service.(client.EventsProvider).Events(ctx, &api.EventsOpts{
+		Topics:              []string{"head"},
+		ReconnectBackoff:    time.Millisecond,
+		MaxReconnectBackoff: 4 * time.Millisecond,
+		HeadHandler:         func(context.Context, *apiv1.HeadEvent) {},
+		ReconnectingHandler: func(_ context.Context, attempt int, delay time.Duration) {
+			mu.Lock()
+			attempts = append(attempts, attempt)
+			delays = append(delays, delay)
+			mu.Unlock()
+		},
+	})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return len(attempts) >= 4
+	}, 5*time.Second, 10*time.Millisecond)
+	stream.Close()
+
+	mu.Lock()
+	require.Equal(t, []int{1, 2, 3, 4}, attempts[:4])
+	require.Equal(t, []time.Duration{time.Millisecond, 2 * time.Millisecond, 4 * time.Millisecond, 4 * time.Millisecond}, delays[:4])
+	mu.Unlock()
+
+	status := stream.Status()
+	require.Equal(t, api.EventStreamStateClosed, status.State)
+	require.ErrorContains(t, status.LastError, "Service Unavailable")
+}
@@ -0,0 +1,135 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	nethttp "net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeResponse is a canned response from a fake beacon node.
+// The content type defaults to JSON if not supplied.
+type fakeResponse struct {
+	version     string
+	contentType string
+	body        string
+	// rejectSSZ results in SSZ request bodies being rejected as an unsupported media type.
+	rejectSSZ bool
+	// requests, if supplied, records the content type of each request.
+	requests *[]string
+	// failures, if supplied, are status codes returned in turn before the response is served.
+	failures []int
+	// retryAfter, if supplied, is returned as the Retry-After header with failures.
+	retryAfter string
+	// delay, if supplied, is the time to wait before responding.
+	delay time.Duration
+	// handler, if supplied, serves the request in place of the canned response.
+	handler nethttp.HandlerFunc
+}
+
+// newFakeService returns a service connected to a fake beacon node that serves
+// the supplied responses by path, and enough of the node API to be active.
+func newFakeService(ctx context.Context,
+	t *testing.T,
+	responses map[string]fakeResponse,
+	params ...http.Parameter,
+) client.Service {
+	t.Helper()
+
+	var attemptsMu sync.Mutex
+	attempts := make(map[string]int)
+
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		switch r.URL.Path {
+		case "/eth/v1/node/version":
+			_, _ = w.Write([]byte(`{"data":{"version":"test"}}`))
+
+			return
+		case "/eth/v1/node/syncing":
+			_, _ = w.Write([]byte(`{"data":{"head_slot":"1","sync_distance":"0","is_syncing":false,"is_optimistic":false,"el_offline":false}}`))
+
+			return
+		}
+
+		response, exists := responses[r.URL.Path]
+		if !exists {
+			w.WriteHeader(nethttp.StatusNotFound)
+
+			return
+		}
+
+		if response.handler != nil {
+			response.handler(w, r)
+
+			return
+		}
+
+		if response.delay > 0 {
+			time.Sleep(response.delay)
+		}
+
+		attemptsMu.Lock()
+		if response.requests != nil {
+			*response.requests = append(*response.requests, r.Header.Get("Content-Type"))
+		}
+		attempt := attempts[r.URL.Path]
+		attempts[r.URL.Path]++
+		attemptsMu.Unlock()
+
+		if attempt < len(response.failures) {
+			if response.retryAfter != "" {
+				w.Header().Set("Retry-After", response.retryAfter)
+			}
+			w.WriteHeader(response.failures[attempt])
+
+			return
+		}
+
+		if response.rejectSSZ && r.Header.Get("Content-Type") == "application/octet-stream" {
+			w.WriteHeader(nethttp.StatusUnsupportedMediaType)
+
+			return
+		}
+
+		contentType := response.contentType
+		if contentType == "" {
+			contentType = "application/json"
+		}
+		w.Header().Set("Content-Type", contentType)
+		if response.version != "" {
+			w.Header().Set("Eth-Consensus-Version", response.version)
+		}
+		_, _ = w.Write([]byte(response.body))
+	}))
+	t.Cleanup(srv.Close)
+
+	service, err := http.New(ctx,
+		append([]http.Parameter{
+			http.WithLogLevel(zerolog.Disabled),
+			http.WithAddress(srv.URL),
+		}, params...)...,
+	)
+	require.NoError(t, err)
+
+	return service
+}
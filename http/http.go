@@ -37,8 +37,22 @@ import (
 // defaultUserAgent is sent with requests if no other user agent has been supplied.
 const defaultUserAgent = "go-eth2-client/0.29.0"
 
-// post sends an HTTP post request and returns the body.
-func (s *Service) post(ctx context.Context,
+// connectionError is an error that suggests that the connection to the node has failed.
+type connectionError struct {
+	err error
+}
+
+func (e *connectionError) Error() string {
+	return e.err.Error()
+}
+
+func (e *connectionError) Unwrap() error {
+	return e.err
+}
+
+// postOnce makes a single attempt to send an HTTP post request and returns the body.
+// If the server returns an error status then the response is returned alongside the error.
+func (s *Service) postOnce(ctx context.Context,
 	endpoint string,
 	query string,
 	opts *api.CommonOpts,
@@ -109,11 +123,10 @@ func (s *Service) post(ctx context.Context,
 			// We don't consider context deadline exceeded to be a potential connection issue, as the user selected the deadline.
 		default:
 			// We consider other errors to be potential connection issues.
-			go s.CheckConnectionState(ctx)
+			err = &connectionError{err: err}
 		}
 
 		span.SetStatus(codes.Error, err.Error())
-		s.monitorPostComplete(ctx, callURL.Path, "failed")
 
 		return nil, errors.Join(errors.New("failed to call POST endpoint"), err)
 	}
@@ -138,7 +151,6 @@ func (s *Service) post(ctx context.Context,
 		}
 
 		span.SetStatus(codes.Error, err.Error())
-		s.monitorPostComplete(ctx, callURL.Path, "failed")
 
 		return nil, errors.Join(errors.New("failed to read POST response"), err)
 	}
@@ -147,7 +159,6 @@ func (s *Service) post(ctx context.Context,
 		// Nothing returned.  This is not considered an error.
 		span.AddEvent("Received empty response")
 		log.Trace().Msg("Endpoint returned no content")
-		s.monitorPostComplete(ctx, callURL.Path, "succeeded")
 
 		return res, nil
 	}
@@ -176,9 +187,8 @@ func (s *Service) post(ctx context.Context,
 		s.logBadStatus(ctx, "POST", res, log)
 
 		span.SetStatus(codes.Error, fmt.Sprintf("Status code %d", resp.StatusCode))
-		s.monitorPostComplete(ctx, callURL.Path, "failed")
 
-		return nil, &api.Error{
+		return res, &api.Error{
 			Method:     http.MethodPost,
 			StatusCode: resp.StatusCode,
 			Endpoint:   endpoint,
@@ -186,8 +196,6 @@ func (s *Service) post(ctx context.Context,
 		}
 	}
 
-	s.monitorPostComplete(ctx, callURL.Path, "succeeded")
-
 	return res, nil
 }
 
@@ -227,10 +235,11 @@ type httpResponse struct {
 	body             []byte
 }
 
-// get sends an HTTP get request and returns the response.
+// getOnce makes a single attempt to send an HTTP get request and returns the response.
+// If the server returns an error status then the response is returned alongside the error.
 //
 //nolint:revive
-func (s *Service) get(ctx context.Context,
+func (s *Service) getOnce(ctx context.Context,
 	endpoint string,
 	query string,
 	opts *api.CommonOpts,
@@ -282,16 +291,15 @@ func (s *Service) get(ctx context.Context,
 			// We don't consider context canceled to be a potential connection issue, as the user canceled the context.
 		case errors.Is(err, context.DeadlineExceeded):
 			// We don't consider context deadline exceeded to be a potential connection issue, as the user selected the deadline.
-		case strings.HasSuffix(callURL.String(), "/node/syncing"):
-			// Special case; if we have called the syncing endpoint and it failed then we don't check the connection status, as
-			// that calls the syncing endpoint itself and so we find ourselves in an endless loop.
+		case strings.HasSuffix(callURL.Path, "/node/syncing"), strings.HasSuffix(callURL.Path, "/node/health"):
+			// Special case; if we have called the syncing or health endpoint and it failed then we don't check the connection
+			// status, as that calls one of these endpoints itself and so we find ourselves in an endless loop.
 		default:
 			// We consider other errors to be potential connection issues.
-			go s.CheckConnectionState(ctx)
+			err = &connectionError{err: err}
 		}
 
 		span.SetStatus(codes.Error, err.Error())
-		s.monitorGetComplete(ctx, callURL.Path, "failed")
 
 		return nil, errors.Join(errors.New("failed to call GET endpoint"), err)
 	}
@@ -320,7 +328,6 @@ func (s *Service) get(ctx context.Context,
 		}
 
 		span.SetStatus(codes.Error, err.Error())
-		s.monitorGetComplete(ctx, callURL.Path, "failed")
 
 		return nil, errors.Join(errors.New("failed to read GET response"), err)
 	}
@@ -329,7 +336,6 @@ func (s *Service) get(ctx context.Context,
 		// Nothing returned.  This is not considered an error.
 		span.AddEvent("Received empty response")
 		log.Trace().Msg("Endpoint returned no content")
-		s.monitorGetComplete(ctx, callURL.Path, "succeeded")
 
 		return res, nil
 	}
@@ -351,9 +357,8 @@ func (s *Service) get(ctx context.Context,
 		s.logBadStatus(ctx, "GET", res, log)
 
 		span.SetStatus(codes.Error, fmt.Sprintf("Status code %d", resp.StatusCode))
-		s.monitorGetComplete(ctx, callURL.Path, "failed")
 
-		return nil, &api.Error{
+		return res, &api.Error{
 			Method:     http.MethodGet,
 			StatusCode: resp.StatusCode,
 			Endpoint:   endpoint,
@@ -372,8 +377,6 @@ func (s *Service) get(ctx context.Context,
 		return nil, errors.Join(errors.New("failed to parse consensus version"), err)
 	}
 
-	s.monitorGetComplete(ctx, callURL.Path, "succeeded")
-
 	return res, nil
 }
 
@@ -389,6 +392,16 @@ func populateConsensusVersion(res *httpResponse, resp *http.Response) error {
 			return nil
 		}
 
+		if len(bytes.TrimSpace(res.body)) == 0 {
+			// Empty bodies, such as that returned by the health endpoint, carry no version.
+			return nil
+		}
+
+		if bytes.HasPrefix(bytes.TrimSpace(res.body), []byte("[")) {
+			// Top-level arrays, such as light client updates, version each element individually.
+			return nil
+		}
+
 		var metadata responseMetadata
 		if err := json.Unmarshal(res.body, &metadata); err != nil {
 			return errors.Join(errors.New("no consensus version header and failed to parse response"), err)
@@ -0,0 +1,234 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	dynssz "github.com/pk910/dynamic-ssz"
+)
+
+// LightClientBootstrap fetches the light client bootstrap for a given block root.
+func (s *Service) LightClientBootstrap(ctx context.Context,
+	opts *api.LightClientBootstrapOpts,
+) (
+	*api.Response[*spec.VersionedLightClientBootstrap],
+	error,
+) {
+	if err := s.assertIsActive(ctx); err != nil {
+		return nil, err
+	}
+
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	if opts.Block == "" {
+		return nil, errors.Join(errors.New("no block specified"), client.ErrInvalidOptions)
+	}
+
+	endpoint := fmt.Sprintf("/eth/v1/beacon/light_client/bootstrap/%s", opts.Block)
+
+	httpResponse, err := s.get(ctx, endpoint, "", &opts.Common, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *api.Response[*spec.VersionedLightClientBootstrap]
+
+	switch httpResponse.contentType {
+	case ContentTypeSSZ:
+		response, err = s.lightClientBootstrapFromSSZ(ctx, httpResponse)
+	case ContentTypeJSON:
+		response, err = s.lightClientBootstrapFromJSON(httpResponse)
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", httpResponse.contentType)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (s *Service) lightClientBootstrapFromSSZ(ctx context.Context,
+	res *httpResponse,
+) (
+	*api.Response[*spec.VersionedLightClientBootstrap],
+	error,
+) {
+	response := &api.Response[*spec.VersionedLightClientBootstrap]{
+		Data: &spec.VersionedLightClientBootstrap{
+			Version: res.consensusVersion,
+		},
+		Metadata: metadataFromHeaders(res.headers),
+	}
+
+	var dynSSZ *dynssz.DynSsz
+
+	if s.customSpecSupport {
+		specs, err := s.Spec(ctx, &api.SpecOpts{})
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to request specs"), err)
+		}
+
+		dynSSZ = dynssz.NewDynSsz(specs.Data)
+	}
+
+	var err error
+
+	switch res.consensusVersion {
+	case spec.DataVersionAltair:
+		response.Data.Altair = &altair.LightClientBootstrap{}
+		if s.customSpecSupport {
+			err = dynSSZ.UnmarshalSSZ(response.Data.Altair, res.body)
+		} else {
+			err = response.Data.Altair.UnmarshalSSZ(res.body)
+		}
+
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to decode altair light client bootstrap"), err)
+		}
+	case spec.DataVersionBellatrix:
+		response.Data.Bellatrix = &altair.LightClientBootstrap{}
+		if s.customSpecSupport {
+			err = dynSSZ.UnmarshalSSZ(response.Data.Bellatrix, res.body)
+		} else {
+			err = response.Data.Bellatrix.UnmarshalSSZ(res.body)
+		}
+
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to decode bellatrix light client bootstrap"), err)
+		}
+	case spec.DataVersionCapella:
+		response.Data.Capella = &capella.LightClientBootstrap{}
+		if s.customSpecSupport {
+			err = dynSSZ.UnmarshalSSZ(response.Data.Capella, res.body)
+		} else {
+			err = response.Data.Capella.UnmarshalSSZ(res.body)
+		}
+
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to decode capella light client bootstrap"), err)
+		}
+	case spec.DataVersionDeneb:
+		response.Data.Deneb = &deneb.LightClientBootstrap{}
+		if s.customSpecSupport {
+			err = dynSSZ.UnmarshalSSZ(response.Data.Deneb, res.body)
+		} else {
+			err = response.Data.Deneb.UnmarshalSSZ(res.body)
+		}
+
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to decode deneb light client bootstrap"), err)
+		}
+	case spec.DataVersionElectra:
+		response.Data.Electra = &electra.LightClientBootstrap{}
+		if s.customSpecSupport {
+			err = dynSSZ.UnmarshalSSZ(response.Data.Electra, res.body)
+		} else {
+			err = response.Data.Electra.UnmarshalSSZ(res.body)
+		}
+
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to decode electra light client bootstrap"), err)
+		}
+	case spec.DataVersionFulu:
+		response.Data.Fulu = &electra.LightClientBootstrap{}
+		if s.customSpecSupport {
+			err = dynSSZ.UnmarshalSSZ(response.Data.Fulu, res.body)
+		} else {
+			err = response.Data.Fulu.UnmarshalSSZ(res.body)
+		}
+
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to decode fulu light client bootstrap"), err)
+		}
+	case spec.DataVersionGloas:
+		response.Data.Gloas = &electra.LightClientBootstrap{}
+		if s.customSpecSupport {
+			err = dynSSZ.UnmarshalSSZ(response.Data.Gloas, res.body)
+		} else {
+			err = response.Data.Gloas.UnmarshalSSZ(res.body)
+		}
+
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to decode gloas light client bootstrap"), err)
+		}
+	default:
+		return nil, fmt.Errorf("unhandled light client bootstrap version %s", res.consensusVersion)
+	}
+
+	return response, nil
+}
+
+func (*Service) lightClientBootstrapFromJSON(res *httpResponse) (*api.Response[*spec.VersionedLightClientBootstrap], error) {
+	response := &api.Response[*spec.VersionedLightClientBootstrap]{
+		Data: &spec.VersionedLightClientBootstrap{
+			Version: res.consensusVersion,
+		},
+	}
+
+	var err error
+
+	switch res.consensusVersion {
+	case spec.DataVersionAltair:
+		response.Data.Altair, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body),
+			&altair.LightClientBootstrap{},
+		)
+	case spec.DataVersionBellatrix:
+		response.Data.Bellatrix, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body),
+			&altair.LightClientBootstrap{},
+		)
+	case spec.DataVersionCapella:
+		response.Data.Capella, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body),
+			&capella.LightClientBootstrap{},
+		)
+	case spec.DataVersionDeneb:
+		response.Data.Deneb, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body),
+			&deneb.LightClientBootstrap{},
+		)
+	case spec.DataVersionElectra:
+		response.Data.Electra, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body),
+			&electra.LightClientBootstrap{},
+		)
+	case spec.DataVersionFulu:
+		response.Data.Fulu, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body),
+			&electra.LightClientBootstrap{},
+		)
+	case spec.DataVersionGloas:
+		response.Data.Gloas, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body),
+			&electra.LightClientBootstrap{},
+		)
+	default:
+		return nil, fmt.Errorf("unhandled version %s", res.consensusVersion)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
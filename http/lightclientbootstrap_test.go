@@ -0,0 +1,76 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLightClientBootstrap(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	service := testService(ctx, t).(client.Service)
+
+	finality, err := service.(client.FinalityProvider).Finality(ctx, &api.FinalityOpts{
+		State: "head",
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		opts *api.LightClientBootstrapOpts
+		err  string
+	}{
+		{
+			name: "NilOpts",
+			err:  "no options specified",
+		},
+		{
+			name: "NoBlock",
+			opts: &api.LightClientBootstrapOpts{},
+			err:  "no block specified",
+		},
+		{
+			name: "Finalized",
+			opts: &api.LightClientBootstrapOpts{
+				Block: finality.Data.Finalized.Root.String(),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response, err := service.(client.LightClientBootstrapProvider).LightClientBootstrap(ctx, test.opts)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+
+				return
+			}
+			if err != nil {
+				t.Skipf("Light client bootstrap not available: %v", err)
+			}
+			require.NotNil(t, response)
+			require.False(t, response.Data.IsEmpty())
+			header, err := response.Data.Header()
+			require.NoError(t, err)
+			require.NotNil(t, header)
+		})
+	}
+}
@@ -0,0 +1,230 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	dynssz "github.com/pk910/dynamic-ssz"
+)
+
+// LightClientFinalityUpdate fetches the latest light client finality update.
+func (s *Service) LightClientFinalityUpdate(ctx context.Context,
+	opts *api.LightClientFinalityUpdateOpts,
+) (
+	*api.Response[*spec.VersionedLightClientFinalityUpdate],
+	error,
+) {
+	if err := s.assertIsActive(ctx); err != nil {
+		return nil, err
+	}
+
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	endpoint := "/eth/v1/beacon/light_client/finality_update"
+
+	httpResponse, err := s.get(ctx, endpoint, "", &opts.Common, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *api.Response[*spec.VersionedLightClientFinalityUpdate]
+
+	switch httpResponse.contentType {
+	case ContentTypeSSZ:
+		response, err = s.lightClientFinalityUpdateFromSSZ(ctx, httpResponse)
+	case ContentTypeJSON:
+		response, err = s.lightClientFinalityUpdateFromJSON(httpResponse)
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", httpResponse.contentType)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (s *Service) lightClientFinalityUpdateFromSSZ(ctx context.Context,
+	res *httpResponse,
+) (
+	*api.Response[*spec.VersionedLightClientFinalityUpdate],
+	error,
+) {
+	response := &api.Response[*spec.VersionedLightClientFinalityUpdate]{
+		Data: &spec.VersionedLightClientFinalityUpdate{
+			Version: res.consensusVersion,
+		},
+		Metadata: metadataFromHeaders(res.headers),
+	}
+
+	var dynSSZ *dynssz.DynSsz
+
+	if s.customSpecSupport {
+		specs, err := s.Spec(ctx, &api.SpecOpts{})
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to request specs"), err)
+		}
+
+		dynSSZ = dynssz.NewDynSsz(specs.Data)
+	}
+
+	var err error
+
+	switch res.consensusVersion {
+	case spec.DataVersionAltair:
+		response.Data.Altair = &altair.LightClientFinalityUpdate{}
+		if s.customSpecSupport {
+			err = dynSSZ.UnmarshalSSZ(response.Data.Altair, res.body)
+		} else {
+			err = response.Data.Altair.UnmarshalSSZ(res.body)
+		}
+
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to decode altair light client finality update"), err)
+		}
+	case spec.DataVersionBellatrix:
+		response.Data.Bellatrix = &altair.LightClientFinalityUpdate{}
+		if s.customSpecSupport {
+			err = dynSSZ.UnmarshalSSZ(response.Data.Bellatrix, res.body)
+		} else {
+			err = response.Data.Bellatrix.UnmarshalSSZ(res.body)
+		}
+
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to decode bellatrix light client finality update"), err)
+		}
+	case spec.DataVersionCapella:
+		response.Data.Capella = &capella.LightClientFinalityUpdate{}
+		if s.customSpecSupport {
+			err = dynSSZ.UnmarshalSSZ(response.Data.Capella, res.body)
+		} else {
+			err = response.Data.Capella.UnmarshalSSZ(res.body)
+		}
+
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to decode capella light client finality update"), err)
+		}
+	case spec.DataVersionDeneb:
+		response.Data.Deneb = &deneb.LightClientFinalityUpdate{}
+		if s.customSpecSupport {
+			err = dynSSZ.UnmarshalSSZ(response.Data.Deneb, res.body)
+		} else {
+			err = response.Data.Deneb.UnmarshalSSZ(res.body)
+		}
+
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to decode deneb light client finality update"), err)
+		}
+	case spec.DataVersionElectra:
+		response.Data.Electra = &electra.LightClientFinalityUpdate{}
+		if s.customSpecSupport {
+			err = dynSSZ.UnmarshalSSZ(response.Data.Electra, res.body)
+		} else {
+			err = response.Data.Electra.UnmarshalSSZ(res.body)
+		}
+
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to decode electra light client finality update"), err)
+		}
+	case spec.DataVersionFulu:
+		response.Data.Fulu = &electra.LightClientFinalityUpdate{}
+		if s.customSpecSupport {
+			err = dynSSZ.UnmarshalSSZ(response.Data.Fulu, res.body)
+		} else {
+			err = response.Data.Fulu.UnmarshalSSZ(res.body)
+		}
+
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to decode fulu light client finality update"), err)
+		}
+	case spec.DataVersionGloas:
+		response.Data.Gloas = &electra.LightClientFinalityUpdate{}
+		if s.customSpecSupport {
+			err = dynSSZ.UnmarshalSSZ(response.Data.Gloas, res.body)
+		} else {
+			err = response.Data.Gloas.UnmarshalSSZ(res.body)
+		}
+
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to decode gloas light client finality update"), err)
+		}
+	default:
+		return nil, fmt.Errorf("unhandled light client finality update version %s", res.consensusVersion)
+	}
+
+	return response, nil
+}
+
+func (*Service) lightClientFinalityUpdateFromJSON(res *httpResponse) (*api.Response[*spec.VersionedLightClientFinalityUpdate], error) {
+	response := &api.Response[*spec.VersionedLightClientFinalityUpdate]{
+		Data: &spec.VersionedLightClientFinalityUpdate{
+			Version: res.consensusVersion,
+		},
+	}
+
+	var err error
+
+	switch res.consensusVersion {
+	case spec.DataVersionAltair:
+		response.Data.Altair, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body),
+			&altair.LightClientFinalityUpdate{},
+		)
+	case spec.DataVersionBellatrix:
+		response.Data.Bellatrix, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body),
+			&altair.LightClientFinalityUpdate{},
+		)
+	case spec.DataVersionCapella:
+		response.Data.Capella, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body),
+			&capella.LightClientFinalityUpdate{},
+		)
+	case spec.DataVersionDeneb:
+		response.Data.Deneb, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body),
+			&deneb.LightClientFinalityUpdate{},
+		)
+	case spec.DataVersionElectra:
+		response.Data.Electra, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body),
+			&electra.LightClientFinalityUpdate{},
+		)
+	case spec.DataVersionFulu:
+		response.Data.Fulu, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body),
+			&electra.LightClientFinalityUpdate{},
+		)
+	case spec.DataVersionGloas:
+		response.Data.Gloas, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body),
+			&electra.LightClientFinalityUpdate{},
+		)
+	default:
+		return nil, fmt.Errorf("unhandled version %s", res.consensusVersion)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
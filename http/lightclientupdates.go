@@ -0,0 +1,73 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+// LightClientUpdates fetches light client updates for a range of sync committee periods.
+func (s *Service) LightClientUpdates(ctx context.Context,
+	opts *api.LightClientUpdatesOpts,
+) (
+	*api.Response[[]*spec.VersionedLightClientUpdate],
+	error,
+) {
+	if err := s.assertIsActive(ctx); err != nil {
+		return nil, err
+	}
+
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	if opts.Count == 0 {
+		return nil, errors.Join(errors.New("no count specified"), client.ErrInvalidOptions)
+	}
+
+	endpoint := "/eth/v1/beacon/light_client/updates"
+	queryItems := []string{
+		fmt.Sprintf("start_period=%d", opts.StartPeriod),
+		fmt.Sprintf("count=%d", opts.Count),
+	}
+
+	// The SSZ form of this response is a sequence of chunks keyed by fork digest rather
+	// than consensus version, so request JSON where each update carries its own version.
+	httpResponse, err := s.get(ctx, endpoint, strings.Join(queryItems, "&"), &opts.Common, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if httpResponse.contentType != ContentTypeJSON {
+		return nil, fmt.Errorf("unhandled content type %v", httpResponse.contentType)
+	}
+
+	data := make([]*spec.VersionedLightClientUpdate, 0)
+	if err := json.Unmarshal(httpResponse.body, &data); err != nil {
+		return nil, errors.Join(errors.New("failed to parse light client updates"), err)
+	}
+
+	return &api.Response[[]*spec.VersionedLightClientUpdate]{
+		Data:     data,
+		Metadata: metadataFromHeaders(httpResponse.headers),
+	}, nil
+}
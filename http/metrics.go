@@ -23,8 +23,9 @@ import (
 )
 
 var (
-	requestsMetric *prometheus.CounterVec
-	stateMetric    *prometheus.GaugeVec
+	requestsMetric      *prometheus.CounterVec
+	stateMetric         *prometheus.GaugeVec
+	eventsDroppedMetric *prometheus.CounterVec
 )
 
 func registerMetrics(ctx context.Context, monitor metrics.Service) error {
@@ -66,6 +67,16 @@ func registerPrometheusMetrics(_ context.Context) error {
 		return errors.Join(errors.New("failed to register state"), err)
 	}
 
+	eventsDroppedMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "consensusclient",
+		Subsystem: "http",
+		Name:      "events_dropped_total",
+		Help:      "Number of events dropped due to a full subscription buffer",
+	}, []string{"server", "topic"})
+	if err := prometheus.Register(eventsDroppedMetric); err != nil {
+		return errors.Join(errors.New("failed to register events_dropped_total"), err)
+	}
+
 	return nil
 }
 
@@ -85,6 +96,14 @@ func (s *Service) monitorPostComplete(_ context.Context, endpoint string, result
 	requestsMetric.WithLabelValues(s.address, "POST", reduceEndpoint(endpoint), result).Inc()
 }
 
+func (s *Service) monitorEventDropped(topic string) {
+	if eventsDroppedMetric == nil {
+		return
+	}
+
+	eventsDroppedMetric.WithLabelValues(s.address, topic).Inc()
+}
+
 type templateReplacement struct {
 	pattern     *regexp.Regexp
 	replacement []byte
@@ -15,7 +15,6 @@ package http
 
 import (
 	"context"
-	"strings"
 
 	"github.com/attestantio/go-eth2-client/api"
 )
@@ -26,32 +25,13 @@ func (s *Service) NodeClient(ctx context.Context) (*api.Response[string], error)
 		return nil, err
 	}
 
-	response, err := s.NodeVersion(ctx, &api.NodeVersionOpts{})
+	response, err := s.NodeVersionDetails(ctx, &api.NodeVersionOpts{})
 	if err != nil {
 		return nil, err
 	}
 
-	nodeVersion := strings.ToLower(response.Data)
-
-	var client string
-
-	switch {
-	case strings.HasPrefix(nodeVersion, "lighthouse"):
-		client = "lighthouse"
-	case strings.HasPrefix(nodeVersion, "lodestar"):
-		client = "lodestar"
-	case strings.HasPrefix(nodeVersion, "nimbus"):
-		client = "nimbus"
-	case strings.HasPrefix(nodeVersion, "prysm"):
-		client = "prysm"
-	case strings.HasPrefix(nodeVersion, "teku"):
-		client = "teku"
-	default:
-		client = nodeVersion
-	}
-
 	return &api.Response[string]{
-		Data:     client,
+		Data:     response.Data.BeaconNode.Client(),
 		Metadata: make(map[string]any),
 	}, nil
 }
@@ -0,0 +1,82 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// NodeHealth provides the health of the node.
+//
+// The health endpoint communicates its result through the status code alone, so
+// a 503 response is returned as apiv1.NodeHealthNotReady rather than an error.
+func (s *Service) NodeHealth(ctx context.Context,
+	opts *api.NodeHealthOpts,
+) (
+	*api.Response[apiv1.NodeHealth],
+	error,
+) {
+	// We do not run assertIsActive here as CheckConnectionState can call this function,
+	// and so it would cause a loop.
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	endpoint := "/eth/v1/node/health"
+	query := ""
+	if opts.SyncingStatus != nil {
+		query = fmt.Sprintf("syncing_status=%d", *opts.SyncingStatus)
+	}
+
+	var statusCode int
+
+	httpResponse, err := s.get(ctx, endpoint, query, &opts.Common, false)
+	if err != nil {
+		var apiErr *api.Error
+		if !errors.As(err, &apiErr) {
+			return nil, err
+		}
+
+		statusCode = apiErr.StatusCode
+	} else {
+		statusCode = httpResponse.statusCode
+	}
+
+	health := apiv1.NodeHealthUnknown
+
+	switch {
+	case opts.SyncingStatus != nil && statusCode == *opts.SyncingStatus && statusCode != http.StatusOK:
+		health = apiv1.NodeHealthSyncing
+	case statusCode == http.StatusOK:
+		health = apiv1.NodeHealthReady
+	case statusCode == http.StatusPartialContent:
+		health = apiv1.NodeHealthSyncing
+	case statusCode == http.StatusServiceUnavailable:
+		health = apiv1.NodeHealthNotReady
+	case err != nil:
+		return nil, err
+	}
+
+	return &api.Response[apiv1.NodeHealth]{
+		Data:     health,
+		Metadata: make(map[string]any),
+	}, nil
+}
@@ -0,0 +1,144 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/stretchr/testify/require"
+)
+
+func healthServer(status *atomic.Int32) *httptest.Server {
+	return httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		switch r.URL.Path {
+		case "/eth/v1/node/version":
+			w.WriteHeader(nethttp.StatusOK)
+			_, _ = w.Write([]byte(`{"data":{"version":"test"}}`))
+		case "/eth/v1/node/health":
+			code := int(status.Load())
+			if code == nethttp.StatusPartialContent && r.URL.Query().Get("syncing_status") != "" {
+				code, _ = strconv.Atoi(r.URL.Query().Get("syncing_status"))
+			}
+			w.WriteHeader(code)
+		default:
+			w.WriteHeader(nethttp.StatusTeapot)
+		}
+	}))
+}
+
+func TestNodeHealth(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	status := &atomic.Int32{}
+	status.Store(nethttp.StatusOK)
+	srv := healthServer(status)
+	defer srv.Close()
+
+	service, err := http.New(ctx, http.WithAddress(srv.URL), http.WithHealthCheck(true))
+	require.NoError(t, err)
+
+	syncingStatus := nethttp.StatusTeapot
+
+	tests := []struct {
+		name     string
+		status   int
+		opts     *api.NodeHealthOpts
+		expected apiv1.NodeHealth
+		err      string
+	}{
+		{
+			name: "NilOpts",
+			err:  "no options specified",
+		},
+		{
+			name:     "Ready",
+			status:   nethttp.StatusOK,
+			opts:     &api.NodeHealthOpts{},
+			expected: apiv1.NodeHealthReady,
+		},
+		{
+			name:     "Syncing",
+			status:   nethttp.StatusPartialContent,
+			opts:     &api.NodeHealthOpts{},
+			expected: apiv1.NodeHealthSyncing,
+		},
+		{
+			name:     "SyncingCustomStatus",
+			status:   nethttp.StatusPartialContent,
+			opts:     &api.NodeHealthOpts{SyncingStatus: &syncingStatus},
+			expected: apiv1.NodeHealthSyncing,
+		},
+		{
+			name:     "NotReady",
+			status:   nethttp.StatusServiceUnavailable,
+			opts:     &api.NodeHealthOpts{},
+			expected: apiv1.NodeHealthNotReady,
+		},
+		{
+			name:   "Unexpected",
+			status: nethttp.StatusInternalServerError,
+			opts:   &api.NodeHealthOpts{},
+			err:    "GET failed with status 500",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			status.Store(int32(test.status))
+			response, err := service.(consensusclient.NodeHealthProvider).NodeHealth(ctx, test.opts)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, response.Data)
+			}
+		})
+	}
+}
+
+func TestCheckConnectionStateHealth(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	status := &atomic.Int32{}
+	status.Store(nethttp.StatusOK)
+	srv := healthServer(status)
+	defer srv.Close()
+
+	s, err := http.New(ctx, http.WithAddress(srv.URL), http.WithHealthCheck(true))
+	require.NoError(t, err)
+	service := s.(*http.Service)
+	require.True(t, service.IsActive())
+	require.True(t, service.IsSynced())
+
+	status.Store(nethttp.StatusPartialContent)
+	service.CheckConnectionState(ctx)
+	require.True(t, service.IsActive())
+	require.False(t, service.IsSynced())
+
+	status.Store(nethttp.StatusServiceUnavailable)
+	service.CheckConnectionState(ctx)
+	require.False(t, service.IsActive())
+	require.False(t, service.IsSynced())
+}
@@ -0,0 +1,75 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodePeer(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	service := testService(ctx, t).(client.Service)
+
+	// Obtain a peer ID to look up.
+	peersResponse, err := service.(client.NodePeersProvider).NodePeers(ctx, &api.NodePeersOpts{
+		State: []string{"connected"},
+	})
+	require.NoError(t, err)
+	if len(peersResponse.Data) == 0 {
+		t.Skip("no connected peers")
+	}
+	peerID := peersResponse.Data[0].PeerID
+
+	tests := []struct {
+		name string
+		opts *api.NodePeerOpts
+		err  string
+	}{
+		{
+			name: "NilOpts",
+			err:  "no options specified",
+		},
+		{
+			name: "PeerIDMissing",
+			opts: &api.NodePeerOpts{},
+			err:  "no peer ID specified",
+		},
+		{
+			name: "Good",
+			opts: &api.NodePeerOpts{
+				PeerID: peerID,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response, err := service.(client.NodePeerProvider).NodePeer(ctx, test.opts)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, response)
+				require.Equal(t, peerID, response.Data.PeerID)
+			}
+		})
+	}
+}
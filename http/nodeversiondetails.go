@@ -0,0 +1,120 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// NodeVersionDetails returns the identity of the beacon node and its execution client.
+// If the beacon node does not support /eth/v2/node/version then the identity of the beacon node
+// is parsed from the free-text version string, and the execution client is not populated.
+func (s *Service) NodeVersionDetails(ctx context.Context,
+	opts *api.NodeVersionOpts,
+) (
+	*api.Response[*apiv1.NodeVersion],
+	error,
+) {
+	// Carry this out without a connection check, as it is called when activating a client.
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	s.nodeVersionDetailsMutex.RLock()
+
+	if s.nodeVersionDetails != nil {
+		defer s.nodeVersionDetailsMutex.RUnlock()
+
+		return &api.Response[*apiv1.NodeVersion]{
+			Data:     s.nodeVersionDetails,
+			Metadata: make(map[string]any),
+		}, nil
+	}
+
+	s.nodeVersionDetailsMutex.RUnlock()
+
+	s.nodeVersionDetailsMutex.Lock()
+	defer s.nodeVersionDetailsMutex.Unlock()
+
+	if s.nodeVersionDetails != nil {
+		// Someone else fetched this whilst we were waiting for the lock.
+		return &api.Response[*apiv1.NodeVersion]{
+			Data:     s.nodeVersionDetails,
+			Metadata: make(map[string]any),
+		}, nil
+	}
+
+	// Up to us to fetch the information.
+	endpoint := "/eth/v2/node/version"
+
+	httpResponse, err := s.get(ctx, endpoint, "", &opts.Common, false)
+	if err != nil {
+		var apiErr *api.Error
+		if errors.As(err, &apiErr) && versionEndpointUnsupported(apiErr.StatusCode) {
+			return s.nodeVersionDetailsFromV1(ctx, opts)
+		}
+
+		return nil, err
+	}
+
+	data, metadata, err := decodeJSONResponse(bytes.NewReader(httpResponse.body), apiv1.NodeVersion{})
+	if err != nil {
+		return nil, err
+	}
+
+	s.nodeVersionDetails = &data
+
+	return &api.Response[*apiv1.NodeVersion]{
+		Data:     s.nodeVersionDetails,
+		Metadata: metadata,
+	}, nil
+}
+
+// nodeVersionDetailsFromV1 obtains the node version details by parsing the v1 version string.
+// This must be called with the node version details lock held.
+func (s *Service) nodeVersionDetailsFromV1(ctx context.Context,
+	opts *api.NodeVersionOpts,
+) (
+	*api.Response[*apiv1.NodeVersion],
+	error,
+) {
+	response, err := s.NodeVersion(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	s.nodeVersionDetails = apiv1.ParseNodeVersion(response.Data)
+
+	return &api.Response[*apiv1.NodeVersion]{
+		Data:     s.nodeVersionDetails,
+		Metadata: response.Metadata,
+	}, nil
+}
+
+// versionEndpointUnsupported returns true if the status code shows that the endpoint is not supported.
+func versionEndpointUnsupported(statusCode int) bool {
+	switch statusCode {
+	case http.StatusBadRequest, http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return true
+	default:
+		return false
+	}
+}
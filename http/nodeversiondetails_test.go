@@ -0,0 +1,97 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeVersionDetails(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name      string
+		responses map[string]fakeResponse
+		opts      *api.NodeVersionOpts
+		expected  *apiv1.NodeVersion
+		client    string
+		err       string
+	}{
+		{
+			name: "NilOpts",
+			err:  "no options specified",
+		},
+		{
+			name: "V2",
+			responses: map[string]fakeResponse{
+				"/eth/v2/node/version": {
+					body: `{"data":{"beacon_node":{"code":"LH","name":"Lighthouse","version":"v7.0.0","commit":"0x1a2b3c4d"},"execution_client":{"code":"GE","name":"go-ethereum","version":"1.15.0","commit":"0x8a9e1f8a"}}}`,
+				},
+			},
+			opts: &api.NodeVersionOpts{},
+			expected: &apiv1.NodeVersion{
+				BeaconNode: &apiv1.ClientVersion{
+					Code:    "LH",
+					Name:    "Lighthouse",
+					Version: "v7.0.0",
+					Commit:  "0x1a2b3c4d",
+				},
+				ExecutionClient: &apiv1.ClientVersion{
+					Code:    "GE",
+					Name:    "go-ethereum",
+					Version: "1.15.0",
+					Commit:  "0x8a9e1f8a",
+				},
+			},
+			client: "lighthouse",
+		},
+		{
+			name: "V1Fallback",
+			opts: &api.NodeVersionOpts{},
+			expected: &apiv1.NodeVersion{
+				BeaconNode: &apiv1.ClientVersion{
+					Name: "test",
+				},
+			},
+			client: "test",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service := newFakeService(ctx, t, test.responses)
+
+			response, err := service.(client.NodeVersionDetailsProvider).NodeVersionDetails(ctx, test.opts)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.expected, response.Data)
+
+			nodeClient, err := service.(client.NodeClientProvider).NodeClient(ctx)
+			require.NoError(t, err)
+			require.Equal(t, test.client, nodeClient.Data)
+		})
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/attestantio/go-eth2-client/api"
 	"github.com/attestantio/go-eth2-client/metrics"
 	"github.com/rs/zerolog"
 )
@@ -36,6 +37,9 @@ type parameters struct {
 	reducedMemoryUsage bool
 	customSpecSupport  bool
 	client             *http.Client
+	healthCheck        bool
+	retryPolicy        *api.RetryPolicy
+	requestCoalescing  bool
 }
 
 // Parameter is the interface for service parameters.
@@ -144,6 +148,36 @@ func WithHTTPClient(client *http.Client) Parameter {
 	})
 }
 
+// WithHealthCheck uses the node's health endpoint rather than its syncing endpoint when
+// checking the connection state.  A 200 response marks the node as synced, a 206 response
+// as active but syncing, and anything else as inactive.
+func WithHealthCheck(healthCheck bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.healthCheck = healthCheck
+	})
+}
+
+// WithRetryPolicy sets the policy for retrying requests that fail with a transient error.
+// This can be overridden for individual calls with the RetryPolicy field of their common options.
+// If not supplied then requests that read data are retried up to 3 times in total; a nil policy
+// disables retries.  The node health and syncing endpoints are not retried by this policy, as
+// their error responses report the state of the node.
+func WithRetryPolicy(policy *api.RetryPolicy) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.retryPolicy = policy
+	})
+}
+
+// WithRequestCoalescing shares a single in-flight request between concurrent identical requests
+// that read data, rather than each making its own round trip to the node.  Requests are only
+// identical if they also have the same timeout and retry policy.  A shared request is bounded by
+// its timeout rather than by the context of any one caller.
+func WithRequestCoalescing(requestCoalescing bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.requestCoalescing = requestCoalescing
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
@@ -154,6 +188,13 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 		extraHeaders:      make(map[string]string),
 		allowDelayedStart: false,
 		hooks:             &Hooks{},
+		retryPolicy: &api.RetryPolicy{
+			MaxAttempts:    3,
+			InitialBackoff: 250 * time.Millisecond,
+			MaxBackoff:     5 * time.Second,
+			Multiplier:     2,
+			Jitter:         0.2,
+		},
 	}
 
 	for _, p := range params {
@@ -182,5 +223,11 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 		return nil, errors.New("no hooks specified")
 	}
 
+	if parameters.retryPolicy != nil {
+		if err := checkRetryPolicy(parameters.retryPolicy); err != nil {
+			return nil, err
+		}
+	}
+
 	return &parameters, nil
 }
@@ -0,0 +1,79 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/gloas"
+)
+
+// PayloadAttestationData obtains payload attestation data given the options.
+func (s *Service) PayloadAttestationData(ctx context.Context,
+	opts *api.PayloadAttestationDataOpts,
+) (
+	*api.Response[*gloas.PayloadAttestationData],
+	error,
+) {
+	if err := s.assertIsSynced(ctx); err != nil {
+		return nil, err
+	}
+
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	endpoint := fmt.Sprintf("/eth/v1/validator/payload_attestation_data/%d", opts.Slot)
+
+	httpResponse, err := s.get(ctx, endpoint, "", &opts.Common, false)
+	if err != nil {
+		return nil, err
+	}
+
+	switch httpResponse.contentType {
+	case ContentTypeJSON:
+		return s.payloadAttestationDataFromJSON(opts, httpResponse)
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", httpResponse.contentType)
+	}
+}
+
+func (*Service) payloadAttestationDataFromJSON(opts *api.PayloadAttestationDataOpts,
+	httpResponse *httpResponse,
+) (
+	*api.Response[*gloas.PayloadAttestationData],
+	error,
+) {
+	data, metadata, err := decodeJSONResponse(bytes.NewReader(httpResponse.body), gloas.PayloadAttestationData{})
+	if err != nil {
+		return nil, err
+	}
+
+	if data.Slot != opts.Slot {
+		return nil, errors.Join(
+			fmt.Errorf("payload attestation data for slot %d; expected %d", data.Slot, opts.Slot),
+			client.ErrInconsistentResult,
+		)
+	}
+
+	return &api.Response[*gloas.PayloadAttestationData]{
+		Metadata: metadata,
+		Data:     &data,
+	}, nil
+}
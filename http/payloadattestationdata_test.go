@@ -0,0 +1,84 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/gloas"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayloadAttestationData(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name     string
+		response fakeResponse
+		opts     *api.PayloadAttestationDataOpts
+		expected *gloas.PayloadAttestationData
+		err      string
+	}{
+		{
+			name: "NilOpts",
+			err:  "no options specified",
+		},
+		{
+			name: "WrongSlot",
+			response: fakeResponse{
+				body: `{"data":{"beacon_block_root":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20","slot":"2","payload_present":true,"blob_data_available":true}}`,
+			},
+			opts: &api.PayloadAttestationDataOpts{Slot: 1},
+			err:  "payload attestation data for slot 2; expected 1",
+		},
+		{
+			name: "Good",
+			response: fakeResponse{
+				body: `{"data":{"beacon_block_root":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20","slot":"1","payload_present":true,"blob_data_available":false}}`,
+			},
+			opts: &api.PayloadAttestationDataOpts{Slot: 1},
+			expected: &gloas.PayloadAttestationData{
+				BeaconBlockRoot: phase0.Root{
+					0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10,
+					0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f, 0x20,
+				},
+				Slot:           1,
+				PayloadPresent: true,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service := newFakeService(ctx, t, map[string]fakeResponse{
+				"/eth/v1/validator/payload_attestation_data/1": test.response,
+			})
+
+			response, err := service.(client.PayloadAttestationDataProvider).PayloadAttestationData(ctx, test.opts)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.expected, response.Data)
+		})
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/gloas"
+)
+
+// PayloadAttestationPool obtains the payload attestation pool for the given options.
+func (s *Service) PayloadAttestationPool(ctx context.Context,
+	opts *api.PayloadAttestationPoolOpts,
+) (
+	*api.Response[[]*gloas.PayloadAttestation],
+	error,
+) {
+	if err := s.assertIsSynced(ctx); err != nil {
+		return nil, err
+	}
+
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	endpoint := "/eth/v1/beacon/pool/payload_attestations"
+
+	query := ""
+	if opts.Slot != nil {
+		query = fmt.Sprintf("slot=%d", *opts.Slot)
+	}
+
+	httpResponse, err := s.get(ctx, endpoint, query, &opts.Common, false)
+	if err != nil {
+		return nil, err
+	}
+
+	switch httpResponse.contentType {
+	case ContentTypeJSON:
+		return s.payloadAttestationPoolFromJSON(opts, httpResponse)
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", httpResponse.contentType)
+	}
+}
+
+func (*Service) payloadAttestationPoolFromJSON(opts *api.PayloadAttestationPoolOpts,
+	httpResponse *httpResponse,
+) (
+	*api.Response[[]*gloas.PayloadAttestation],
+	error,
+) {
+	data, metadata, err := decodeJSONResponse(bytes.NewReader(httpResponse.body), []*gloas.PayloadAttestation{})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, datum := range data {
+		if datum == nil || datum.Data == nil {
+			return nil, errors.Join(errors.New("payload attestation without data returned"), client.ErrInconsistentResult)
+		}
+
+		if opts.Slot != nil && datum.Data.Slot != *opts.Slot {
+			return nil, errors.Join(
+				fmt.Errorf("payload attestation for slot %d; expected %d", datum.Data.Slot, *opts.Slot),
+				client.ErrInconsistentResult,
+			)
+		}
+	}
+
+	return &api.Response[[]*gloas.PayloadAttestation]{
+		Metadata: metadata,
+		Data:     data,
+	}, nil
+}
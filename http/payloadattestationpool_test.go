@@ -0,0 +1,90 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayloadAttestationPool(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	attestation := `{"aggregation_bits":"0x01000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","data":{"beacon_block_root":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20","slot":"%SLOT%","payload_present":true,"blob_data_available":true},"signature":"0x8a75731b877a4be72ddc81ae5318eaa9863fef2297b58a4f01a447bd1fff10d48bb79e62d280557c472af5d457032e0112db17f99b2e925ce2c89dd839e5bd8e5e95b2f5253bb80087753555c69b116162c334f5a142e38ff6a66ef579c9a70d"}`
+	slot1 := strings.ReplaceAll(attestation, "%SLOT%", "1")
+	slot2 := strings.ReplaceAll(attestation, "%SLOT%", "2")
+	slot := phase0.Slot(1)
+
+	tests := []struct {
+		name     string
+		response fakeResponse
+		opts     *api.PayloadAttestationPoolOpts
+		expected int
+		err      string
+	}{
+		{
+			name: "NilOpts",
+			err:  "no options specified",
+		},
+		{
+			name:     "Empty",
+			response: fakeResponse{body: `{"data":[]}`},
+			opts:     &api.PayloadAttestationPoolOpts{},
+			expected: 0,
+		},
+		{
+			name:     "AllSlots",
+			response: fakeResponse{body: `{"data":[` + slot1 + `,` + slot2 + `]}`},
+			opts:     &api.PayloadAttestationPoolOpts{},
+			expected: 2,
+		},
+		{
+			name:     "Slot",
+			response: fakeResponse{body: `{"data":[` + slot1 + `]}`},
+			opts:     &api.PayloadAttestationPoolOpts{Slot: &slot},
+			expected: 1,
+		},
+		{
+			name:     "WrongSlot",
+			response: fakeResponse{body: `{"data":[` + slot2 + `]}`},
+			opts:     &api.PayloadAttestationPoolOpts{Slot: &slot},
+			err:      "payload attestation for slot 2; expected 1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service := newFakeService(ctx, t, map[string]fakeResponse{
+				"/eth/v1/beacon/pool/payload_attestations": test.response,
+			})
+
+			response, err := service.(client.PayloadAttestationPoolProvider).PayloadAttestationPool(ctx, test.opts)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, response.Data, test.expected)
+		})
+	}
+}
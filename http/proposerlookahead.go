@@ -0,0 +1,100 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// ProposerLookahead obtains the proposer lookahead for the given options.
+// The first entry is the proposer for the first slot of the state's epoch, with entries for
+// subsequent slots following in order.
+func (s *Service) ProposerLookahead(ctx context.Context,
+	opts *api.ProposerLookaheadOpts,
+) (
+	*api.Response[[]phase0.ValidatorIndex],
+	error,
+) {
+	if err := s.assertIsActive(ctx); err != nil {
+		return nil, err
+	}
+
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	if opts.State == "" {
+		return nil, errors.Join(errors.New("no state specified"), client.ErrInvalidOptions)
+	}
+
+	endpoint := fmt.Sprintf("/eth/v1/beacon/states/%s/proposer_lookahead", opts.State)
+
+	httpResponse, err := s.get(ctx, endpoint, "", &opts.Common, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *api.Response[[]phase0.ValidatorIndex]
+
+	switch httpResponse.contentType {
+	case ContentTypeSSZ:
+		response, err = s.proposerLookaheadFromSSZ(httpResponse)
+	case ContentTypeJSON:
+		response, err = s.proposerLookaheadFromJSON(httpResponse)
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", httpResponse.contentType)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (*Service) proposerLookaheadFromSSZ(res *httpResponse) (*api.Response[[]phase0.ValidatorIndex], error) {
+	if len(res.body)%8 != 0 {
+		return nil, fmt.Errorf("invalid length %d for proposer lookahead", len(res.body))
+	}
+
+	data := make([]phase0.ValidatorIndex, len(res.body)/8)
+	for i := range data {
+		data[i] = phase0.ValidatorIndex(binary.LittleEndian.Uint64(res.body[i*8 : (i+1)*8]))
+	}
+
+	return &api.Response[[]phase0.ValidatorIndex]{
+		Data:     data,
+		Metadata: metadataFromHeaders(res.headers),
+	}, nil
+}
+
+func (*Service) proposerLookaheadFromJSON(res *httpResponse) (*api.Response[[]phase0.ValidatorIndex], error) {
+	data, metadata, err := decodeJSONResponse(bytes.NewReader(res.body), []phase0.ValidatorIndex{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[[]phase0.ValidatorIndex]{
+		Data:     data,
+		Metadata: metadata,
+	}, nil
+}
@@ -0,0 +1,94 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProposerLookahead(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name     string
+		response fakeResponse
+		opts     *api.ProposerLookaheadOpts
+		expected []phase0.ValidatorIndex
+		err      string
+	}{
+		{
+			name: "NilOpts",
+			err:  "no options specified",
+		},
+		{
+			name: "NoState",
+			opts: &api.ProposerLookaheadOpts{},
+			err:  "no state specified",
+		},
+		{
+			name: "JSON",
+			response: fakeResponse{
+				version: "fulu",
+				body:    `{"version":"fulu","execution_optimistic":false,"finalized":false,"data":["1","2","3","4"]}`,
+			},
+			opts:     &api.ProposerLookaheadOpts{State: "head"},
+			expected: []phase0.ValidatorIndex{1, 2, 3, 4},
+		},
+		{
+			name: "SSZ",
+			response: fakeResponse{
+				version:     "fulu",
+				contentType: "application/octet-stream",
+				body:        string([]byte{0x01, 0, 0, 0, 0, 0, 0, 0, 0x02, 0x01, 0, 0, 0, 0, 0, 0}),
+			},
+			opts:     &api.ProposerLookaheadOpts{State: "head"},
+			expected: []phase0.ValidatorIndex{1, 258},
+		},
+		{
+			name: "SSZInvalidLength",
+			response: fakeResponse{
+				version:     "fulu",
+				contentType: "application/octet-stream",
+				body:        string([]byte{0x01, 0, 0}),
+			},
+			opts: &api.ProposerLookaheadOpts{State: "head"},
+			err:  "invalid length 3 for proposer lookahead",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service := newFakeService(ctx, t, map[string]fakeResponse{
+				"/eth/v1/beacon/states/head/proposer_lookahead": test.response,
+			})
+
+			response, err := service.(client.ProposerLookaheadProvider).ProposerLookahead(ctx, test.opts)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.expected, response.Data)
+		})
+	}
+}
@@ -0,0 +1,334 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"regexp"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// idempotentPostEndpoints are endpoints that use POST to read data, and so
+// are safe to retry regardless of the retry policy's submission setting.
+var idempotentPostEndpoints = regexp.MustCompile(
+	"^/eth/v1/(" +
+		"beacon/states/[^/]+/(validators|validator_balances|validator_identities)|" +
+		"validator/duties/(attester|sync)/[0-9]+|" +
+		"validator/liveness/[0-9]+|" +
+		"validator/(beacon_committee_selections|sync_committee_selections)|" +
+		"beacon/rewards/(attestations|sync_committee)/[^/]+" +
+		")$",
+)
+
+// nodeStatusEndpoints are endpoints whose error responses report the state
+// of the node rather than a transient failure, and so are not retried by the
+// service's default retry policy.
+var nodeStatusEndpoints = regexp.MustCompile("^/eth/v1/node/(health|syncing)$")
+
+// get sends an HTTP get request and returns the response, retrying
+// according to the retry policy and coalescing if enabled.
+func (s *Service) get(ctx context.Context,
+	endpoint string,
+	query string,
+	opts *api.CommonOpts,
+	supportsSSZ bool,
+) (
+	*httpResponse,
+	error,
+) {
+	path := urlForCall(s.base, endpoint, query).Path
+	policy := s.retryPolicyFor(opts, http.MethodGet, endpoint)
+	timeout := s.requestTimeout(opts)
+
+	call := func(ctx context.Context) (*httpResponse, error) {
+		return s.withRetries(ctx,
+			policy,
+			timeout,
+			func(ctx context.Context) (*httpResponse, error) {
+				return s.getOnce(ctx, endpoint, query, opts, supportsSSZ)
+			},
+			func(result string) {
+				s.monitorGetComplete(ctx, path, result)
+			},
+		)
+	}
+
+	if s.requestGroup == nil {
+		return call(ctx)
+	}
+
+	return s.coalesce(ctx,
+		getRequestKey(endpoint, query, supportsSSZ && !s.enforceJSON, timeout, policy),
+		call,
+	)
+}
+
+// post sends an HTTP post request and returns the body, retrying
+// according to the retry policy and coalescing if enabled.
+func (s *Service) post(ctx context.Context,
+	endpoint string,
+	query string,
+	opts *api.CommonOpts,
+	body io.Reader,
+	contentType ContentType,
+	headers map[string]string,
+) (
+	*httpResponse,
+	error,
+) {
+	path := urlForCall(s.base, endpoint, query).Path
+	policy := s.retryPolicyFor(opts, http.MethodPost, endpoint)
+	timeout := s.requestTimeout(opts)
+	coalesce := s.requestGroup != nil && idempotentPostEndpoints.MatchString(endpoint)
+
+	var bodyBytes []byte
+	requestBody := func() io.Reader { return body }
+	if coalesce || (policy != nil && policy.MaxAttempts > 1) {
+		// Buffer the body so that it can be sent more than once.
+		var err error
+		bodyBytes, err = io.ReadAll(body)
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to read request body"), err)
+		}
+
+		requestBody = func() io.Reader { return bytes.NewReader(bodyBytes) }
+	}
+
+	call := func(ctx context.Context) (*httpResponse, error) {
+		return s.withRetries(ctx,
+			policy,
+			timeout,
+			func(ctx context.Context) (*httpResponse, error) {
+				return s.postOnce(ctx, endpoint, query, opts, requestBody(), contentType, headers)
+			},
+			func(result string) {
+				s.monitorPostComplete(ctx, path, result)
+			},
+		)
+	}
+
+	if !coalesce {
+		return call(ctx)
+	}
+
+	return s.coalesce(ctx,
+		postRequestKey(endpoint, query, contentType, headers, bodyBytes, timeout, policy),
+		call,
+	)
+}
+
+// retryPolicyFor returns the retry policy that applies to the given request,
+// or nil if the request should not be retried.
+func (s *Service) retryPolicyFor(opts *api.CommonOpts,
+	method string,
+	endpoint string,
+) *api.RetryPolicy {
+	policy := s.retryPolicy
+	if nodeStatusEndpoints.MatchString(endpoint) {
+		// A node that is not ready should be reported as such immediately.
+		policy = nil
+	}
+	if opts != nil && opts.RetryPolicy != nil {
+		policy = opts.RetryPolicy
+	}
+
+	if policy == nil {
+		return nil
+	}
+
+	if method == http.MethodPost &&
+		!policy.RetrySubmissions &&
+		!idempotentPostEndpoints.MatchString(endpoint) {
+		return nil
+	}
+
+	return policy
+}
+
+// requestTimeout returns the timeout for a request, covering all of its attempts.
+func (s *Service) requestTimeout(opts *api.CommonOpts) time.Duration {
+	if opts != nil && opts.Timeout != 0 {
+		return opts.Timeout
+	}
+
+	return s.timeout
+}
+
+// checkRetryPolicy returns an error if the retry policy is not valid.
+func checkRetryPolicy(policy *api.RetryPolicy) error {
+	if policy.MaxAttempts < 1 {
+		return errors.New("retry policy max attempts must be at least 1")
+	}
+
+	if policy.Jitter < 0 || policy.Jitter > 1 {
+		return errors.New("retry policy jitter must be between 0 and 1")
+	}
+
+	return nil
+}
+
+// withRetries calls the supplied function until it succeeds, fails with an
+// error that cannot be retried, or the retry policy is exhausted.  The timeout
+// covers all attempts and the delays between them.  If any attempt suggests
+// that the connection to the node has failed then the connection state is
+// checked once the request completes.
+func (s *Service) withRetries(ctx context.Context,
+	policy *api.RetryPolicy,
+	timeout time.Duration,
+	call func(ctx context.Context) (*httpResponse, error),
+	monitor func(result string),
+) (
+	*httpResponse,
+	error,
+) {
+	maxAttempts := 1
+	if policy != nil {
+		if err := checkRetryPolicy(policy); err != nil {
+			monitor("failed")
+
+			return nil, errors.Join(errors.New("invalid retry policy"), err)
+		}
+		maxAttempts = policy.MaxAttempts
+	}
+
+	connectionFailed := false
+	defer func() {
+		if connectionFailed {
+			go s.CheckConnectionState(ctx)
+		}
+	}()
+
+	requestCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for attempt := 1; ; attempt++ {
+		res, err := call(requestCtx)
+		if err == nil {
+			monitor("succeeded")
+
+			return res, nil
+		}
+
+		var connErr *connectionError
+		if errors.As(err, &connErr) {
+			connectionFailed = true
+		}
+
+		if attempt >= maxAttempts || !isRetryable(err) {
+			monitor("failed")
+
+			return nil, err
+		}
+
+		delay := retryBackoff(policy, attempt)
+		if retryAfter, present := retryAfterDelay(res, time.Now()); present {
+			if policy.MaxBackoff > 0 && retryAfter > policy.MaxBackoff {
+				// The server wants us to wait longer than we are prepared to.
+				monitor("failed")
+
+				return nil, err
+			}
+			delay = retryAfter
+		}
+
+		if deadline, exists := requestCtx.Deadline(); exists && time.Until(deadline) < delay {
+			// There is not enough time left for another attempt.
+			monitor("failed")
+
+			return nil, err
+		}
+
+		monitor("retried")
+		s.log.Debug().Err(err).Int("attempt", attempt).Dur("delay", delay).Msg("Request failed; retrying")
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-requestCtx.Done():
+			timer.Stop()
+			monitor("failed")
+
+			return nil, errors.Join(requestCtx.Err(), err)
+		case <-timer.C:
+		}
+	}
+}
+
+// isRetryable returns true if the error is transient and the request can
+// be retried.
+func isRetryable(err error) bool {
+	var apiErr *api.Error
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout:
+			return true
+		default:
+			return false
+		}
+	}
+
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// retryBackoff returns the delay before the given retry, with jitter applied.
+func retryBackoff(policy *api.RetryPolicy, attempt int) time.Duration {
+	multiplier := math.Max(policy.Multiplier, 1)
+	delay := float64(policy.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if policy.MaxBackoff > 0 {
+		delay = math.Min(delay, float64(policy.MaxBackoff))
+	}
+
+	if policy.Jitter > 0 {
+		// #nosec G404
+		delay *= 1 + policy.Jitter*(2*rand.Float64()-1)
+	}
+
+	return time.Duration(delay)
+}
+
+// retryAfterDelay returns the delay requested by the server's Retry-After
+// header, if present.
+func retryAfterDelay(res *httpResponse, now time.Time) (time.Duration, bool) {
+	if res == nil {
+		return 0, false
+	}
+
+	value, exists := res.headers["Retry-After"]
+	if !exists || value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.ParseUint(value, 10, 32); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0), true
+	}
+
+	return 0, false
+}
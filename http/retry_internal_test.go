@@ -0,0 +1,170 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		retryable bool
+	}{
+		{
+			name:      "ServiceUnavailable",
+			err:       &api.Error{StatusCode: 503},
+			retryable: true,
+		},
+		{
+			name:      "TooManyRequests",
+			err:       &api.Error{StatusCode: 429},
+			retryable: true,
+		},
+		{
+			name: "BadRequest",
+			err:  &api.Error{StatusCode: 400},
+		},
+		{
+			name:      "ConnectionReset",
+			err:       errors.Join(errors.New("failed to call GET endpoint"), fmt.Errorf("read: %w", syscall.ECONNRESET)),
+			retryable: true,
+		},
+		{
+			name:      "UnexpectedEOF",
+			err:       errors.Join(errors.New("failed to read GET response"), io.ErrUnexpectedEOF),
+			retryable: true,
+		},
+		{
+			name: "Other",
+			err:  errors.New("connection refused"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.retryable, isRetryable(test.err))
+		})
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	policy := &api.RetryPolicy{
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		Multiplier:     3,
+	}
+	require.Equal(t, 100*time.Millisecond, retryBackoff(policy, 1))
+	require.Equal(t, 300*time.Millisecond, retryBackoff(policy, 2))
+	require.Equal(t, 900*time.Millisecond, retryBackoff(policy, 3))
+	require.Equal(t, time.Second, retryBackoff(policy, 4))
+
+	policy.Jitter = 0.5
+	for range 100 {
+		delay := retryBackoff(policy, 1)
+		require.GreaterOrEqual(t, delay, 50*time.Millisecond)
+		require.LessOrEqual(t, delay, 150*time.Millisecond)
+	}
+}
+
+func TestRetryAfterDelay(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		res     *httpResponse
+		delay   time.Duration
+		present bool
+	}{
+		{
+			name: "Nil",
+		},
+		{
+			name: "Missing",
+			res:  &httpResponse{headers: map[string]string{}},
+		},
+		{
+			name:    "Seconds",
+			res:     &httpResponse{headers: map[string]string{"Retry-After": "2"}},
+			delay:   2 * time.Second,
+			present: true,
+		},
+		{
+			name:    "Date",
+			res:     &httpResponse{headers: map[string]string{"Retry-After": "Thu, 01 Jan 2026 00:00:03 GMT"}},
+			delay:   3 * time.Second,
+			present: true,
+		},
+		{
+			name:    "DatePassed",
+			res:     &httpResponse{headers: map[string]string{"Retry-After": "Wed, 31 Dec 2025 23:59:00 GMT"}},
+			present: true,
+		},
+		{
+			name: "Invalid",
+			res:  &httpResponse{headers: map[string]string{"Retry-After": "soon"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			delay, present := retryAfterDelay(test.res, now)
+			require.Equal(t, test.present, present)
+			require.Equal(t, test.delay, delay)
+		})
+	}
+}
+
+func TestIdempotentPostEndpoints(t *testing.T) {
+	require.True(t, idempotentPostEndpoints.MatchString("/eth/v1/beacon/states/head/validators"))
+	require.True(t, idempotentPostEndpoints.MatchString("/eth/v1/beacon/states/0x01/validator_balances"))
+	require.True(t, idempotentPostEndpoints.MatchString("/eth/v1/validator/duties/attester/5"))
+	require.True(t, idempotentPostEndpoints.MatchString("/eth/v1/beacon/rewards/sync_committee/head"))
+	require.False(t, idempotentPostEndpoints.MatchString("/eth/v1/beacon/pool/attestations"))
+	require.False(t, idempotentPostEndpoints.MatchString("/eth/v2/beacon/blocks"))
+}
+
+func TestWithRetriesContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	outcomes := make([]string, 0)
+	s := &Service{}
+	_, err := s.withRetries(ctx,
+		&api.RetryPolicy{
+			MaxAttempts:    3,
+			InitialBackoff: time.Minute,
+		},
+		time.Hour,
+		func(context.Context) (*httpResponse, error) {
+			cancel()
+
+			return nil, &api.Error{StatusCode: 503}
+		},
+		func(result string) {
+			outcomes = append(outcomes, result)
+		},
+	)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, []string{"retried", "failed"}, outcomes)
+}
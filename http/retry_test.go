@@ -0,0 +1,309 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	nethttp "net/http"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec/gloas"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+const blockRootBody = `{"data":{"root":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20"}}`
+
+func fastRetryPolicy() *api.RetryPolicy {
+	return &api.RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Second,
+		Multiplier:     2,
+	}
+}
+
+func TestRetryGet(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name     string
+		params   []http.Parameter
+		opts     *api.BeaconBlockRootOpts
+		response fakeResponse
+		attempts int
+		err      string
+	}{
+		{
+			name: "TransientFailure",
+			params: []http.Parameter{
+				http.WithRetryPolicy(fastRetryPolicy()),
+			},
+			response: fakeResponse{
+				failures: []int{nethttp.StatusServiceUnavailable, nethttp.StatusTooManyRequests},
+			},
+			attempts: 3,
+		},
+		{
+			name: "DefaultPolicy",
+			response: fakeResponse{
+				failures: []int{nethttp.StatusBadGateway},
+			},
+			attempts: 2,
+		},
+		{
+			name: "Exhausted",
+			params: []http.Parameter{
+				http.WithRetryPolicy(fastRetryPolicy()),
+			},
+			response: fakeResponse{
+				failures: []int{nethttp.StatusServiceUnavailable, nethttp.StatusServiceUnavailable, nethttp.StatusGatewayTimeout},
+			},
+			attempts: 3,
+			err:      "GET failed with status 504",
+		},
+		{
+			name: "NotRetryable",
+			params: []http.Parameter{
+				http.WithRetryPolicy(fastRetryPolicy()),
+			},
+			response: fakeResponse{
+				failures: []int{nethttp.StatusBadRequest},
+			},
+			attempts: 1,
+			err:      "GET failed with status 400",
+		},
+		{
+			name: "Disabled",
+			params: []http.Parameter{
+				http.WithRetryPolicy(nil),
+			},
+			response: fakeResponse{
+				failures: []int{nethttp.StatusServiceUnavailable},
+			},
+			attempts: 1,
+			err:      "GET failed with status 503",
+		},
+		{
+			name: "PerCallOverride",
+			params: []http.Parameter{
+				http.WithRetryPolicy(nil),
+			},
+			opts: &api.BeaconBlockRootOpts{
+				Block: "head",
+				Common: api.CommonOpts{
+					RetryPolicy: fastRetryPolicy(),
+				},
+			},
+			response: fakeResponse{
+				failures: []int{nethttp.StatusServiceUnavailable},
+			},
+			attempts: 2,
+		},
+		{
+			name: "PerCallInvalid",
+			opts: &api.BeaconBlockRootOpts{
+				Block: "head",
+				Common: api.CommonOpts{
+					RetryPolicy: &api.RetryPolicy{MaxAttempts: 3, Jitter: -1},
+				},
+			},
+			attempts: 0,
+			err:      "retry policy jitter must be between 0 and 1",
+		},
+		{
+			name: "PerCallMaxAttemptsZero",
+			opts: &api.BeaconBlockRootOpts{
+				Block: "head",
+				Common: api.CommonOpts{
+					RetryPolicy: &api.RetryPolicy{},
+				},
+			},
+			attempts: 0,
+			err:      "retry policy max attempts must be at least 1",
+		},
+		{
+			name: "TimeoutCoversAttempts",
+			opts: &api.BeaconBlockRootOpts{
+				Block: "head",
+				Common: api.CommonOpts{
+					Timeout: 120 * time.Millisecond,
+					RetryPolicy: &api.RetryPolicy{
+						MaxAttempts:    5,
+						InitialBackoff: 50 * time.Millisecond,
+						Multiplier:     2,
+					},
+				},
+			},
+			response: fakeResponse{
+				failures: []int{
+					nethttp.StatusServiceUnavailable,
+					nethttp.StatusServiceUnavailable,
+					nethttp.StatusServiceUnavailable,
+					nethttp.StatusServiceUnavailable,
+					nethttp.StatusServiceUnavailable,
+				},
+			},
+			// The second retry would be due after the timeout has passed.
+			attempts: 2,
+			err:      "GET failed with status 503",
+		},
+		{
+			name: "RetryAfter",
+			params: []http.Parameter{
+				http.WithRetryPolicy(fastRetryPolicy()),
+			},
+			response: fakeResponse{
+				failures:   []int{nethttp.StatusTooManyRequests},
+				retryAfter: "0",
+			},
+			attempts: 2,
+		},
+		{
+			name: "RetryAfterTooLong",
+			params: []http.Parameter{
+				http.WithRetryPolicy(fastRetryPolicy()),
+			},
+			response: fakeResponse{
+				failures:   []int{nethttp.StatusTooManyRequests},
+				retryAfter: "60",
+			},
+			attempts: 1,
+			err:      "GET failed with status 429",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			requests := make([]string, 0)
+			response := test.response
+			response.body = blockRootBody
+			response.requests = &requests
+
+			service := newFakeService(ctx, t, map[string]fakeResponse{
+				"/eth/v1/beacon/blocks/head/root": response,
+			}, test.params...)
+
+			opts := test.opts
+			if opts == nil {
+				opts = &api.BeaconBlockRootOpts{Block: "head"}
+			}
+
+			_, err := service.(client.BeaconBlockRootProvider).BeaconBlockRoot(ctx, opts)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Len(t, requests, test.attempts)
+		})
+	}
+}
+
+func TestRetryPost(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	messages := []*gloas.PayloadAttestationMessage{
+		{
+			ValidatorIndex: 1,
+			Data:           &gloas.PayloadAttestationData{Slot: 1},
+		},
+	}
+
+	t.Run("SubmissionNotRetried", func(t *testing.T) {
+		requests := make([]string, 0)
+		service := newFakeService(ctx, t, map[string]fakeResponse{
+			"/eth/v1/beacon/pool/payload_attestations": {
+				failures: []int{nethttp.StatusServiceUnavailable},
+				requests: &requests,
+			},
+		}, http.WithRetryPolicy(fastRetryPolicy()))
+
+		err := service.(client.PayloadAttestationMessagesSubmitter).SubmitPayloadAttestationMessages(ctx,
+			&api.SubmitPayloadAttestationMessagesOpts{
+				PayloadAttestationMessages: messages,
+			},
+		)
+		require.ErrorContains(t, err, "POST failed with status 503")
+		require.Len(t, requests, 1)
+	})
+
+	t.Run("SubmissionRetried", func(t *testing.T) {
+		requests := make([]string, 0)
+		service := newFakeService(ctx, t, map[string]fakeResponse{
+			"/eth/v1/beacon/pool/payload_attestations": {
+				failures: []int{nethttp.StatusServiceUnavailable},
+				requests: &requests,
+			},
+		}, http.WithRetryPolicy(fastRetryPolicy()))
+
+		policy := fastRetryPolicy()
+		policy.RetrySubmissions = true
+		err := service.(client.PayloadAttestationMessagesSubmitter).SubmitPayloadAttestationMessages(ctx,
+			&api.SubmitPayloadAttestationMessagesOpts{
+				Common: api.CommonOpts{
+					RetryPolicy: policy,
+				},
+				PayloadAttestationMessages: messages,
+			},
+		)
+		require.NoError(t, err)
+		require.Len(t, requests, 2)
+	})
+
+	t.Run("IdempotentRetried", func(t *testing.T) {
+		requests := make([]string, 0)
+		service := newFakeService(ctx, t, map[string]fakeResponse{
+			"/eth/v1/beacon/states/head/validator_balances": {
+				failures: []int{nethttp.StatusServiceUnavailable},
+				requests: &requests,
+				body:     `{"data":[{"index":"1","balance":"2"}]}`,
+			},
+		}, http.WithRetryPolicy(fastRetryPolicy()))
+
+		response, err := service.(client.ValidatorBalancesProvider).ValidatorBalances(ctx, &api.ValidatorBalancesOpts{
+			State:   "head",
+			Indices: []phase0.ValidatorIndex{1},
+		})
+		require.NoError(t, err)
+		require.Equal(t, map[phase0.ValidatorIndex]phase0.Gwei{1: 2}, response.Data)
+		require.Len(t, requests, 2)
+	})
+}
+
+func TestRetryNodeHealth(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	requests := make([]string, 0)
+	service := newFakeService(ctx, t, map[string]fakeResponse{
+		"/eth/v1/node/health": {
+			failures: []int{nethttp.StatusServiceUnavailable},
+			requests: &requests,
+		},
+	}, http.WithRetryPolicy(fastRetryPolicy()))
+
+	// A node that is not ready is reported immediately, rather than retried.
+	response, err := service.(client.NodeHealthProvider).NodeHealth(ctx, &api.NodeHealthOpts{})
+	require.NoError(t, err)
+	require.Equal(t, apiv1.NodeHealthNotReady, response.Data)
+	require.Len(t, requests, 1)
+}
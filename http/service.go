@@ -32,6 +32,7 @@ import (
 	"github.com/rs/zerolog"
 	zerologger "github.com/rs/zerolog/log"
 	"golang.org/x/sync/semaphore"
+	"golang.org/x/sync/singleflight"
 )
 
 // Service is an Ethereum 2 client service.
@@ -44,18 +45,26 @@ type Service struct {
 	client  *http.Client
 	timeout time.Duration
 
+	// retryPolicy is the default policy for retrying requests.
+	retryPolicy *api.RetryPolicy
+
+	// requestGroup coalesces concurrent identical requests, if enabled.
+	requestGroup *singleflight.Group
+
 	// Various information from the node that does not change during the
 	// lifetime of a beacon node.
-	genesis              *apiv1.Genesis
-	genesisMutex         sync.RWMutex
-	spec                 map[string]any
-	specMutex            sync.RWMutex
-	depositContract      *apiv1.DepositContract
-	depositContractMutex sync.RWMutex
-	forkSchedule         []*phase0.Fork
-	forkScheduleMutex    sync.RWMutex
-	nodeVersion          string
-	nodeVersionMutex     sync.RWMutex
+	genesis                 *apiv1.Genesis
+	genesisMutex            sync.RWMutex
+	spec                    map[string]any
+	specMutex               sync.RWMutex
+	depositContract         *apiv1.DepositContract
+	depositContractMutex    sync.RWMutex
+	forkSchedule            []*phase0.Fork
+	forkScheduleMutex       sync.RWMutex
+	nodeVersion             string
+	nodeVersionMutex        sync.RWMutex
+	nodeVersionDetails      *apiv1.NodeVersion
+	nodeVersionDetailsMutex sync.RWMutex
 
 	// User-specified chunk sizes.
 	userIndexChunkSize  int
@@ -74,6 +83,11 @@ type Service struct {
 	connectedToDVTMiddleware bool
 	reducedMemoryUsage       bool
 	customSpecSupport        bool
+	healthCheck              bool
+
+	// sszUnsupportedEndpoints are endpoints that have rejected SSZ request bodies.
+	sszUnsupportedEndpoints   map[string]struct{}
+	sszUnsupportedEndpointsMu sync.RWMutex
 }
 
 // New creates a new Ethereum 2 client service, connecting with a standard HTTP.
@@ -118,19 +132,26 @@ func New(ctx context.Context, params ...Parameter) (client.Service, error) {
 	}
 
 	s := &Service{
-		log:                 log,
-		base:                base,
-		address:             address.String(),
-		client:              httpClient,
-		timeout:             parameters.timeout,
-		userIndexChunkSize:  parameters.indexChunkSize,
-		userPubKeyChunkSize: parameters.pubKeyChunkSize,
-		extraHeaders:        parameters.extraHeaders,
-		enforceJSON:         parameters.enforceJSON,
-		pingSem:             semaphore.NewWeighted(1),
-		hooks:               parameters.hooks,
-		reducedMemoryUsage:  parameters.reducedMemoryUsage,
-		customSpecSupport:   parameters.customSpecSupport,
+		log:                     log,
+		base:                    base,
+		address:                 address.String(),
+		client:                  httpClient,
+		timeout:                 parameters.timeout,
+		userIndexChunkSize:      parameters.indexChunkSize,
+		userPubKeyChunkSize:     parameters.pubKeyChunkSize,
+		extraHeaders:            parameters.extraHeaders,
+		retryPolicy:             parameters.retryPolicy,
+		enforceJSON:             parameters.enforceJSON,
+		pingSem:                 semaphore.NewWeighted(1),
+		hooks:                   parameters.hooks,
+		reducedMemoryUsage:      parameters.reducedMemoryUsage,
+		customSpecSupport:       parameters.customSpecSupport,
+		healthCheck:             parameters.healthCheck,
+		sszUnsupportedEndpoints: make(map[string]struct{}),
+	}
+
+	if parameters.requestCoalescing {
+		s.requestGroup = &singleflight.Group{}
 	}
 
 	// Ping the client to see if it is ready to serve requests.
@@ -196,15 +217,10 @@ func (s *Service) CheckConnectionState(ctx context.Context) {
 		active = wasActive
 		synced = wasSynced
 	} else {
-		response, err := s.NodeSyncing(ctx, &api.NodeSyncingOpts{})
-		if err != nil {
-			log.Debug().Err(err).Msg("Failed to obtain sync state from node")
-
-			active = false
-			synced = false
+		if s.healthCheck {
+			active, synced = s.checkHealth(ctx)
 		} else {
-			active = true
-			synced = (!response.Data.IsSyncing) || (response.Data.HeadSlot == 0 && response.Data.SyncDistance <= 1)
+			active, synced = s.checkSyncing(ctx)
 		}
 
 		s.pingSem.Release(1)
@@ -275,6 +291,37 @@ func (s *Service) CheckConnectionState(ctx context.Context) {
 	}
 }
 
+// checkSyncing uses the syncing endpoint to obtain the active and synced state of the node.
+func (s *Service) checkSyncing(ctx context.Context) (bool, bool) {
+	response, err := s.NodeSyncing(ctx, &api.NodeSyncingOpts{})
+	if err != nil {
+		zerolog.Ctx(ctx).Debug().Err(err).Msg("Failed to obtain sync state from node")
+
+		return false, false
+	}
+
+	return true, (!response.Data.IsSyncing) || (response.Data.HeadSlot == 0 && response.Data.SyncDistance <= 1)
+}
+
+// checkHealth uses the health endpoint to obtain the active and synced state of the node.
+func (s *Service) checkHealth(ctx context.Context) (bool, bool) {
+	response, err := s.NodeHealth(ctx, &api.NodeHealthOpts{})
+	if err != nil {
+		zerolog.Ctx(ctx).Debug().Err(err).Msg("Failed to obtain health from node")
+
+		return false, false
+	}
+
+	switch response.Data {
+	case apiv1.NodeHealthReady:
+		return true, true
+	case apiv1.NodeHealthSyncing:
+		return true, false
+	default:
+		return false, false
+	}
+}
+
 // IsActive returns true if the client is active.
 func (s *Service) IsActive() bool {
 	s.connectionMu.RLock()
@@ -348,6 +395,12 @@ func (s *Service) clearStaticValues() {
 	s.nodeVersionMutex.Lock()
 	s.nodeVersion = ""
 	s.nodeVersionMutex.Unlock()
+	s.nodeVersionDetailsMutex.Lock()
+	s.nodeVersionDetails = nil
+	s.nodeVersionDetailsMutex.Unlock()
+	s.sszUnsupportedEndpointsMu.Lock()
+	s.sszUnsupportedEndpoints = make(map[string]struct{})
+	s.sszUnsupportedEndpointsMu.Unlock()
 }
 
 // checkDVT checks if connected to DVT middleware and sets
@@ -358,9 +411,9 @@ func (s *Service) checkDVT(ctx context.Context) error {
 		return errors.Join(errors.New("failed to obtain node version for DVT check"), err)
 	}
 
-	version := strings.ToLower(response.Data)
-
-	if strings.Contains(version, "charon") {
+	// Use the v1 version string, as middleware can pass requests for the v2 version through to
+	// the underlying beacon node.
+	if apiv1.ParseNodeVersion(response.Data).BeaconNode.Client() == "charon" {
 		s.connectedToDVTMiddleware = true
 	}
 
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
 	v1 "github.com/attestantio/go-eth2-client/http"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -89,6 +90,24 @@ func TestService(t *testing.T) {
 			},
 			err: "problem with parameters\nno hooks specified",
 		},
+		{
+			name: "RetryPolicyMaxAttemptsZero",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithRetryPolicy(&api.RetryPolicy{}),
+			},
+			err: "problem with parameters\nretry policy max attempts must be at least 1",
+		},
+		{
+			name: "RetryPolicyJitterInvalid",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithRetryPolicy(&api.RetryPolicy{MaxAttempts: 3, Jitter: 1.5}),
+			},
+			err: "problem with parameters\nretry policy jitter must be between 0 and 1",
+		},
 		{
 			name: "Good",
 			parameters: []v1.Parameter{
@@ -125,35 +144,60 @@ func TestInterfaces(t *testing.T) {
 	assert.Implements(t, (*client.AttestationPoolProvider)(nil), s)
 	assert.Implements(t, (*client.AttestationsSubmitter)(nil), s)
 	assert.Implements(t, (*client.AttesterDutiesProvider)(nil), s)
+	assert.Implements(t, (*client.AttesterSlashingPoolProvider)(nil), s)
+	assert.Implements(t, (*client.BLSToExecutionChangePoolProvider)(nil), s)
 	assert.Implements(t, (*client.BLSToExecutionChangesSubmitter)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockHeadersProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockRootProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockSubmitter)(nil), s)
 	assert.Implements(t, (*client.BeaconCommitteeSubscriptionsSubmitter)(nil), s)
 	assert.Implements(t, (*client.BeaconCommitteeSelectionsProvider)(nil), s)
+	assert.Implements(t, (*client.BeaconHeadsProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconStateProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconStateRandaoProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconStateRootProvider)(nil), s)
 	assert.Implements(t, (*client.BlindedBeaconBlockSubmitter)(nil), s)
+	assert.Implements(t, (*client.BlockAttestationsProvider)(nil), s)
 	assert.Implements(t, (*client.ValidatorRegistrationsSubmitter)(nil), s)
+	assert.Implements(t, (*client.DataColumnSidecarsProvider)(nil), s)
 	assert.Implements(t, (*client.DepositContractProvider)(nil), s)
+	assert.Implements(t, (*client.DepositSnapshotProvider)(nil), s)
+	assert.Implements(t, (*client.EventSubscriptionProvider)(nil), s)
 	assert.Implements(t, (*client.EventsProvider)(nil), s)
+	assert.Implements(t, (*client.ExecutionPayloadEnvelopeSubmitter)(nil), s)
 	assert.Implements(t, (*client.FinalityProvider)(nil), s)
 	assert.Implements(t, (*client.ForkProvider)(nil), s)
 	assert.Implements(t, (*client.ForkScheduleProvider)(nil), s)
 	assert.Implements(t, (*client.GenesisProvider)(nil), s)
+	assert.Implements(t, (*client.LightClientBootstrapProvider)(nil), s)
+	assert.Implements(t, (*client.LightClientFinalityUpdateProvider)(nil), s)
+	assert.Implements(t, (*client.LightClientOptimisticUpdateProvider)(nil), s)
+	assert.Implements(t, (*client.LightClientUpdatesProvider)(nil), s)
+	assert.Implements(t, (*client.NodeHealthProvider)(nil), s)
+	assert.Implements(t, (*client.NodeIdentityProvider)(nil), s)
+	assert.Implements(t, (*client.NodePeerCountProvider)(nil), s)
+	assert.Implements(t, (*client.NodePeerProvider)(nil), s)
 	assert.Implements(t, (*client.NodeSyncingProvider)(nil), s)
+	assert.Implements(t, (*client.NodeVersionDetailsProvider)(nil), s)
+	assert.Implements(t, (*client.PayloadAttestationDataProvider)(nil), s)
+	assert.Implements(t, (*client.PayloadAttestationMessagesSubmitter)(nil), s)
+	assert.Implements(t, (*client.PayloadAttestationPoolProvider)(nil), s)
 	assert.Implements(t, (*client.ProposalProvider)(nil), s)
 	assert.Implements(t, (*client.ProposerDutiesProvider)(nil), s)
+	assert.Implements(t, (*client.ProposerLookaheadProvider)(nil), s)
+	assert.Implements(t, (*client.ProposerSlashingPoolProvider)(nil), s)
 	assert.Implements(t, (*client.ProposalPreparationsSubmitter)(nil), s)
+	assert.Implements(t, (*client.SignedExecutionPayloadEnvelopeProvider)(nil), s)
 	assert.Implements(t, (*client.SpecProvider)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeContributionProvider)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeContributionsSubmitter)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeDutiesProvider)(nil), s)
+	assert.Implements(t, (*client.SyncCommitteeSelectionsProvider)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeMessagesSubmitter)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteesProvider)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeSubscriptionsSubmitter)(nil), s)
 	assert.Implements(t, (*client.ValidatorBalancesProvider)(nil), s)
+	assert.Implements(t, (*client.ValidatorIdentitiesProvider)(nil), s)
 	assert.Implements(t, (*client.ValidatorLivenessProvider)(nil), s)
 	assert.Implements(t, (*client.ValidatorsProvider)(nil), s)
 	assert.Implements(t, (*client.VoluntaryExitSubmitter)(nil), s)
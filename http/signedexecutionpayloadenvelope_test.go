@@ -0,0 +1,159 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/gloas"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignedExecutionPayloadEnvelope(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	envelope := &gloas.SignedExecutionPayloadEnvelope{
+		Message: &gloas.ExecutionPayloadEnvelope{
+			Payload: &deneb.ExecutionPayload{
+				BlockNumber:   10,
+				ExtraData:     []byte{},
+				BaseFeePerGas: uint256.NewInt(7),
+				Transactions:  []bellatrix.Transaction{},
+				Withdrawals:   []*capella.Withdrawal{},
+			},
+			ExecutionRequests: &electra.ExecutionRequests{
+				Deposits:       []*electra.DepositRequest{},
+				Withdrawals:    []*electra.WithdrawalRequest{},
+				Consolidations: []*electra.ConsolidationRequest{},
+			},
+			BuilderIndex:       3,
+			Slot:               12,
+			BlobKZGCommitments: []deneb.KZGCommitment{},
+		},
+	}
+
+	envelopeJSON, err := json.Marshal(envelope)
+	require.NoError(t, err)
+	envelopeSSZ, err := envelope.MarshalSSZ()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		response fakeResponse
+		opts     *api.SignedExecutionPayloadEnvelopeOpts
+		err      string
+	}{
+		{
+			name: "NilOpts",
+			err:  "no options specified",
+		},
+		{
+			name: "NoBlock",
+			opts: &api.SignedExecutionPayloadEnvelopeOpts{},
+			err:  "no block specified",
+		},
+		{
+			name: "JSON",
+			response: fakeResponse{
+				version: "gloas",
+				body:    `{"version":"gloas","execution_optimistic":false,"finalized":false,"data":` + string(envelopeJSON) + `}`,
+			},
+			opts: &api.SignedExecutionPayloadEnvelopeOpts{Block: "head"},
+		},
+		{
+			name: "SSZ",
+			response: fakeResponse{
+				version:     "gloas",
+				contentType: "application/octet-stream",
+				body:        string(envelopeSSZ),
+			},
+			opts: &api.SignedExecutionPayloadEnvelopeOpts{Block: "head"},
+		},
+		{
+			name: "SSZInvalid",
+			response: fakeResponse{
+				version:     "gloas",
+				contentType: "application/octet-stream",
+				body:        string(envelopeSSZ[:10]),
+			},
+			opts: &api.SignedExecutionPayloadEnvelopeOpts{Block: "head"},
+			err:  "failed to decode signed execution payload envelope",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service := newFakeService(ctx, t, map[string]fakeResponse{
+				"/eth/v1/beacon/execution_payload_envelope/head": test.response,
+			})
+
+			response, err := service.(client.SignedExecutionPayloadEnvelopeProvider).SignedExecutionPayloadEnvelope(ctx, test.opts)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, envelope, response.Data)
+		})
+	}
+}
+
+func TestSubmitExecutionPayloadEnvelope(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	service := newFakeService(ctx, t, map[string]fakeResponse{
+		"/eth/v1/beacon/execution_payload_envelope": {},
+	})
+	submitter := service.(client.ExecutionPayloadEnvelopeSubmitter)
+
+	require.ErrorIs(t, submitter.SubmitExecutionPayloadEnvelope(ctx, nil), client.ErrNoOptions)
+	require.ErrorIs(t, submitter.SubmitExecutionPayloadEnvelope(ctx, &api.SubmitExecutionPayloadEnvelopeOpts{}), client.ErrInvalidOptions)
+}
+
+func TestSubmitPayloadAttestationMessages(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	service := newFakeService(ctx, t, map[string]fakeResponse{
+		"/eth/v1/beacon/pool/payload_attestations": {},
+	})
+	submitter := service.(client.PayloadAttestationMessagesSubmitter)
+
+	require.ErrorIs(t, submitter.SubmitPayloadAttestationMessages(ctx, nil), client.ErrNoOptions)
+	require.ErrorIs(t, submitter.SubmitPayloadAttestationMessages(ctx, &api.SubmitPayloadAttestationMessagesOpts{}), client.ErrInvalidOptions)
+	require.ErrorIs(t, submitter.SubmitPayloadAttestationMessages(ctx, &api.SubmitPayloadAttestationMessagesOpts{
+		PayloadAttestationMessages: []*gloas.PayloadAttestationMessage{nil},
+	}), client.ErrInvalidOptions)
+	require.NoError(t, submitter.SubmitPayloadAttestationMessages(ctx, &api.SubmitPayloadAttestationMessagesOpts{
+		PayloadAttestationMessages: []*gloas.PayloadAttestationMessage{
+			{
+				ValidatorIndex: 1,
+				Data:           &gloas.PayloadAttestationData{Slot: 1},
+			},
+		},
+	}))
+}
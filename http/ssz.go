@@ -0,0 +1,303 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/api"
+	dynssz "github.com/pk910/dynamic-ssz"
+)
+
+// sszOffsetSize is the size of an offset in an SSZ-encoded list of variable-size items.
+const sszOffsetSize = 4
+
+// sszMarshaler is the interface for items that can be encoded as SSZ.
+type sszMarshaler interface {
+	MarshalSSZ() ([]byte, error)
+}
+
+// sszUnmarshaler is the interface for items that can be decoded from SSZ.
+type sszUnmarshaler[T any] interface {
+	*T
+	UnmarshalSSZ(buf []byte) error
+	SizeSSZ() int
+}
+
+// dynSSZ returns a dynamic SSZ codec for the node's spec if custom spec
+// support is enabled, otherwise nil.
+func (s *Service) dynSSZ(ctx context.Context) (*dynssz.DynSsz, error) {
+	if !s.customSpecSupport {
+		return nil, nil
+	}
+
+	specs, err := s.Spec(ctx, &api.SpecOpts{})
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to request specs"), err)
+	}
+
+	return dynssz.NewDynSsz(specs.Data), nil
+}
+
+// decodeSSZList decodes an SSZ-encoded list of items.  Fixed-size items are
+// concatenated; variable-size items are preceded by a table of offsets.
+// If dynSSZ is supplied it is used to decode the items.
+func decodeSSZList[T any, PT sszUnmarshaler[T]](dynSSZ *dynssz.DynSsz,
+	data []byte,
+	variableSize bool,
+) (
+	[]*T,
+	error,
+) {
+	if len(data) == 0 {
+		return []*T{}, nil
+	}
+
+	var chunks [][]byte
+	if variableSize {
+		offsets, err := sszListOffsets(data)
+		if err != nil {
+			return nil, err
+		}
+
+		chunks = make([][]byte, len(offsets))
+		for i := range offsets {
+			end := len(data)
+			if i < len(offsets)-1 {
+				end = offsets[i+1]
+			}
+
+			chunks[i] = data[offsets[i]:end]
+		}
+	} else {
+		size := PT(new(T)).SizeSSZ()
+		if size == 0 || len(data)%size != 0 {
+			return nil, fmt.Errorf("invalid length %d for list of %d-byte items", len(data), size)
+		}
+
+		chunks = make([][]byte, len(data)/size)
+		for i := range chunks {
+			chunks[i] = data[i*size : (i+1)*size]
+		}
+	}
+
+	items := make([]*T, len(chunks))
+	for i := range chunks {
+		item := PT(new(T))
+
+		var err error
+		if dynSSZ != nil {
+			err = dynSSZ.UnmarshalSSZ(item, chunks[i])
+		} else {
+			err = item.UnmarshalSSZ(chunks[i])
+		}
+
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to decode item %d", i), err)
+		}
+
+		items[i] = (*T)(item)
+	}
+
+	return items, nil
+}
+
+// sszListOffsets returns the offsets of the items in an SSZ-encoded list of variable-size items.
+func sszListOffsets(data []byte) ([]int, error) {
+	if len(data) < sszOffsetSize {
+		return nil, fmt.Errorf("invalid length %d for list of variable-size items", len(data))
+	}
+
+	first := int(binary.LittleEndian.Uint32(data[:sszOffsetSize]))
+	if first == 0 || first%sszOffsetSize != 0 || first > len(data) {
+		return nil, fmt.Errorf("invalid first offset %d", first)
+	}
+
+	offsets := make([]int, first/sszOffsetSize)
+	offsets[0] = first
+
+	for i := 1; i < len(offsets); i++ {
+		offsets[i] = int(binary.LittleEndian.Uint32(data[i*sszOffsetSize : (i+1)*sszOffsetSize]))
+		if offsets[i] < offsets[i-1] || offsets[i] > len(data) {
+			return nil, fmt.Errorf("invalid offset %d for item %d", offsets[i], i)
+		}
+	}
+
+	return offsets, nil
+}
+
+// encodeSSZList encodes a list of items as SSZ.  Fixed-size items are
+// concatenated; variable-size items are preceded by a table of offsets.
+// If dynSSZ is supplied it is used to encode the items.
+func encodeSSZList[T sszMarshaler](dynSSZ *dynssz.DynSsz,
+	items []T,
+	variableSize bool,
+) (
+	[]byte,
+	error,
+) {
+	encoded := make([][]byte, len(items))
+	total := 0
+
+	for i := range items {
+		var err error
+		if dynSSZ != nil {
+			encoded[i], err = dynSSZ.MarshalSSZ(items[i])
+		} else {
+			encoded[i], err = items[i].MarshalSSZ()
+		}
+
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to encode item %d", i), err)
+		}
+
+		total += len(encoded[i])
+	}
+
+	if !variableSize {
+		return bytes.Join(encoded, nil), nil
+	}
+
+	res := make([]byte, sszOffsetSize*len(items), sszOffsetSize*len(items)+total)
+	offset := len(res)
+
+	for i := range encoded {
+		binary.LittleEndian.PutUint32(res[i*sszOffsetSize:], uint32(offset))
+		offset += len(encoded[i])
+	}
+
+	for i := range encoded {
+		res = append(res, encoded[i]...)
+	}
+
+	return res, nil
+}
+
+// sszMarshalers converts items to SSZ marshalers.
+func sszMarshalers(items []any) ([]sszMarshaler, error) {
+	res := make([]sszMarshaler, len(items))
+	for i := range items {
+		item, isMarshaler := items[i].(sszMarshaler)
+		if !isMarshaler {
+			return nil, fmt.Errorf("item %d of type %T cannot be encoded as SSZ", i, items[i])
+		}
+
+		res[i] = item
+	}
+
+	return res, nil
+}
+
+// postSSZOrJSON posts the SSZ-encoded body to the endpoint, unless JSON is
+// enforced or the endpoint has previously rejected SSZ, in which case the
+// JSON-encoded body is posted.  If the node rejects the SSZ body with 415
+// Unsupported Media Type the request is retried with JSON, and the endpoint is
+// remembered so that future requests go straight to JSON.  A 400 Bad Request is
+// never retried, as the node may have acted on the body before rejecting it.
+func (s *Service) postSSZOrJSON(ctx context.Context,
+	endpoint string,
+	query string,
+	opts *api.CommonOpts,
+	sszBody func() ([]byte, error),
+	jsonBody func() ([]byte, error),
+	headers map[string]string,
+) (
+	*httpResponse,
+	error,
+) {
+	if !s.enforceJSON && s.endpointSupportsSSZ(endpoint) {
+		body, err := sszBody()
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to marshal SSZ"), err)
+		}
+
+		res, err := s.post(ctx, endpoint, query, opts, bytes.NewReader(body), ContentTypeSSZ, headers)
+		if err == nil {
+			return res, nil
+		}
+
+		var apiErr *api.Error
+		if !errors.As(err, &apiErr) || !isSSZRejection(apiErr) {
+			return nil, err
+		}
+
+		s.setEndpointSSZUnsupported(endpoint)
+		if apiErr.StatusCode != http.StatusUnsupportedMediaType {
+			s.log.Debug().Str("endpoint", endpoint).Msg("Endpoint does not accept SSZ; future requests will use JSON")
+
+			return nil, err
+		}
+
+		s.log.Debug().Str("endpoint", endpoint).Msg("Endpoint does not accept SSZ; falling back to JSON")
+	}
+
+	body, err := jsonBody()
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to marshal JSON"), err)
+	}
+
+	return s.post(ctx, endpoint, query, opts, bytes.NewReader(body), ContentTypeJSON, headers)
+}
+
+// isSSZRejection returns true if the error returned for an SSZ request body
+// shows that the endpoint does not accept SSZ.  This is either a 415 Unsupported
+// Media Type, or a 400 Bad Request whose message refers to the content type.
+func isSSZRejection(apiErr *api.Error) bool {
+	switch apiErr.StatusCode {
+	case http.StatusUnsupportedMediaType:
+		return true
+	case http.StatusBadRequest:
+		msg := strings.ToLower(string(apiErr.Data))
+
+		return strings.Contains(msg, "content type") ||
+			strings.Contains(msg, "content-type") ||
+			strings.Contains(msg, "media type")
+	default:
+		return false
+	}
+}
+
+// endpointSupportsSSZ returns false if the endpoint has rejected SSZ request bodies.
+func (s *Service) endpointSupportsSSZ(endpoint string) bool {
+	s.sszUnsupportedEndpointsMu.RLock()
+	defer s.sszUnsupportedEndpointsMu.RUnlock()
+
+	_, unsupported := s.sszUnsupportedEndpoints[endpoint]
+
+	return !unsupported
+}
+
+// setEndpointSSZUnsupported records that the endpoint does not accept SSZ request bodies.
+func (s *Service) setEndpointSSZUnsupported(endpoint string) {
+	s.sszUnsupportedEndpointsMu.Lock()
+	s.sszUnsupportedEndpoints[endpoint] = struct{}{}
+	s.sszUnsupportedEndpointsMu.Unlock()
+}
+
+// sszAcceptHeaders returns headers requesting an SSZ response in preference to JSON,
+// unless JSON is enforced.
+func (s *Service) sszAcceptHeaders() map[string]string {
+	headers := make(map[string]string)
+	if !s.enforceJSON {
+		headers["Accept"] = "application/octet-stream;q=1,application/json;q=0.9"
+	}
+
+	return headers
+}
@@ -0,0 +1,123 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"testing"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSSZListRoundTrip(t *testing.T) {
+	committees := []*apiv1.BeaconCommittee{
+		{Slot: 1, Index: 2, Validators: []phase0.ValidatorIndex{3, 4, 5}},
+		{Slot: 1, Index: 3, Validators: []phase0.ValidatorIndex{}},
+		{Slot: 1, Index: 4, Validators: []phase0.ValidatorIndex{6}},
+	}
+
+	data, err := encodeSSZList(nil, committees, true)
+	require.NoError(t, err)
+
+	res, err := decodeSSZList[apiv1.BeaconCommittee](nil, data, true)
+	require.NoError(t, err)
+	require.Equal(t, committees, res)
+
+	balances := []*apiv1.ValidatorBalance{
+		{Index: 1, Balance: 2},
+		{Index: 3, Balance: 4},
+	}
+
+	data, err = encodeSSZList(nil, balances, false)
+	require.NoError(t, err)
+	require.Len(t, data, 32)
+
+	balancesRes, err := decodeSSZList[apiv1.ValidatorBalance](nil, data, false)
+	require.NoError(t, err)
+	require.Equal(t, balances, balancesRes)
+}
+
+func TestDecodeSSZList(t *testing.T) {
+	tests := []struct {
+		name         string
+		data         []byte
+		variableSize bool
+		items        int
+		err          string
+	}{
+		{
+			name:         "Empty",
+			data:         []byte{},
+			variableSize: true,
+		},
+		{
+			name:  "FixedBadLength",
+			data:  make([]byte, 17),
+			items: 0,
+			err:   "invalid length 17 for list of 16-byte items",
+		},
+		{
+			name:  "Fixed",
+			data:  make([]byte, 48),
+			items: 3,
+		},
+		{
+			name:         "VariableShort",
+			data:         []byte{0x04, 0x00},
+			variableSize: true,
+			err:          "invalid length 2 for list of variable-size items",
+		},
+		{
+			name:         "VariableBadFirstOffset",
+			data:         []byte{0x05, 0x00, 0x00, 0x00, 0x00},
+			variableSize: true,
+			err:          "invalid first offset 5",
+		},
+		{
+			name:         "VariableOffsetBeyondData",
+			data:         []byte{0x08, 0x00, 0x00, 0x00, 0xff, 0x00, 0x00, 0x00},
+			variableSize: true,
+			err:          "invalid offset 255 for item 1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				items int
+				err   error
+			)
+
+			if test.variableSize {
+				var res []*apiv1.BeaconCommittee
+				res, err = decodeSSZList[apiv1.BeaconCommittee](nil, test.data, true)
+				items = len(res)
+			} else {
+				var res []*apiv1.ValidatorBalance
+				res, err = decodeSSZList[apiv1.ValidatorBalance](nil, test.data, false)
+				items = len(res)
+			}
+
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.items, items)
+		})
+	}
+}
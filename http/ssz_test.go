@@ -0,0 +1,469 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"encoding/binary"
+	nethttp "net/http"
+	"sync"
+	"testing"
+	"time"
+
+	bitfield "github.com/OffchainLabs/go-bitfield"
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+type sszItem interface {
+	MarshalSSZ() ([]byte, error)
+}
+
+// sszList encodes items as an SSZ list, with offsets if the items are variable size.
+func sszList[T sszItem](t *testing.T, variableSize bool, items ...T) string {
+	t.Helper()
+
+	var header, body []byte
+	for _, item := range items {
+		data, err := item.MarshalSSZ()
+		require.NoError(t, err)
+
+		if variableSize {
+			header = binary.LittleEndian.AppendUint32(header, uint32(4*len(items)+len(body)))
+		}
+
+		body = append(body, data...)
+	}
+
+	return string(append(header, body...))
+}
+
+func TestValidatorsSSZ(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	validators := []*apiv1.Validator{
+		{
+			Index:     1,
+			Balance:   32000000000,
+			Status:    apiv1.ValidatorStateActiveOngoing,
+			Validator: &phase0.Validator{WithdrawalCredentials: make([]byte, 32), EffectiveBalance: 32000000000, ExitEpoch: 0xffffffffffffffff},
+		},
+		{
+			Index:     5,
+			Balance:   31000000000,
+			Status:    apiv1.ValidatorStateExitedSlashed,
+			Validator: &phase0.Validator{WithdrawalCredentials: make([]byte, 32), Slashed: true},
+		},
+	}
+
+	service := newFakeService(ctx, t, map[string]fakeResponse{
+		"/eth/v1/beacon/states/head/validators": {
+			contentType: "application/octet-stream",
+			body:        sszList(t, false, validators...),
+		},
+	})
+
+	response, err := service.(client.ValidatorsProvider).Validators(ctx, &api.ValidatorsOpts{
+		State:   "head",
+		Indices: []phase0.ValidatorIndex{1, 5},
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Data, 2)
+	require.Equal(t, validators[0], response.Data[1])
+	require.Equal(t, validators[1], response.Data[5])
+}
+
+func TestValidatorBalancesSSZ(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	service := newFakeService(ctx, t, map[string]fakeResponse{
+		"/eth/v1/beacon/states/head/validator_balances": {
+			contentType: "application/octet-stream",
+			body: sszList(t, false,
+				&apiv1.ValidatorBalance{Index: 1, Balance: 2},
+				&apiv1.ValidatorBalance{Index: 3, Balance: 4},
+			),
+		},
+	})
+
+	response, err := service.(client.ValidatorBalancesProvider).ValidatorBalances(ctx, &api.ValidatorBalancesOpts{
+		State:   "head",
+		Indices: []phase0.ValidatorIndex{1, 3},
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[phase0.ValidatorIndex]phase0.Gwei{1: 2, 3: 4}, response.Data)
+}
+
+func TestBeaconCommitteesSSZ(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	committees := []*apiv1.BeaconCommittee{
+		{Slot: 1, Index: 0, Validators: []phase0.ValidatorIndex{3, 4, 5}},
+		{Slot: 1, Index: 1, Validators: []phase0.ValidatorIndex{6, 7}},
+	}
+
+	service := newFakeService(ctx, t, map[string]fakeResponse{
+		"/eth/v1/beacon/states/head/committees": {
+			contentType: "application/octet-stream",
+			body:        sszList(t, true, committees...),
+		},
+	})
+
+	response, err := service.(client.BeaconCommitteesProvider).BeaconCommittees(ctx, &api.BeaconCommitteesOpts{
+		State: "head",
+	})
+	require.NoError(t, err)
+	require.Equal(t, committees, response.Data)
+}
+
+func TestSyncCommitteeDutiesSSZ(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	duties := []*apiv1.SyncCommitteeDuty{
+		{ValidatorIndex: 1, ValidatorSyncCommitteeIndices: []phase0.CommitteeIndex{2, 3}},
+		{ValidatorIndex: 4, ValidatorSyncCommitteeIndices: []phase0.CommitteeIndex{5}},
+	}
+
+	service := newFakeService(ctx, t, map[string]fakeResponse{
+		"/eth/v1/validator/duties/sync/1": {
+			contentType: "application/octet-stream",
+			body:        sszList(t, true, duties...),
+		},
+	})
+
+	response, err := service.(client.SyncCommitteeDutiesProvider).SyncCommitteeDuties(ctx, &api.SyncCommitteeDutiesOpts{
+		Epoch:   1,
+		Indices: []phase0.ValidatorIndex{1, 4},
+	})
+	require.NoError(t, err)
+	require.Equal(t, duties, response.Data)
+}
+
+func TestAttestationPoolSSZ(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	committeeBits := bitfield.NewBitvector64()
+	committeeBits.SetBitAt(2, true)
+	attestation := &electra.Attestation{
+		AggregationBits: bitfield.Bitlist{0x03},
+		Data: &phase0.AttestationData{
+			Slot:   5,
+			Source: &phase0.Checkpoint{},
+			Target: &phase0.Checkpoint{},
+		},
+		CommitteeBits: committeeBits,
+	}
+
+	tests := []struct {
+		name     string
+		response fakeResponse
+		opts     *api.AttestationPoolOpts
+		expected []*spec.VersionedAttestation
+		err      string
+	}{
+		{
+			name: "Empty",
+			response: fakeResponse{
+				contentType: "application/octet-stream",
+			},
+			opts:     &api.AttestationPoolOpts{},
+			expected: []*spec.VersionedAttestation{},
+		},
+		{
+			name: "Electra",
+			response: fakeResponse{
+				version:     "electra",
+				contentType: "application/octet-stream",
+				body:        sszList(t, true, attestation),
+			},
+			opts: &api.AttestationPoolOpts{},
+			expected: []*spec.VersionedAttestation{
+				{Version: spec.DataVersionElectra, Electra: attestation},
+			},
+		},
+		{
+			name: "NoVersion",
+			response: fakeResponse{
+				contentType: "application/octet-stream",
+				body:        sszList(t, true, attestation),
+			},
+			opts: &api.AttestationPoolOpts{},
+			err:  "unhandled attestation pool version unknown",
+		},
+		{
+			name: "WrongSlot",
+			response: fakeResponse{
+				version:     "electra",
+				contentType: "application/octet-stream",
+				body:        sszList(t, true, attestation),
+			},
+			opts: &api.AttestationPoolOpts{Slot: new(phase0.Slot)},
+			err:  "attestation data not for requested slot",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service := newFakeService(ctx, t, map[string]fakeResponse{
+				"/eth/v2/beacon/pool/attestations": test.response,
+			})
+
+			response, err := service.(client.AttestationPoolProvider).AttestationPool(ctx, test.opts)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.expected, response.Data)
+		})
+	}
+}
+
+func TestBlockAttestationsSSZ(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	phase0Attestation := &phase0.Attestation{
+		AggregationBits: bitfield.Bitlist{0x03},
+		Data: &phase0.AttestationData{
+			Slot:   5,
+			Index:  2,
+			Source: &phase0.Checkpoint{},
+			Target: &phase0.Checkpoint{},
+		},
+	}
+	committeeBits := bitfield.NewBitvector64()
+	committeeBits.SetBitAt(2, true)
+	electraAttestation := &electra.Attestation{
+		AggregationBits: bitfield.Bitlist{0x03},
+		Data: &phase0.AttestationData{
+			Slot:   5,
+			Source: &phase0.Checkpoint{},
+			Target: &phase0.Checkpoint{},
+		},
+		CommitteeBits: committeeBits,
+	}
+
+	tests := []struct {
+		name     string
+		response fakeResponse
+		expected []*spec.VersionedAttestation
+		err      string
+	}{
+		{
+			name: "Deneb",
+			response: fakeResponse{
+				version:     "deneb",
+				contentType: "application/octet-stream",
+				body:        sszList(t, true, phase0Attestation, phase0Attestation),
+			},
+			expected: []*spec.VersionedAttestation{
+				{Version: spec.DataVersionDeneb, Deneb: phase0Attestation},
+				{Version: spec.DataVersionDeneb, Deneb: phase0Attestation},
+			},
+		},
+		{
+			name: "Electra",
+			response: fakeResponse{
+				version:     "electra",
+				contentType: "application/octet-stream",
+				body:        sszList(t, true, electraAttestation),
+			},
+			expected: []*spec.VersionedAttestation{
+				{Version: spec.DataVersionElectra, Electra: electraAttestation},
+			},
+		},
+		{
+			name: "Gloas",
+			response: fakeResponse{
+				version:     "gloas",
+				contentType: "application/octet-stream",
+				body:        sszList(t, true, electraAttestation),
+			},
+			expected: []*spec.VersionedAttestation{
+				{Version: spec.DataVersionGloas, Gloas: electraAttestation},
+			},
+		},
+		{
+			name: "Empty",
+			response: fakeResponse{
+				version:     "fulu",
+				contentType: "application/octet-stream",
+			},
+			expected: []*spec.VersionedAttestation{},
+		},
+		{
+			name: "NoVersion",
+			response: fakeResponse{
+				contentType: "application/octet-stream",
+				body:        sszList(t, true, electraAttestation),
+			},
+			err: "unhandled version unknown",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service := newFakeService(ctx, t, map[string]fakeResponse{
+				"/eth/v2/beacon/blocks/head/attestations": test.response,
+			})
+
+			response, err := service.(client.BlockAttestationsProvider).BlockAttestations(ctx, &api.BlockAttestationsOpts{
+				Block: "head",
+			})
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.expected, response.Data)
+		})
+	}
+}
+
+func TestSubmitValidatorRegistrationsSSZ(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	registrations := []*api.VersionedSignedValidatorRegistration{
+		{
+			Version: spec.BuilderVersionV1,
+			V1: &apiv1.SignedValidatorRegistration{
+				Message: &apiv1.ValidatorRegistration{
+					GasLimit:  30000000,
+					Timestamp: time.Unix(1700000000, 0),
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		rejectSSZ bool
+		params    []http.Parameter
+		expected  []string
+	}{
+		{
+			name:     "SSZ",
+			expected: []string{"application/octet-stream", "application/octet-stream"},
+		},
+		{
+			name:      "Fallback",
+			rejectSSZ: true,
+			// Only the first call should attempt SSZ.
+			expected: []string{"application/octet-stream", "application/json", "application/json"},
+		},
+		{
+			name:     "EnforceJSON",
+			params:   []http.Parameter{http.WithEnforceJSON(true)},
+			expected: []string{"application/json", "application/json"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			requests := make([]string, 0)
+			service := newFakeService(ctx, t, map[string]fakeResponse{
+				"/eth/v1/validator/register_validator": {
+					rejectSSZ: test.rejectSSZ,
+					requests:  &requests,
+				},
+			}, test.params...)
+
+			submitter := service.(client.ValidatorRegistrationsSubmitter)
+			require.NoError(t, submitter.SubmitValidatorRegistrations(ctx, registrations))
+			require.NoError(t, submitter.SubmitValidatorRegistrations(ctx, registrations))
+			require.Equal(t, test.expected, requests)
+		})
+	}
+}
+
+func TestSubmitValidatorRegistrationsSSZBadRequest(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	registrations := []*api.VersionedSignedValidatorRegistration{
+		{
+			Version: spec.BuilderVersionV1,
+			V1: &apiv1.SignedValidatorRegistration{
+				Message: &apiv1.ValidatorRegistration{
+					GasLimit:  30000000,
+					Timestamp: time.Unix(1700000000, 0),
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		message  string
+		expected []string
+	}{
+		{
+			name:    "InvalidBody",
+			message: `{"code":400,"message":"invalid signature"}`,
+			// The endpoint continues to be sent SSZ.
+			expected: []string{"application/octet-stream", "application/octet-stream"},
+		},
+		{
+			name:    "ContentType",
+			message: `{"code":400,"message":"unsupported content type application/octet-stream"}`,
+			// The endpoint is sent JSON from the next call.
+			expected: []string{"application/octet-stream", "application/json"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var mu sync.Mutex
+			requests := make([]string, 0)
+			service := newFakeService(ctx, t, map[string]fakeResponse{
+				"/eth/v1/validator/register_validator": {
+					handler: func(w nethttp.ResponseWriter, r *nethttp.Request) {
+						mu.Lock()
+						requests = append(requests, r.Header.Get("Content-Type"))
+						first := len(requests) == 1
+						mu.Unlock()
+
+						if first {
+							w.WriteHeader(nethttp.StatusBadRequest)
+							_, _ = w.Write([]byte(test.message))
+						}
+					},
+				},
+			})
+
+			// A bad request is never resubmitted, as the node may have acted on it.
+			submitter := service.(client.ValidatorRegistrationsSubmitter)
+			require.ErrorContains(t, submitter.SubmitValidatorRegistrations(ctx, registrations), "400")
+			require.NoError(t, submitter.SubmitValidatorRegistrations(ctx, registrations))
+			require.Equal(t, test.expected, requests)
+		})
+	}
+}
@@ -14,7 +14,6 @@
 package http
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -46,23 +45,32 @@ func (s *Service) SubmitAggregateAttestations(ctx context.Context, opts *api.Sub
 		return err
 	}
 
-	specJSON, err := json.Marshal(unversionedAggregates)
-	if err != nil {
-		return errors.Join(errors.New("failed to marshal JSON"), err)
-	}
-
 	endpoint := "/eth/v2/validator/aggregate_and_proofs"
 	query := ""
 
 	headers := make(map[string]string)
 
 	headers["Eth-Consensus-Version"] = strings.ToLower(aggregateAndProofs[0].Version.String())
-	if _, err = s.post(ctx,
+	if _, err = s.postSSZOrJSON(ctx,
 		endpoint,
 		query,
 		&opts.Common,
-		bytes.NewReader(specJSON),
-		ContentTypeJSON,
+		func() ([]byte, error) {
+			items, err := sszMarshalers(unversionedAggregates)
+			if err != nil {
+				return nil, err
+			}
+
+			dynSSZ, err := s.dynSSZ(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			return encodeSSZList(dynSSZ, items, true)
+		},
+		func() ([]byte, error) {
+			return json.Marshal(unversionedAggregates)
+		},
 		headers,
 	); err != nil {
 		return errors.Join(errors.New("failed to submit versioned aggregate and proofs"), err)
@@ -105,6 +113,8 @@ func createUnversionedAggregates(aggregateAndProofs []*spec.VersionedSignedAggre
 			unversionedAggregates = append(unversionedAggregates, aggregateAndProofs[i].Electra)
 		case spec.DataVersionFulu:
 			unversionedAggregates = append(unversionedAggregates, aggregateAndProofs[i].Fulu)
+		case spec.DataVersionGloas:
+			unversionedAggregates = append(unversionedAggregates, aggregateAndProofs[i].Gloas)
 		default:
 			return nil, errors.Join(errors.New("unknown aggregate and proof version"), client.ErrInvalidOptions)
 		}
@@ -14,7 +14,6 @@
 package http
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -46,23 +45,33 @@ func (s *Service) SubmitAttestations(ctx context.Context, opts *api.SubmitAttest
 		return err
 	}
 
-	specJSON, err := json.Marshal(unversionedAttestations)
-	if err != nil {
-		return errors.Join(errors.New("failed to marshal JSON"), err)
-	}
-
 	endpoint := "/eth/v2/beacon/pool/attestations"
 	query := ""
 
 	headers := make(map[string]string)
 
 	headers["Eth-Consensus-Version"] = strings.ToLower(attestations[0].Version.String())
-	if _, err = s.post(ctx,
+	if _, err = s.postSSZOrJSON(ctx,
 		endpoint,
 		query,
 		&opts.Common,
-		bytes.NewReader(specJSON),
-		ContentTypeJSON,
+		func() ([]byte, error) {
+			items, err := sszMarshalers(unversionedAttestations)
+			if err != nil {
+				return nil, err
+			}
+
+			dynSSZ, err := s.dynSSZ(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			// Single attestations, used from Electra onwards, are fixed size.
+			return encodeSSZList(dynSSZ, items, attestations[0].Version < spec.DataVersionElectra)
+		},
+		func() ([]byte, error) {
+			return json.Marshal(unversionedAttestations)
+		},
 		headers,
 	); err != nil {
 		return errors.Join(errors.New("failed to submit versioned beacon attestations"), err)
@@ -118,6 +127,15 @@ func (s *Service) createUnversionedAttestations(attestations []*spec.VersionedAt
 				continue
 			}
 
+			unversionedAttestations = append(unversionedAttestations, singleAttestation)
+		case spec.DataVersionGloas:
+			singleAttestation, err := attestations[i].Gloas.ToSingleAttestation(attestations[i].ValidatorIndex)
+			if err != nil {
+				s.log.Warn().Err(err).Msg("Failed to convert attestation to single attestation")
+
+				continue
+			}
+
 			unversionedAttestations = append(unversionedAttestations, singleAttestation)
 		default:
 			return nil, errors.Join(errors.New("unknown attestation version"), client.ErrInvalidOptions)
@@ -14,7 +14,6 @@
 package http
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -41,48 +40,91 @@ func (s *Service) SubmitBlindedProposal(ctx context.Context,
 		return errors.Join(errors.New("no proposal supplied"), client.ErrInvalidOptions)
 	}
 
-	var (
-		specJSON []byte
-		err      error
+	endpoint := "/eth/v2/beacon/blinded_blocks"
+
+	query := ""
+	if opts.BroadcastValidation != nil {
+		query = "broadcast_validation=" + opts.BroadcastValidation.String()
+	}
+
+	headers := make(map[string]string)
+	headers["Eth-Consensus-Version"] = strings.ToLower(opts.Proposal.Version.String())
+
+	_, err := s.postSSZOrJSON(ctx,
+		endpoint,
+		query,
+		&opts.Common,
+		func() ([]byte, error) {
+			return s.submitBlindedProposalSSZ(ctx, opts.Proposal)
+		},
+		func() ([]byte, error) {
+			return submitBlindedProposalJSON(opts.Proposal)
+		},
+		headers,
 	)
+	if err != nil {
+		return errors.Join(errors.New("failed to submit blinded proposal"), err)
+	}
+
+	return nil
+}
 
-	switch opts.Proposal.Version {
+func submitBlindedProposalJSON(proposal *api.VersionedSignedBlindedProposal) ([]byte, error) {
+	switch proposal.Version {
 	case spec.DataVersionPhase0:
-		err = errors.New("blinded phase0 proposals not supported")
+		return nil, errors.New("blinded phase0 proposals not supported")
 	case spec.DataVersionAltair:
-		err = errors.New("blinded altair proposals not supported")
+		return nil, errors.New("blinded altair proposals not supported")
 	case spec.DataVersionBellatrix:
-		specJSON, err = json.Marshal(opts.Proposal.Bellatrix)
+		return json.Marshal(proposal.Bellatrix)
 	case spec.DataVersionCapella:
-		specJSON, err = json.Marshal(opts.Proposal.Capella)
+		return json.Marshal(proposal.Capella)
 	case spec.DataVersionDeneb:
-		specJSON, err = json.Marshal(opts.Proposal.Deneb)
+		return json.Marshal(proposal.Deneb)
 	case spec.DataVersionElectra:
-		specJSON, err = json.Marshal(opts.Proposal.Electra)
+		return json.Marshal(proposal.Electra)
 	case spec.DataVersionFulu:
-		specJSON, err = json.Marshal(opts.Proposal.Fulu)
+		return json.Marshal(proposal.Fulu)
 	default:
-		err = errors.New("unknown proposal version")
+		return nil, errors.New("unknown proposal version")
 	}
+}
 
-	if err != nil {
-		return errors.Join(errors.New("failed to marshal JSON"), err)
-	}
+func (s *Service) submitBlindedProposalSSZ(ctx context.Context,
+	proposal *api.VersionedSignedBlindedProposal,
+) (
+	[]byte,
+	error,
+) {
+	var item sszMarshaler
 
-	endpoint := "/eth/v2/beacon/blinded_blocks"
-
-	query := ""
-	if opts.BroadcastValidation != nil {
-		query = "broadcast_validation=" + opts.BroadcastValidation.String()
+	switch proposal.Version {
+	case spec.DataVersionPhase0:
+		return nil, errors.New("blinded phase0 proposals not supported")
+	case spec.DataVersionAltair:
+		return nil, errors.New("blinded altair proposals not supported")
+	case spec.DataVersionBellatrix:
+		item = proposal.Bellatrix
+	case spec.DataVersionCapella:
+		item = proposal.Capella
+	case spec.DataVersionDeneb:
+		item = proposal.Deneb
+	case spec.DataVersionElectra:
+		item = proposal.Electra
+	case spec.DataVersionFulu:
+		item = proposal.Fulu
+	default:
+		return nil, errors.New("unknown proposal version")
 	}
 
-	headers := make(map[string]string)
-	headers["Eth-Consensus-Version"] = strings.ToLower(opts.Proposal.Version.String())
-
-	_, err = s.post(ctx, endpoint, query, &opts.Common, bytes.NewBuffer(specJSON), ContentTypeJSON, headers)
+	dynSSZ, err := s.dynSSZ(ctx)
 	if err != nil {
-		return errors.Join(errors.New("failed to submit blinded proposal"), err)
+		return nil, err
 	}
 
-	return nil
+	if dynSSZ != nil {
+		return dynSSZ.MarshalSSZ(item)
+	}
+
+	return item.MarshalSSZ()
 }
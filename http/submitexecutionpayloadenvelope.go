@@ -0,0 +1,66 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+// SubmitExecutionPayloadEnvelope submits a signed execution payload envelope.
+func (s *Service) SubmitExecutionPayloadEnvelope(ctx context.Context,
+	opts *api.SubmitExecutionPayloadEnvelopeOpts,
+) error {
+	if err := s.assertIsSynced(ctx); err != nil {
+		return err
+	}
+
+	if opts == nil {
+		return client.ErrNoOptions
+	}
+
+	if opts.Envelope == nil || opts.Envelope.Message == nil {
+		return errors.Join(errors.New("no execution payload envelope supplied"), client.ErrInvalidOptions)
+	}
+
+	specJSON, err := json.Marshal(opts.Envelope)
+	if err != nil {
+		return errors.Join(errors.New("failed to marshal JSON"), err)
+	}
+
+	endpoint := "/eth/v1/beacon/execution_payload_envelope"
+	query := ""
+
+	headers := make(map[string]string)
+	headers["Eth-Consensus-Version"] = spec.DataVersionGloas.String()
+
+	if _, err := s.post(ctx,
+		endpoint,
+		query,
+		&opts.Common,
+		bytes.NewReader(specJSON),
+		ContentTypeJSON,
+		headers,
+	); err != nil {
+		return errors.Join(errors.New("failed to submit execution payload envelope"), err)
+	}
+
+	return nil
+}
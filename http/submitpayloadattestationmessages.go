@@ -0,0 +1,68 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// SubmitPayloadAttestationMessages submits payload attestation messages.
+func (s *Service) SubmitPayloadAttestationMessages(ctx context.Context,
+	opts *api.SubmitPayloadAttestationMessagesOpts,
+) error {
+	if err := s.assertIsSynced(ctx); err != nil {
+		return err
+	}
+
+	if opts == nil {
+		return client.ErrNoOptions
+	}
+
+	if len(opts.PayloadAttestationMessages) == 0 {
+		return errors.Join(errors.New("no payload attestation messages supplied"), client.ErrInvalidOptions)
+	}
+
+	for _, message := range opts.PayloadAttestationMessages {
+		if message == nil {
+			return errors.Join(errors.New("nil payload attestation message supplied"), client.ErrInvalidOptions)
+		}
+	}
+
+	specJSON, err := json.Marshal(opts.PayloadAttestationMessages)
+	if err != nil {
+		return errors.Join(errors.New("failed to marshal JSON"), err)
+	}
+
+	endpoint := "/eth/v1/beacon/pool/payload_attestations"
+	query := ""
+
+	if _, err := s.post(ctx,
+		endpoint,
+		query,
+		&opts.Common,
+		bytes.NewReader(specJSON),
+		ContentTypeJSON,
+		map[string]string{},
+	); err != nil {
+		return errors.Join(errors.New("failed to submit payload attestation messages"), err)
+	}
+
+	return nil
+}
@@ -121,6 +121,8 @@ func (*Service) submitProposalJSON(_ context.Context,
 		specJSON, err = json.Marshal(proposal.Electra)
 	case spec.DataVersionFulu:
 		specJSON, err = json.Marshal(proposal.Fulu)
+	case spec.DataVersionGloas:
+		specJSON, err = json.Marshal(proposal.Gloas)
 	default:
 		err = errors.New("unknown proposal version")
 	}
@@ -161,6 +163,8 @@ func (*Service) submitProposalSSZ(_ context.Context,
 		specSSZ, err = proposal.Electra.MarshalSSZ()
 	case spec.DataVersionFulu:
 		specSSZ, err = proposal.Fulu.MarshalSSZ()
+	case spec.DataVersionGloas:
+		specSSZ, err = proposal.Gloas.MarshalSSZ()
 	default:
 		err = errors.New("unknown proposal version")
 	}
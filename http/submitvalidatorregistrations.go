@@ -14,7 +14,6 @@
 package http
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -63,20 +62,25 @@ func (s *Service) SubmitValidatorRegistrations(ctx context.Context,
 		}
 	}
 
-	specJSON, err := json.Marshal(unversionedRegistrations)
-	if err != nil {
-		return errors.Join(errors.New("failed to marshal JSON"), err)
-	}
-
 	endpoint := "/eth/v1/validator/register_validator"
 	query := ""
 
-	if _, err := s.post(ctx,
+	if _, err := s.postSSZOrJSON(ctx,
 		endpoint,
 		query,
 		&api.CommonOpts{},
-		bytes.NewReader(specJSON),
-		ContentTypeJSON,
+		func() ([]byte, error) {
+			items, err := sszMarshalers(unversionedRegistrations)
+			if err != nil {
+				return nil, err
+			}
+
+			// Signed validator registrations are fixed size.
+			return encodeSSZList(nil, items, false)
+		},
+		func() ([]byte, error) {
+			return json.Marshal(unversionedRegistrations)
+		},
 		map[string]string{},
 	); err != nil {
 		return errors.Join(errors.New("failed to submit validator registration"), err)
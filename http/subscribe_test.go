@@ -0,0 +1,133 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// serveHeadEvents returns a handler that sends head events for the given slots,
+// then holds the connection open.
+func serveHeadEvents(slots ...phase0.Slot) nethttp.HandlerFunc {
+	return func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(nethttp.StatusOK)
+		for _, slot := range slots {
+			_, _ = fmt.Fprintf(w, "event: head\ndata: "+headEventData+"\n\n", slot)
+		}
+		w.(nethttp.Flusher).Flush()
+
+		<-r.Context().Done()
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	service := newFakeService(ctx, t, map[string]fakeResponse{
+		"/eth/v1/events": {
+			handler: serveHeadEvents(1, 2, 3),
+		},
+	})
+
+	_, err := service.(client.EventSubscriptionProvider).Subscribe(ctx, nil)
+	require.ErrorIs(t, err, client.ErrNoOptions)
+	_, err = service.(client.EventSubscriptionProvider).Subscribe(ctx, &api.SubscribeOpts{})
+	require.ErrorIs(t, err, client.ErrInvalidOptions)
+
+	subCtx, subCancel := context.WithCancel(ctx)
+	ch, err := service.(client.EventSubscriptionProvider).Subscribe(subCtx, &api.SubscribeOpts{
+		Topics: []string{"head"},
+	})
+	require.NoError(t, err)
+
+	for slot := phase0.Slot(1); slot <= 3; slot++ {
+		select {
+		case event := <-ch:
+			require.Equal(t, "head", event.Topic)
+			require.Equal(t, slot, event.Head.Slot)
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "timed out waiting for event")
+		}
+	}
+
+	// The channel is closed when the context is done.
+	subCancel()
+	select {
+	case _, open := <-ch:
+		require.False(t, open)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timed out waiting for channel to close")
+	}
+}
+
+func TestSubscribeOverflow(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name   string
+		policy api.EventOverflowPolicy
+		slots  []phase0.Slot
+	}{
+		{
+			name:   "DropNewest",
+			policy: api.EventOverflowDropNewest,
+			slots:  []phase0.Slot{1, 2},
+		},
+		{
+			name:   "DropOldest",
+			policy: api.EventOverflowDropOldest,
+			slots:  []phase0.Slot{4, 5},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service := newFakeService(ctx, t, map[string]fakeResponse{
+				"/eth/v1/events": {
+					handler: serveHeadEvents(1, 2, 3, 4, 5),
+				},
+			})
+
+			subCtx, subCancel := context.WithCancel(ctx)
+			ch, err := service.(client.EventSubscriptionProvider).Subscribe(subCtx, &api.SubscribeOpts{
+				Topics:         []string{"head"},
+				BufferSize:     2,
+				OverflowPolicy: test.policy,
+			})
+			require.NoError(t, err)
+
+			// Allow all events to arrive before reading any.
+			time.Sleep(200 * time.Millisecond)
+			subCancel()
+
+			slots := make([]phase0.Slot, 0)
+			for event := range ch {
+				slots = append(slots, event.Head.Slot)
+			}
+			require.Equal(t, test.slots, slots)
+		})
+	}
+}
@@ -70,15 +70,52 @@ func (s *Service) SyncCommitteeDuties(ctx context.Context,
 	httpResponse, err := s.post(ctx,
 		endpoint,
 		query,
-		&api.CommonOpts{},
+		&opts.Common,
 		&reqBodyReader,
 		ContentTypeJSON,
-		map[string]string{},
+		s.sszAcceptHeaders(),
 	)
 	if err != nil {
 		return nil, errors.Join(errors.New("failed to request sync committee duties"), err)
 	}
 
+	switch httpResponse.contentType {
+	case ContentTypeSSZ:
+		return s.syncCommitteeDutiesFromSSZ(ctx, httpResponse)
+	case ContentTypeJSON:
+		return s.syncCommitteeDutiesFromJSON(httpResponse)
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", httpResponse.contentType)
+	}
+}
+
+func (s *Service) syncCommitteeDutiesFromSSZ(ctx context.Context,
+	httpResponse *httpResponse,
+) (
+	*api.Response[[]*apiv1.SyncCommitteeDuty],
+	error,
+) {
+	dynSSZ, err := s.dynSSZ(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := decodeSSZList[apiv1.SyncCommitteeDuty](dynSSZ, httpResponse.body, true)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to decode sync committee duties"), err)
+	}
+
+	return &api.Response[[]*apiv1.SyncCommitteeDuty]{
+		Metadata: metadataFromHeaders(httpResponse.headers),
+		Data:     data,
+	}, nil
+}
+
+func (*Service) syncCommitteeDutiesFromJSON(httpResponse *httpResponse,
+) (
+	*api.Response[[]*apiv1.SyncCommitteeDuty],
+	error,
+) {
 	data, metadata, err := decodeJSONResponse(bytes.NewReader(httpResponse.body), []*apiv1.SyncCommitteeDuty{})
 	if err != nil {
 		return nil, err
@@ -0,0 +1,83 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncCommitteeSelections(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	service := newFakeService(ctx, t, map[string]fakeResponse{
+		"/eth/v1/validator/sync_committee_selections": {
+			body: `{"data":[{"validator_index":"1","slot":"2","subcommittee_index":"3","selection_proof":"0x010203000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"}]}`,
+		},
+	})
+
+	tests := []struct {
+		name     string
+		opts     *api.SyncCommitteeSelectionsOpts
+		expected []*apiv1.SyncCommitteeSelection
+		err      string
+	}{
+		{
+			name: "NilOpts",
+			err:  "no options specified",
+		},
+		{
+			name: "Good",
+			opts: &api.SyncCommitteeSelectionsOpts{
+				Selections: []*apiv1.SyncCommitteeSelection{
+					{
+						ValidatorIndex:    1,
+						Slot:              2,
+						SubcommitteeIndex: 3,
+						SelectionProof:    phase0.BLSSignature{0x01},
+					},
+				},
+			},
+			expected: []*apiv1.SyncCommitteeSelection{
+				{
+					ValidatorIndex:    1,
+					Slot:              2,
+					SubcommitteeIndex: 3,
+					SelectionProof:    phase0.BLSSignature{0x01, 0x02, 0x03},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response, err := service.(client.SyncCommitteeSelectionsProvider).SyncCommitteeSelections(ctx, test.opts)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.expected, response.Data)
+		})
+	}
+}
@@ -62,12 +62,14 @@ func (s *Service) ValidatorBalances(ctx context.Context,
 		return nil, errors.Join(errors.New("failed to marshal request data"), err)
 	}
 
-	httpResponse, err := s.post(ctx, endpoint, query, &opts.Common, bytes.NewReader(data), ContentTypeJSON, map[string]string{})
+	httpResponse, err := s.post(ctx, endpoint, query, &opts.Common, bytes.NewReader(data), ContentTypeJSON, s.sszAcceptHeaders())
 	if err != nil {
 		return nil, err
 	}
 
 	switch httpResponse.contentType {
+	case ContentTypeSSZ:
+		return s.validatorBalancesFromSSZ(httpResponse)
 	case ContentTypeJSON:
 		return s.validatorBalancesFromJSON(ctx, httpResponse)
 	default:
@@ -75,6 +77,28 @@ func (s *Service) ValidatorBalances(ctx context.Context,
 	}
 }
 
+func (*Service) validatorBalancesFromSSZ(httpResponse *httpResponse,
+) (
+	*api.Response[map[phase0.ValidatorIndex]phase0.Gwei],
+	error,
+) {
+	data, err := decodeSSZList[apiv1.ValidatorBalance](nil, httpResponse.body, false)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to decode validator balances"), err)
+	}
+
+	response := &api.Response[map[phase0.ValidatorIndex]phase0.Gwei]{
+		Data:     make(map[phase0.ValidatorIndex]phase0.Gwei, len(data)),
+		Metadata: metadataFromHeaders(httpResponse.headers),
+	}
+
+	for _, datum := range data {
+		response.Data[datum.Index] = datum.Balance
+	}
+
+	return response, nil
+}
+
 func (*Service) validatorBalancesFromJSON(_ context.Context,
 	httpResponse *httpResponse,
 ) (
@@ -0,0 +1,133 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// ValidatorIdentities provides the index, public key and activation epoch of validators for the given options.
+func (s *Service) ValidatorIdentities(ctx context.Context,
+	opts *api.ValidatorIdentitiesOpts,
+) (
+	*api.Response[[]*apiv1.ValidatorIdentity],
+	error,
+) {
+	ctx, span := otel.Tracer("attestantio.go-eth2-client.http").Start(ctx, "ValidatorIdentities")
+	defer span.End()
+
+	if err := s.assertIsActive(ctx); err != nil {
+		return nil, err
+	}
+
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	if opts.State == "" {
+		return nil, errors.Join(errors.New("no state specified"), client.ErrInvalidOptions)
+	}
+
+	span.SetAttributes(attribute.Int("validators", len(opts.Indices)+len(opts.PubKeys)))
+
+	endpoint := fmt.Sprintf("/eth/v1/beacon/states/%s/validator_identities", opts.State)
+	query := ""
+
+	ids := make([]string, 0, len(opts.Indices)+len(opts.PubKeys))
+	for i := range opts.Indices {
+		ids = append(ids, fmt.Sprintf("%d", opts.Indices[i]))
+	}
+
+	for i := range opts.PubKeys {
+		ids = append(ids, opts.PubKeys[i].String())
+	}
+
+	reqData, err := json.Marshal(ids)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to marshal request data"), err)
+	}
+
+	httpResponse, err := s.post(ctx,
+		endpoint,
+		query,
+		&opts.Common,
+		bytes.NewReader(reqData),
+		ContentTypeJSON,
+		s.sszAcceptHeaders(),
+	)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to request validator identities"), err)
+	}
+
+	var response *api.Response[[]*apiv1.ValidatorIdentity]
+
+	switch httpResponse.contentType {
+	case ContentTypeSSZ:
+		response, err = s.validatorIdentitiesFromSSZ(ctx, httpResponse)
+	case ContentTypeJSON:
+		response, err = s.validatorIdentitiesFromJSON(httpResponse)
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", httpResponse.contentType)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (s *Service) validatorIdentitiesFromSSZ(ctx context.Context,
+	res *httpResponse,
+) (
+	*api.Response[[]*apiv1.ValidatorIdentity],
+	error,
+) {
+	dynSSZ, err := s.dynSSZ(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := decodeSSZList[apiv1.ValidatorIdentity](dynSSZ, res.body, false)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to decode validator identities"), err)
+	}
+
+	return &api.Response[[]*apiv1.ValidatorIdentity]{
+		Data:     data,
+		Metadata: metadataFromHeaders(res.headers),
+	}, nil
+}
+
+func (*Service) validatorIdentitiesFromJSON(res *httpResponse) (*api.Response[[]*apiv1.ValidatorIdentity], error) {
+	data, metadata, err := decodeJSONResponse(bytes.NewReader(res.body), []*apiv1.ValidatorIdentity{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[[]*apiv1.ValidatorIdentity]{
+		Data:     data,
+		Metadata: metadata,
+	}, nil
+}
@@ -0,0 +1,136 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorIdentities(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	identity := &apiv1.ValidatorIdentity{
+		Index:           1,
+		Pubkey:          phase0.BLSPubKey{0x01, 0x02, 0x03},
+		ActivationEpoch: 2,
+	}
+	identitySSZ, err := identity.MarshalSSZ()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		params   []http.Parameter
+		response fakeResponse
+		opts     *api.ValidatorIdentitiesOpts
+		expected []*apiv1.ValidatorIdentity
+		err      string
+	}{
+		{
+			name: "NilOpts",
+			err:  "no options specified",
+		},
+		{
+			name: "NoState",
+			opts: &api.ValidatorIdentitiesOpts{},
+			err:  "no state specified",
+		},
+		{
+			name: "JSON",
+			response: fakeResponse{
+				body: `{"execution_optimistic":false,"finalized":false,"data":[{"index":"1","pubkey":"0x010203000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","activation_epoch":"2"}]}`,
+			},
+			opts: &api.ValidatorIdentitiesOpts{
+				State:   "head",
+				Indices: []phase0.ValidatorIndex{1},
+			},
+			expected: []*apiv1.ValidatorIdentity{identity},
+		},
+		{
+			name: "SSZ",
+			response: fakeResponse{
+				contentType: "application/octet-stream",
+				body:        string(append(append([]byte{}, identitySSZ...), identitySSZ...)),
+			},
+			opts: &api.ValidatorIdentitiesOpts{
+				State:   "head",
+				PubKeys: []phase0.BLSPubKey{identity.Pubkey},
+			},
+			expected: []*apiv1.ValidatorIdentity{identity, identity},
+		},
+		{
+			name:   "SSZCustomSpec",
+			params: []http.Parameter{http.WithCustomSpecSupport(true)},
+			response: fakeResponse{
+				contentType: "application/octet-stream",
+				body:        string(identitySSZ),
+			},
+			opts: &api.ValidatorIdentitiesOpts{
+				State:   "head",
+				Indices: []phase0.ValidatorIndex{1},
+			},
+			expected: []*apiv1.ValidatorIdentity{identity},
+		},
+		{
+			name: "SSZEmpty",
+			response: fakeResponse{
+				contentType: "application/octet-stream",
+			},
+			opts: &api.ValidatorIdentitiesOpts{
+				State: "head",
+			},
+			expected: []*apiv1.ValidatorIdentity{},
+		},
+		{
+			name: "SSZInvalidLength",
+			response: fakeResponse{
+				contentType: "application/octet-stream",
+				body:        string(identitySSZ[:63]),
+			},
+			opts: &api.ValidatorIdentitiesOpts{
+				State: "head",
+			},
+			err: "invalid length 63 for list of 64-byte items",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service := newFakeService(ctx, t, map[string]fakeResponse{
+				"/eth/v1/beacon/states/head/validator_identities": test.response,
+				"/eth/v1/config/spec": {
+					body: `{"data":{"SLOTS_PER_EPOCH":"32"}}`,
+				},
+			}, test.params...)
+
+			response, err := service.(client.ValidatorIdentitiesProvider).ValidatorIdentities(ctx, test.opts)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.expected, response.Data)
+		})
+	}
+}
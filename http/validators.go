@@ -86,14 +86,39 @@ func (s *Service) Validators(ctx context.Context,
 		return nil, errors.Join(errors.New("failed to marshal request data"), err)
 	}
 
-	httpResponse, err := s.post(ctx, endpoint, query, &opts.Common, bytes.NewReader(reqData), ContentTypeJSON, map[string]string{})
+	httpResponse, err := s.post(ctx,
+		endpoint,
+		query,
+		&opts.Common,
+		bytes.NewReader(reqData),
+		ContentTypeJSON,
+		s.sszAcceptHeaders(),
+	)
 	if err != nil {
 		return nil, errors.Join(errors.New("failed to request validators"), err)
 	}
 
-	data, metadata, err := decodeJSONResponse(bytes.NewReader(httpResponse.body), []*apiv1.Validator{})
-	if err != nil {
-		return nil, err
+	var (
+		data     []*apiv1.Validator
+		metadata map[string]any
+	)
+
+	switch httpResponse.contentType {
+	case ContentTypeSSZ:
+		// Validators are fixed size and independent of the chain spec.
+		data, err = decodeSSZList[apiv1.Validator](nil, httpResponse.body, false)
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to decode validators"), err)
+		}
+
+		metadata = metadataFromHeaders(httpResponse.headers)
+	case ContentTypeJSON:
+		data, metadata, err = decodeJSONResponse(bytes.NewReader(httpResponse.body), []*apiv1.Validator{})
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", httpResponse.contentType)
 	}
 
 	// Data is returned as an array but we want it as a map.
@@ -109,8 +134,8 @@ func (s *Service) Validators(ctx context.Context,
 }
 
 // validatorsFromState fetches all validators from state.
-// This is more efficient than fetching the validators endpoint, as validators uses JSON only,
-// whereas state can be provided using SSZ.
+// This is more efficient than fetching the validators endpoint for all validators, as
+// the state is a single SSZ object whereas not all nodes provide validators as SSZ.
 func (s *Service) validatorsFromState(ctx context.Context,
 	opts *api.ValidatorsOpts,
 ) (
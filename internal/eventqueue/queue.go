@@ -0,0 +1,111 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package eventqueue provides a bounded queue of events for subscriptions.
+package eventqueue
+
+import (
+	"context"
+	"sync"
+
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// DefaultSize is the size of the queue if none is supplied.
+const DefaultSize = 64
+
+// Queue is a bounded queue of events, read through a channel.
+type Queue struct {
+	ch     chan *api.Event
+	policy api.EventOverflowPolicy
+	onDrop func(event *api.Event)
+
+	mu     sync.Mutex
+	closed bool
+}
+
+// New creates a queue of the given size.
+// onDrop, if supplied, is called with each event discarded due to the overflow policy.
+func New(size int, policy api.EventOverflowPolicy, onDrop func(event *api.Event)) *Queue {
+	if size <= 0 {
+		size = DefaultSize
+	}
+
+	if onDrop == nil {
+		onDrop = func(*api.Event) {}
+	}
+
+	return &Queue{
+		ch:     make(chan *api.Event, size),
+		policy: policy,
+		onDrop: onDrop,
+	}
+}
+
+// C returns the channel from which events are read.
+// The channel is closed when the queue is closed.
+func (q *Queue) C() <-chan *api.Event {
+	return q.ch
+}
+
+// Push adds an event to the queue, applying the overflow policy if the queue is full.
+// With the blocking policy this waits until there is space or the context is done.
+func (q *Queue) Push(ctx context.Context, event *api.Event) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return
+	}
+
+	switch q.policy {
+	case api.EventOverflowDropNewest:
+		select {
+		case q.ch <- event:
+		default:
+			q.onDrop(event)
+		}
+	case api.EventOverflowDropOldest:
+		for {
+			select {
+			case q.ch <- event:
+				return
+			default:
+			}
+
+			select {
+			case oldest := <-q.ch:
+				q.onDrop(oldest)
+			default:
+			}
+		}
+	default:
+		select {
+		case q.ch <- event:
+		case <-ctx.Done():
+		}
+	}
+}
+
+// Close closes the queue.  Events already queued can still be read.
+func (q *Queue) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return
+	}
+
+	q.closed = true
+	close(q.ch)
+}
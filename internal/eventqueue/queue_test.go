@@ -0,0 +1,129 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventqueue_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/internal/eventqueue"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func headEvent(slot phase0.Slot) *api.Event {
+	return &api.Event{
+		Topic: "head",
+		Head:  &apiv1.HeadEvent{Slot: slot},
+	}
+}
+
+// drain returns the slots of the events in the queue, closing it.
+func drain(queue *eventqueue.Queue) []phase0.Slot {
+	queue.Close()
+
+	slots := make([]phase0.Slot, 0)
+	for event := range queue.C() {
+		slots = append(slots, event.Head.Slot)
+	}
+
+	return slots
+}
+
+func TestQueue(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		policy  api.EventOverflowPolicy
+		slots   []phase0.Slot
+		dropped []phase0.Slot
+	}{
+		{
+			name:    "DropNewest",
+			policy:  api.EventOverflowDropNewest,
+			slots:   []phase0.Slot{1, 2},
+			dropped: []phase0.Slot{3, 4},
+		},
+		{
+			name:    "DropOldest",
+			policy:  api.EventOverflowDropOldest,
+			slots:   []phase0.Slot{3, 4},
+			dropped: []phase0.Slot{1, 2},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dropped := make([]phase0.Slot, 0)
+			queue := eventqueue.New(2, test.policy, func(event *api.Event) {
+				dropped = append(dropped, event.Head.Slot)
+			})
+
+			for slot := phase0.Slot(1); slot <= 4; slot++ {
+				queue.Push(ctx, headEvent(slot))
+			}
+
+			require.Equal(t, test.slots, drain(queue))
+			require.Equal(t, test.dropped, dropped)
+		})
+	}
+}
+
+func TestQueueBlock(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	queue := eventqueue.New(1, api.EventOverflowBlock, nil)
+	queue.Push(ctx, headEvent(1))
+
+	pushed := make(chan struct{})
+	go func() {
+		queue.Push(ctx, headEvent(2))
+		close(pushed)
+	}()
+
+	// The second push waits for space in the queue.
+	select {
+	case <-pushed:
+		require.Fail(t, "push did not block")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	require.Equal(t, phase0.Slot(1), (<-queue.C()).Head.Slot)
+	<-pushed
+	require.Equal(t, phase0.Slot(2), (<-queue.C()).Head.Slot)
+
+	// A blocked push returns when the context is done.
+	queue.Push(ctx, headEvent(3))
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	queue.Push(ctx, headEvent(4))
+	require.Equal(t, []phase0.Slot{3}, drain(queue))
+}
+
+func TestQueueClosed(t *testing.T) {
+	queue := eventqueue.New(0, api.EventOverflowBlock, nil)
+	queue.Close()
+	queue.Close()
+
+	// Pushing to a closed queue is ignored.
+	queue.Push(context.Background(), headEvent(1))
+	_, open := <-queue.C()
+	require.False(t, open)
+}
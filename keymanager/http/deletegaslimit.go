@@ -0,0 +1,39 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// DeleteGasLimit deletes the gas limit for a validator, reverting it to the default.
+func (s *Service) DeleteGasLimit(ctx context.Context,
+	opts *api.ValidatorGasLimitOpts,
+) error {
+	if opts == nil {
+		return client.ErrNoOptions
+	}
+
+	endpoint := fmt.Sprintf("/eth/v1/validator/%#x/gas_limit", opts.Pubkey[:])
+
+	if _, err := s.delete(ctx, endpoint, &opts.Common, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apikeymanager "github.com/attestantio/go-eth2-client/api/v1/keymanager"
+)
+
+// DeleteKeystores deletes keystores, returning the result for each keystore in the order supplied
+// along with the slashing protection data for the deleted keys.
+func (s *Service) DeleteKeystores(ctx context.Context,
+	opts *api.DeleteKeystoresOpts,
+) (
+	*api.Response[*apikeymanager.DeleteKeystoresResponse],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	if len(opts.Pubkeys) == 0 {
+		return nil, errors.Join(errors.New("no public keys specified"), client.ErrInvalidOptions)
+	}
+
+	body, err := json.Marshal(&pubkeysJSON{
+		Pubkeys: pubkeyStrings(opts.Pubkeys),
+	})
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to marshal JSON"), err)
+	}
+
+	httpResponse, err := s.delete(ctx, "/eth/v1/keystores", &opts.Common, body)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &apikeymanager.DeleteKeystoresResponse{}
+	if err := json.Unmarshal(httpResponse.body, data); err != nil {
+		return nil, errors.Join(errors.New("failed to parse delete keystores response"), err)
+	}
+
+	return &api.Response[*apikeymanager.DeleteKeystoresResponse]{
+		Data:     data,
+		Metadata: make(map[string]any),
+	}, nil
+}
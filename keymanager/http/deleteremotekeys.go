@@ -0,0 +1,77 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apikeymanager "github.com/attestantio/go-eth2-client/api/v1/keymanager"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+type pubkeysJSON struct {
+	Pubkeys []string `json:"pubkeys"`
+}
+
+// DeleteRemoteKeys deletes remote keys, returning the result for each key in the order supplied.
+func (s *Service) DeleteRemoteKeys(ctx context.Context,
+	opts *api.DeleteRemoteKeysOpts,
+) (
+	*api.Response[[]*apikeymanager.OperationResult],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	if len(opts.Pubkeys) == 0 {
+		return nil, errors.Join(errors.New("no public keys specified"), client.ErrInvalidOptions)
+	}
+
+	body, err := json.Marshal(&pubkeysJSON{
+		Pubkeys: pubkeyStrings(opts.Pubkeys),
+	})
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to marshal JSON"), err)
+	}
+
+	httpResponse, err := s.delete(ctx, "/eth/v1/remotekeys", &opts.Common, body)
+	if err != nil {
+		return nil, err
+	}
+
+	data, metadata, err := decodeJSONResponse(httpResponse.body, make([]*apikeymanager.OperationResult, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[[]*apikeymanager.OperationResult]{
+		Data:     data,
+		Metadata: metadata,
+	}, nil
+}
+
+func pubkeyStrings(pubkeys []phase0.BLSPubKey) []string {
+	res := make([]string, len(pubkeys))
+	for i := range pubkeys {
+		res[i] = fmt.Sprintf("%#x", pubkeys[i])
+	}
+
+	return res
+}
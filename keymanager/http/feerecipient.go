@@ -0,0 +1,52 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apikeymanager "github.com/attestantio/go-eth2-client/api/v1/keymanager"
+)
+
+// FeeRecipient provides the fee recipient for a validator.
+func (s *Service) FeeRecipient(ctx context.Context,
+	opts *api.ValidatorFeeRecipientOpts,
+) (
+	*api.Response[*apikeymanager.FeeRecipient],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	endpoint := fmt.Sprintf("/eth/v1/validator/%#x/feerecipient", opts.Pubkey[:])
+
+	httpResponse, err := s.get(ctx, endpoint, "", &opts.Common)
+	if err != nil {
+		return nil, err
+	}
+
+	data, metadata, err := decodeJSONResponse(httpResponse.body, &apikeymanager.FeeRecipient{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[*apikeymanager.FeeRecipient]{
+		Data:     data,
+		Metadata: metadata,
+	}, nil
+}
@@ -0,0 +1,260 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// defaultUserAgent is sent with requests if no other user agent has been supplied.
+const defaultUserAgent = "go-eth2-client/0.29.0"
+
+type httpResponse struct {
+	statusCode int
+	headers    map[string]string
+	body       []byte
+}
+
+// get sends an HTTP get request and returns the response.
+func (s *Service) get(ctx context.Context,
+	endpoint string,
+	query string,
+	opts *api.CommonOpts,
+) (
+	*httpResponse,
+	error,
+) {
+	return s.call(ctx, http.MethodGet, endpoint, query, opts, nil)
+}
+
+// post sends an HTTP post request with a JSON body and returns the response.
+func (s *Service) post(ctx context.Context,
+	endpoint string,
+	query string,
+	opts *api.CommonOpts,
+	body []byte,
+) (
+	*httpResponse,
+	error,
+) {
+	return s.call(ctx, http.MethodPost, endpoint, query, opts, body)
+}
+
+// delete sends an HTTP delete request with an optional JSON body and returns the response.
+func (s *Service) delete(ctx context.Context,
+	endpoint string,
+	opts *api.CommonOpts,
+	body []byte,
+) (
+	*httpResponse,
+	error,
+) {
+	return s.call(ctx, http.MethodDelete, endpoint, "", opts, body)
+}
+
+// call sends an HTTP request and returns the response.
+func (s *Service) call(ctx context.Context,
+	method string,
+	endpoint string,
+	query string,
+	opts *api.CommonOpts,
+	body []byte,
+) (
+	*httpResponse,
+	error,
+) {
+	ctx, span := otel.Tracer("attestantio.go-eth2-client.keymanager.http").Start(ctx, strings.ToLower(method))
+	defer span.End()
+
+	// #nosec G404
+	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.address).Str("endpoint", endpoint).Logger()
+	// Request bodies can contain keystores and passwords, so are not logged.
+	log.Trace().Str("method", method).Msg("Request")
+
+	callURL := urlForCall(s.base, endpoint, query)
+	span.SetAttributes(attribute.String("url", callURL.String()))
+
+	timeout := s.timeout
+	if opts.Timeout != 0 {
+		timeout = opts.Timeout
+	}
+
+	opCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(opCtx, method, callURL.String(), reqBody)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to create request")
+
+		return nil, errors.Join(fmt.Errorf("failed to create %s request", method), err)
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	s.addHeaders(req)
+
+	res, err := s.do(req, endpoint, log)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		s.monitorComplete(ctx, method, callURL.Path, "failed")
+
+		return nil, err
+	}
+
+	s.monitorComplete(ctx, method, callURL.Path, "succeeded")
+
+	return res, nil
+}
+
+// addHeaders adds the accept, authorization and user-supplied headers to a request.
+func (s *Service) addHeaders(req *http.Request) {
+	for k, v := range s.extraHeaders {
+		req.Header.Add(k, v)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+s.bearerToken)
+
+	if req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", defaultUserAgent)
+	}
+}
+
+// do sends the request and populates the response.
+func (s *Service) do(req *http.Request,
+	endpoint string,
+	log zerolog.Logger,
+) (
+	*httpResponse,
+	error,
+) {
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to call %s endpoint", req.Method), err)
+	}
+	defer resp.Body.Close()
+
+	log = log.With().Int("status_code", resp.StatusCode).Logger()
+
+	res := &httpResponse{
+		statusCode: resp.StatusCode,
+	}
+	populateHeaders(res, resp)
+
+	res.body, err = io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to read %s response", req.Method), err)
+	}
+
+	if statusCodeFamily(resp.StatusCode) != 2 {
+		trimmedResponse := bytes.ReplaceAll(bytes.ReplaceAll(res.body, []byte{0x0a}, []byte{}), []byte{0x0d}, []byte{})
+		log.Debug().Str("response", string(trimmedResponse)).Msg(req.Method + " failed")
+
+		return nil, &api.Error{
+			Method:     req.Method,
+			StatusCode: resp.StatusCode,
+			Endpoint:   endpoint,
+			Data:       res.body,
+		}
+	}
+
+	log.Trace().Msg("Request succeeded")
+
+	return res, nil
+}
+
+// decodeJSONResponse decodes the data of a keymanager response into res,
+// returning any other top-level fields as metadata.
+func decodeJSONResponse[T any](body []byte, res T) (T, map[string]any, error) {
+	decoded := make(map[string]json.RawMessage)
+
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return res, nil, errors.Join(errors.New("failed to parse JSON"), err)
+	}
+
+	metadata := make(map[string]any)
+
+	for k, v := range decoded {
+		switch k {
+		case "data":
+			if err := json.Unmarshal(v, &res); err != nil {
+				return res, nil, errors.Join(errors.New("failed to unmarshal data"), err)
+			}
+		default:
+			var val any
+
+			if err := json.Unmarshal(v, &val); err != nil {
+				return res, nil, errors.Join(fmt.Errorf("failed to unmarshal metadata %s", k), err)
+			}
+
+			metadata[k] = val
+		}
+	}
+
+	if _, exists := decoded["data"]; !exists {
+		return res, nil, errors.New("no data in response")
+	}
+
+	return res, metadata, nil
+}
+
+func populateHeaders(res *httpResponse, resp *http.Response) {
+	res.headers = make(map[string]string, len(resp.Header))
+	for k, v := range resp.Header {
+		res.headers[k] = strings.Join(v, ";")
+	}
+}
+
+// urlForCall patches together a URL for a call.
+func urlForCall(base *url.URL,
+	endpoint string,
+	query string,
+) *url.URL {
+	callURL := *base
+
+	callURL.Path += endpoint
+	if callURL.RawQuery == "" {
+		callURL.RawQuery = query
+	} else if query != "" {
+		callURL.RawQuery = fmt.Sprintf("%s&%s", callURL.RawQuery, query)
+	}
+
+	return &callURL
+}
+
+func statusCodeFamily(status int) int {
+	return status / 100
+}
@@ -0,0 +1,74 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apikeymanager "github.com/attestantio/go-eth2-client/api/v1/keymanager"
+)
+
+type importKeystoresJSON struct {
+	Keystores          []string `json:"keystores"`
+	Passwords          []string `json:"passwords"`
+	SlashingProtection string   `json:"slashing_protection,omitempty"`
+}
+
+// ImportKeystores imports keystores, returning the result for each keystore in the order supplied.
+func (s *Service) ImportKeystores(ctx context.Context,
+	opts *api.ImportKeystoresOpts,
+) (
+	*api.Response[[]*apikeymanager.OperationResult],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	if len(opts.Keystores) == 0 {
+		return nil, errors.Join(errors.New("no keystores specified"), client.ErrInvalidOptions)
+	}
+
+	if len(opts.Passwords) != len(opts.Keystores) {
+		return nil, errors.Join(errors.New("number of passwords does not match number of keystores"), client.ErrInvalidOptions)
+	}
+
+	body, err := json.Marshal(&importKeystoresJSON{
+		Keystores:          opts.Keystores,
+		Passwords:          opts.Passwords,
+		SlashingProtection: opts.SlashingProtection,
+	})
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to marshal JSON"), err)
+	}
+
+	httpResponse, err := s.post(ctx, "/eth/v1/keystores", "", &opts.Common, body)
+	if err != nil {
+		return nil, err
+	}
+
+	data, metadata, err := decodeJSONResponse(httpResponse.body, make([]*apikeymanager.OperationResult, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[[]*apikeymanager.OperationResult]{
+		Data:     data,
+		Metadata: metadata,
+	}, nil
+}
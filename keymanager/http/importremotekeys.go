@@ -0,0 +1,66 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apikeymanager "github.com/attestantio/go-eth2-client/api/v1/keymanager"
+)
+
+type importRemoteKeysJSON struct {
+	RemoteKeys []*apikeymanager.RemoteKey `json:"remote_keys"`
+}
+
+// ImportRemoteKeys imports remote keys, returning the result for each key in the order supplied.
+func (s *Service) ImportRemoteKeys(ctx context.Context,
+	opts *api.ImportRemoteKeysOpts,
+) (
+	*api.Response[[]*apikeymanager.OperationResult],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	if len(opts.RemoteKeys) == 0 {
+		return nil, errors.Join(errors.New("no remote keys specified"), client.ErrInvalidOptions)
+	}
+
+	body, err := json.Marshal(&importRemoteKeysJSON{
+		RemoteKeys: opts.RemoteKeys,
+	})
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to marshal JSON"), err)
+	}
+
+	httpResponse, err := s.post(ctx, "/eth/v1/remotekeys", "", &opts.Common, body)
+	if err != nil {
+		return nil, err
+	}
+
+	data, metadata, err := decodeJSONResponse(httpResponse.body, make([]*apikeymanager.OperationResult, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[[]*apikeymanager.OperationResult]{
+		Data:     data,
+		Metadata: metadata,
+	}, nil
+}
@@ -0,0 +1,49 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apikeymanager "github.com/attestantio/go-eth2-client/api/v1/keymanager"
+)
+
+// Keystores provides the keystores held by the validator client.
+func (s *Service) Keystores(ctx context.Context,
+	opts *api.KeystoresOpts,
+) (
+	*api.Response[[]*apikeymanager.Keystore],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	httpResponse, err := s.get(ctx, "/eth/v1/keystores", "", &opts.Common)
+	if err != nil {
+		return nil, err
+	}
+
+	data, metadata, err := decodeJSONResponse(httpResponse.body, make([]*apikeymanager.Keystore, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[[]*apikeymanager.Keystore]{
+		Data:     data,
+		Metadata: metadata,
+	}, nil
+}
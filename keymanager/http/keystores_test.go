@@ -0,0 +1,94 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apikeymanager "github.com/attestantio/go-eth2-client/api/v1/keymanager"
+	"github.com/attestantio/go-eth2-client/keymanager"
+	"github.com/attestantio/go-eth2-client/keymanager/http"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeystores(t *testing.T) {
+	ctx := context.Background()
+	service := newTestService(t)
+
+	pubkey := phase0.BLSPubKey{0x01}
+	keystore := fmt.Sprintf(`{"pubkey":"%x","crypto":{},"version":4}`, pubkey[:])
+
+	// Invalid options.
+	_, err := service.(keymanager.KeystoresImporter).ImportKeystores(ctx, nil)
+	require.EqualError(t, err, "no options specified")
+	_, err = service.(keymanager.KeystoresImporter).ImportKeystores(ctx, &api.ImportKeystoresOpts{})
+	require.ErrorContains(t, err, "no keystores specified")
+	_, err = service.(keymanager.KeystoresImporter).ImportKeystores(ctx, &api.ImportKeystoresOpts{
+		Keystores: []string{keystore},
+	})
+	require.ErrorContains(t, err, "number of passwords does not match number of keystores")
+
+	// Import twice.
+	importResponse, err := service.(keymanager.KeystoresImporter).ImportKeystores(ctx, &api.ImportKeystoresOpts{
+		Keystores: []string{keystore, keystore},
+		Passwords: []string{"secret", "secret"},
+	})
+	require.NoError(t, err)
+	require.Len(t, importResponse.Data, 2)
+	require.Equal(t, apikeymanager.OperationStatusImported, importResponse.Data[0].Status)
+	require.Equal(t, apikeymanager.OperationStatusDuplicate, importResponse.Data[1].Status)
+
+	// List.
+	listResponse, err := service.(keymanager.KeystoresProvider).Keystores(ctx, &api.KeystoresOpts{})
+	require.NoError(t, err)
+	require.Len(t, listResponse.Data, 1)
+	require.Equal(t, pubkey, listResponse.Data[0].ValidatingPubkey)
+	require.Equal(t, "m/12381/3600/0/0/0", listResponse.Data[0].DerivationPath)
+
+	// Delete.
+	deleteResponse, err := service.(keymanager.KeystoresDeleter).DeleteKeystores(ctx, &api.DeleteKeystoresOpts{
+		Pubkeys: []phase0.BLSPubKey{pubkey, {0x02}},
+	})
+	require.NoError(t, err)
+	require.Len(t, deleteResponse.Data.Results, 2)
+	require.Equal(t, apikeymanager.OperationStatusDeleted, deleteResponse.Data.Results[0].Status)
+	require.Equal(t, apikeymanager.OperationStatusNotFound, deleteResponse.Data.Results[1].Status)
+	require.JSONEq(t, `{"metadata":{},"data":[]}`, deleteResponse.Data.SlashingProtection)
+
+	listResponse, err = service.(keymanager.KeystoresProvider).Keystores(ctx, &api.KeystoresOpts{})
+	require.NoError(t, err)
+	require.Empty(t, listResponse.Data)
+}
+
+func TestUnauthorized(t *testing.T) {
+	ctx := context.Background()
+	service := newTestService(t)
+
+	unauthorized, err := http.New(ctx,
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(service.Address()),
+		http.WithBearerToken("wrong"),
+	)
+	require.NoError(t, err)
+
+	_, err = unauthorized.(keymanager.KeystoresProvider).Keystores(ctx, &api.KeystoresOpts{})
+	var apiErr *api.Error
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, 401, apiErr.StatusCode)
+}
@@ -0,0 +1,73 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"regexp"
+
+	"github.com/attestantio/go-eth2-client/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var requestsMetric *prometheus.CounterVec
+
+func registerMetrics(ctx context.Context, monitor metrics.Service) error {
+	if requestsMetric != nil {
+		// Already registered.
+		return nil
+	}
+
+	if monitor == nil {
+		// No monitor.
+		return nil
+	}
+
+	if monitor.Presenter() == "prometheus" {
+		return registerPrometheusMetrics(ctx)
+	}
+
+	return nil
+}
+
+func registerPrometheusMetrics(_ context.Context) error {
+	requestsMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "keymanagerclient",
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Number of requests",
+	}, []string{"server", "method", "endpoint", "result"})
+	if err := prometheus.Register(requestsMetric); err != nil {
+		return errors.Join(errors.New("failed to register requests_total"), err)
+	}
+
+	return nil
+}
+
+func (s *Service) monitorComplete(_ context.Context, method string, endpoint string, result string) {
+	if requestsMetric == nil {
+		return
+	}
+
+	requestsMetric.WithLabelValues(s.address, method, reduceEndpoint(endpoint), result).Inc()
+}
+
+// pubkeyPattern matches the validator public key portion of an endpoint.
+var pubkeyPattern = regexp.MustCompile("/validator/0x[0-9a-fA-F]{96}/")
+
+// reduceEndpoint reduces an endpoint to its template, to avoid unbounded metric labels.
+func reduceEndpoint(in string) string {
+	return pubkeyPattern.ReplaceAllString(in, "/validator/{pubkey}/")
+}
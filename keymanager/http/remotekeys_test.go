@@ -0,0 +1,63 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apikeymanager "github.com/attestantio/go-eth2-client/api/v1/keymanager"
+	"github.com/attestantio/go-eth2-client/keymanager"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoteKeys(t *testing.T) {
+	ctx := context.Background()
+	service := newTestService(t)
+
+	pubkey := phase0.BLSPubKey{0x01}
+
+	_, err := service.(keymanager.RemoteKeysImporter).ImportRemoteKeys(ctx, &api.ImportRemoteKeysOpts{})
+	require.ErrorContains(t, err, "no remote keys specified")
+
+	importResponse, err := service.(keymanager.RemoteKeysImporter).ImportRemoteKeys(ctx, &api.ImportRemoteKeysOpts{
+		RemoteKeys: []*apikeymanager.RemoteKey{
+			{
+				Pubkey: pubkey,
+				URL:    "https://signer.example.com",
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, importResponse.Data, 1)
+	require.Equal(t, apikeymanager.OperationStatusImported, importResponse.Data[0].Status)
+
+	listResponse, err := service.(keymanager.RemoteKeysProvider).RemoteKeys(ctx, &api.RemoteKeysOpts{})
+	require.NoError(t, err)
+	require.Len(t, listResponse.Data, 1)
+	require.Equal(t, pubkey, listResponse.Data[0].Pubkey)
+	require.Equal(t, "https://signer.example.com", listResponse.Data[0].URL)
+
+	_, err = service.(keymanager.RemoteKeysDeleter).DeleteRemoteKeys(ctx, &api.DeleteRemoteKeysOpts{})
+	require.ErrorContains(t, err, "no public keys specified")
+
+	deleteResponse, err := service.(keymanager.RemoteKeysDeleter).DeleteRemoteKeys(ctx, &api.DeleteRemoteKeysOpts{
+		Pubkeys: []phase0.BLSPubKey{pubkey},
+	})
+	require.NoError(t, err)
+	require.Len(t, deleteResponse.Data, 1)
+	require.Equal(t, apikeymanager.OperationStatusDeleted, deleteResponse.Data[0].Status)
+}
@@ -0,0 +1,234 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/keymanager"
+	"github.com/attestantio/go-eth2-client/keymanager/http"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+const testBearerToken = "api-token-0x1234"
+
+var validatorPathRegex = regexp.MustCompile("^/eth/v1/validator/(0x[0-9a-f]{96})/([a-z_]+)$")
+
+// keymanagerServer is a minimal in-memory keymanager API server for tests.
+type keymanagerServer struct {
+	mu         sync.Mutex
+	keystores  map[string]bool
+	remoteKeys map[string]string
+	settings   map[string]map[string]string
+}
+
+func newKeymanagerServer() *keymanagerServer {
+	return &keymanagerServer{
+		keystores:  make(map[string]bool),
+		remoteKeys: make(map[string]string),
+		settings:   make(map[string]map[string]string),
+	}
+}
+
+func (k *keymanagerServer) ServeHTTP(w nethttp.ResponseWriter, r *nethttp.Request) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if r.Header.Get("Authorization") != "Bearer "+testBearerToken {
+		w.WriteHeader(nethttp.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"code":401,"message":"unauthorized"}`))
+
+		return
+	}
+
+	body, _ := io.ReadAll(r.Body)
+
+	switch {
+	case r.URL.Path == "/eth/v1/keystores":
+		k.serveKeystores(w, r.Method, body)
+	case r.URL.Path == "/eth/v1/remotekeys":
+		k.serveRemoteKeys(w, r.Method, body)
+	case validatorPathRegex.MatchString(r.URL.Path):
+		matches := validatorPathRegex.FindStringSubmatch(r.URL.Path)
+		k.serveValidator(w, r, matches[1], matches[2], body)
+	default:
+		w.WriteHeader(nethttp.StatusNotFound)
+	}
+}
+
+func (k *keymanagerServer) serveKeystores(w nethttp.ResponseWriter, method string, body []byte) {
+	switch method {
+	case nethttp.MethodGet:
+		data := make([]map[string]any, 0)
+		for pubkey := range k.keystores {
+			data = append(data, map[string]any{"validating_pubkey": pubkey, "derivation_path": "m/12381/3600/0/0/0"})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+	case nethttp.MethodPost:
+		var req struct {
+			Keystores []string `json:"keystores"`
+			Passwords []string `json:"passwords"`
+		}
+		_ = json.Unmarshal(body, &req)
+		data := make([]map[string]string, 0)
+		for _, keystore := range req.Keystores {
+			var ks struct {
+				Pubkey string `json:"pubkey"`
+			}
+			_ = json.Unmarshal([]byte(keystore), &ks)
+			pubkey := "0x" + ks.Pubkey
+			if k.keystores[pubkey] {
+				data = append(data, map[string]string{"status": "duplicate"})
+			} else {
+				k.keystores[pubkey] = true
+				data = append(data, map[string]string{"status": "imported"})
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+	case nethttp.MethodDelete:
+		var req struct {
+			Pubkeys []string `json:"pubkeys"`
+		}
+		_ = json.Unmarshal(body, &req)
+		data := make([]map[string]string, 0)
+		for _, pubkey := range req.Pubkeys {
+			if k.keystores[pubkey] {
+				delete(k.keystores, pubkey)
+				data = append(data, map[string]string{"status": "deleted"})
+			} else {
+				data = append(data, map[string]string{"status": "not_found"})
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": data, "slashing_protection": `{"metadata":{},"data":[]}`})
+	}
+}
+
+func (k *keymanagerServer) serveRemoteKeys(w nethttp.ResponseWriter, method string, body []byte) {
+	switch method {
+	case nethttp.MethodGet:
+		data := make([]map[string]any, 0)
+		for pubkey, url := range k.remoteKeys {
+			data = append(data, map[string]any{"pubkey": pubkey, "url": url, "readonly": false})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+	case nethttp.MethodPost:
+		var req struct {
+			RemoteKeys []struct {
+				Pubkey string `json:"pubkey"`
+				URL    string `json:"url"`
+			} `json:"remote_keys"`
+		}
+		_ = json.Unmarshal(body, &req)
+		data := make([]map[string]string, 0)
+		for _, remoteKey := range req.RemoteKeys {
+			k.remoteKeys[remoteKey.Pubkey] = remoteKey.URL
+			data = append(data, map[string]string{"status": "imported"})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+	case nethttp.MethodDelete:
+		var req struct {
+			Pubkeys []string `json:"pubkeys"`
+		}
+		_ = json.Unmarshal(body, &req)
+		data := make([]map[string]string, 0)
+		for _, pubkey := range req.Pubkeys {
+			if _, exists := k.remoteKeys[pubkey]; exists {
+				delete(k.remoteKeys, pubkey)
+				data = append(data, map[string]string{"status": "deleted"})
+			} else {
+				data = append(data, map[string]string{"status": "not_found"})
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+	}
+}
+
+func (k *keymanagerServer) serveValidator(w nethttp.ResponseWriter, r *nethttp.Request, pubkey string, item string, body []byte) {
+	fields := map[string]string{
+		"feerecipient": "ethaddress",
+		"gas_limit":    "gas_limit",
+		"graffiti":     "graffiti",
+	}
+	defaults := map[string]string{
+		"feerecipient": "0x0000000000000000000000000000000000000000",
+		"gas_limit":    "30000000",
+		"graffiti":     "",
+	}
+
+	if item == "voluntary_exit" {
+		epoch := r.URL.Query().Get("epoch")
+		if epoch == "" {
+			epoch = "100"
+		}
+		_, _ = fmt.Fprintf(w, `{"data":{"message":{"epoch":"%s","validator_index":"1"},"signature":"0x%0192x"}}`, epoch, 0)
+
+		return
+	}
+
+	field, exists := fields[item]
+	if !exists {
+		w.WriteHeader(nethttp.StatusNotFound)
+
+		return
+	}
+
+	if _, exists := k.settings[pubkey]; !exists {
+		k.settings[pubkey] = make(map[string]string)
+	}
+
+	switch r.Method {
+	case nethttp.MethodGet:
+		value, exists := k.settings[pubkey][item]
+		if !exists {
+			value = defaults[item]
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]string{"pubkey": pubkey, field: value}})
+	case nethttp.MethodPost:
+		req := make(map[string]string)
+		_ = json.Unmarshal(body, &req)
+		k.settings[pubkey][item] = req[field]
+		w.WriteHeader(nethttp.StatusAccepted)
+	case nethttp.MethodDelete:
+		delete(k.settings[pubkey], item)
+		w.WriteHeader(nethttp.StatusNoContent)
+	}
+}
+
+// newTestService starts an in-memory keymanager server and returns a client connected to it.
+func newTestService(t *testing.T) keymanager.Service {
+	t.Helper()
+
+	srv := httptest.NewServer(newKeymanagerServer())
+	t.Cleanup(srv.Close)
+
+	service, err := http.New(context.Background(),
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(srv.URL),
+		http.WithBearerToken(testBearerToken),
+	)
+	require.NoError(t, err)
+
+	return service
+}
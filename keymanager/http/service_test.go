@@ -0,0 +1,83 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/keymanager/http"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		parameters []http.Parameter
+		address    string
+		err        string
+	}{
+		{
+			name: "AddressMissing",
+			parameters: []http.Parameter{
+				http.WithLogLevel(zerolog.Disabled),
+				http.WithBearerToken("token"),
+			},
+			err: "problem with parameters\nno address specified",
+		},
+		{
+			name: "BearerTokenMissing",
+			parameters: []http.Parameter{
+				http.WithLogLevel(zerolog.Disabled),
+				http.WithAddress("localhost:5062"),
+			},
+			err: "problem with parameters\nno bearer token specified",
+		},
+		{
+			name: "TimeoutZero",
+			parameters: []http.Parameter{
+				http.WithLogLevel(zerolog.Disabled),
+				http.WithAddress("localhost:5062"),
+				http.WithBearerToken("token"),
+				http.WithTimeout(0),
+			},
+			err: "problem with parameters\nno timeout specified",
+		},
+		{
+			name: "Good",
+			parameters: []http.Parameter{
+				http.WithLogLevel(zerolog.Disabled),
+				http.WithAddress("localhost:5062"),
+				http.WithBearerToken("token"),
+			},
+			address: "http://localhost:5062",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service, err := http.New(ctx, test.parameters...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "Keymanager (HTTP)", service.Name())
+				require.Equal(t, test.address, service.Address())
+			}
+		})
+	}
+}
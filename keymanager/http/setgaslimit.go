@@ -0,0 +1,57 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+type setGasLimitJSON struct {
+	GasLimit string `json:"gas_limit"`
+}
+
+// SetGasLimit sets the gas limit for a validator.
+func (s *Service) SetGasLimit(ctx context.Context,
+	opts *api.SetValidatorGasLimitOpts,
+) error {
+	if opts == nil {
+		return client.ErrNoOptions
+	}
+
+	if opts.GasLimit == 0 {
+		return errors.Join(errors.New("no gas limit specified"), client.ErrInvalidOptions)
+	}
+
+	body, err := json.Marshal(&setGasLimitJSON{
+		GasLimit: strconv.FormatUint(opts.GasLimit, 10),
+	})
+	if err != nil {
+		return errors.Join(errors.New("failed to marshal JSON"), err)
+	}
+
+	endpoint := fmt.Sprintf("/eth/v1/validator/%#x/gas_limit", opts.Pubkey[:])
+
+	if _, err := s.post(ctx, endpoint, "", &opts.Common, body); err != nil {
+		return err
+	}
+
+	return nil
+}
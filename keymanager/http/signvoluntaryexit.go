@@ -0,0 +1,58 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// SignVoluntaryExit has the validator client sign a voluntary exit for a validator.
+// The exit is returned but not submitted to the network.
+func (s *Service) SignVoluntaryExit(ctx context.Context,
+	opts *api.SignVoluntaryExitOpts,
+) (
+	*api.Response[*phase0.SignedVoluntaryExit],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	endpoint := fmt.Sprintf("/eth/v1/validator/%#x/voluntary_exit", opts.Pubkey[:])
+
+	query := ""
+	if opts.Epoch != nil {
+		query = fmt.Sprintf("epoch=%d", *opts.Epoch)
+	}
+
+	httpResponse, err := s.post(ctx, endpoint, query, &opts.Common, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	data, metadata, err := decodeJSONResponse(httpResponse.body, &phase0.SignedVoluntaryExit{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[*phase0.SignedVoluntaryExit]{
+		Data:     data,
+		Metadata: metadata,
+	}, nil
+}
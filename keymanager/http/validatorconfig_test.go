@@ -0,0 +1,115 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/keymanager"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeRecipient(t *testing.T) {
+	ctx := context.Background()
+	service := newTestService(t)
+
+	pubkey := phase0.BLSPubKey{0x01}
+	feeRecipient := bellatrix.ExecutionAddress{0x01, 0x02, 0x03}
+
+	require.NoError(t, service.(keymanager.FeeRecipientSetter).SetFeeRecipient(ctx, &api.SetValidatorFeeRecipientOpts{
+		Pubkey:       pubkey,
+		FeeRecipient: feeRecipient,
+	}))
+
+	response, err := service.(keymanager.FeeRecipientProvider).FeeRecipient(ctx, &api.ValidatorFeeRecipientOpts{Pubkey: pubkey})
+	require.NoError(t, err)
+	require.Equal(t, pubkey, response.Data.Pubkey)
+	require.Equal(t, feeRecipient, response.Data.EthAddress)
+
+	require.NoError(t, service.(keymanager.FeeRecipientDeleter).DeleteFeeRecipient(ctx, &api.ValidatorFeeRecipientOpts{Pubkey: pubkey}))
+
+	response, err = service.(keymanager.FeeRecipientProvider).FeeRecipient(ctx, &api.ValidatorFeeRecipientOpts{Pubkey: pubkey})
+	require.NoError(t, err)
+	require.Equal(t, bellatrix.ExecutionAddress{}, response.Data.EthAddress)
+}
+
+func TestGasLimit(t *testing.T) {
+	ctx := context.Background()
+	service := newTestService(t)
+
+	pubkey := phase0.BLSPubKey{0x01}
+
+	err := service.(keymanager.GasLimitSetter).SetGasLimit(ctx, &api.SetValidatorGasLimitOpts{Pubkey: pubkey})
+	require.ErrorContains(t, err, "no gas limit specified")
+
+	require.NoError(t, service.(keymanager.GasLimitSetter).SetGasLimit(ctx, &api.SetValidatorGasLimitOpts{
+		Pubkey:   pubkey,
+		GasLimit: 36000000,
+	}))
+
+	response, err := service.(keymanager.GasLimitProvider).GasLimit(ctx, &api.ValidatorGasLimitOpts{Pubkey: pubkey})
+	require.NoError(t, err)
+	require.Equal(t, uint64(36000000), response.Data.GasLimit)
+
+	require.NoError(t, service.(keymanager.GasLimitDeleter).DeleteGasLimit(ctx, &api.ValidatorGasLimitOpts{Pubkey: pubkey}))
+
+	response, err = service.(keymanager.GasLimitProvider).GasLimit(ctx, &api.ValidatorGasLimitOpts{Pubkey: pubkey})
+	require.NoError(t, err)
+	require.Equal(t, uint64(30000000), response.Data.GasLimit)
+}
+
+func TestGraffiti(t *testing.T) {
+	ctx := context.Background()
+	service := newTestService(t)
+
+	pubkey := phase0.BLSPubKey{0x01}
+
+	require.NoError(t, service.(keymanager.GraffitiSetter).SetGraffiti(ctx, &api.SetValidatorGraffitiOpts{
+		Pubkey:   pubkey,
+		Graffiti: "hello",
+	}))
+
+	response, err := service.(keymanager.GraffitiProvider).Graffiti(ctx, &api.ValidatorGraffitiOpts{Pubkey: pubkey})
+	require.NoError(t, err)
+	require.Equal(t, "hello", response.Data.Graffiti)
+
+	require.NoError(t, service.(keymanager.GraffitiDeleter).DeleteGraffiti(ctx, &api.ValidatorGraffitiOpts{Pubkey: pubkey}))
+
+	response, err = service.(keymanager.GraffitiProvider).Graffiti(ctx, &api.ValidatorGraffitiOpts{Pubkey: pubkey})
+	require.NoError(t, err)
+	require.Empty(t, response.Data.Graffiti)
+}
+
+func TestSignVoluntaryExit(t *testing.T) {
+	ctx := context.Background()
+	service := newTestService(t)
+
+	response, err := service.(keymanager.VoluntaryExitSigner).SignVoluntaryExit(ctx, &api.SignVoluntaryExitOpts{
+		Pubkey: phase0.BLSPubKey{0x01},
+	})
+	require.NoError(t, err)
+	require.Equal(t, phase0.Epoch(100), response.Data.Message.Epoch)
+
+	epoch := phase0.Epoch(200)
+	response, err = service.(keymanager.VoluntaryExitSigner).SignVoluntaryExit(ctx, &api.SignVoluntaryExitOpts{
+		Pubkey: phase0.BLSPubKey{0x01},
+		Epoch:  &epoch,
+	})
+	require.NoError(t, err)
+	require.Equal(t, phase0.Epoch(200), response.Data.Message.Epoch)
+}
@@ -0,0 +1,31 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// DeleteFeeRecipient deletes the fee recipient for a validator, reverting it to the default.
+func (s *Service) DeleteFeeRecipient(ctx context.Context,
+	opts *api.ValidatorFeeRecipientOpts,
+) error {
+	if s.DeleteFeeRecipientFunc != nil {
+		return s.DeleteFeeRecipientFunc(ctx, opts)
+	}
+
+	return nil
+}
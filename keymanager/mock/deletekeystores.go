@@ -0,0 +1,42 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apikeymanager "github.com/attestantio/go-eth2-client/api/v1/keymanager"
+)
+
+// DeleteKeystores deletes keystores, returning the result for each keystore in the order supplied
+// along with the slashing protection data for the deleted keys.
+func (s *Service) DeleteKeystores(ctx context.Context,
+	opts *api.DeleteKeystoresOpts,
+) (
+	*api.Response[*apikeymanager.DeleteKeystoresResponse],
+	error,
+) {
+	if s.DeleteKeystoresFunc != nil {
+		return s.DeleteKeystoresFunc(ctx, opts)
+	}
+
+	return &api.Response[*apikeymanager.DeleteKeystoresResponse]{
+		Data: &apikeymanager.DeleteKeystoresResponse{
+			Results:            operationResults(len(opts.Pubkeys), apikeymanager.OperationStatusDeleted),
+			SlashingProtection: `{"metadata":{"interchange_format_version":"5","genesis_validators_root":"0x0000000000000000000000000000000000000000000000000000000000000000"},"data":[]}`,
+		},
+		Metadata: make(map[string]any),
+	}, nil
+}
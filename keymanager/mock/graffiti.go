@@ -0,0 +1,41 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apikeymanager "github.com/attestantio/go-eth2-client/api/v1/keymanager"
+)
+
+// Graffiti provides the graffiti for a validator.
+func (s *Service) Graffiti(ctx context.Context,
+	opts *api.ValidatorGraffitiOpts,
+) (
+	*api.Response[*apikeymanager.Graffiti],
+	error,
+) {
+	if s.GraffitiFunc != nil {
+		return s.GraffitiFunc(ctx, opts)
+	}
+
+	return &api.Response[*apikeymanager.Graffiti]{
+		Data: &apikeymanager.Graffiti{
+			Pubkey:   opts.Pubkey,
+			Graffiti: "mock",
+		},
+		Metadata: make(map[string]any),
+	}, nil
+}
@@ -0,0 +1,38 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apikeymanager "github.com/attestantio/go-eth2-client/api/v1/keymanager"
+)
+
+// ImportKeystores imports keystores, returning the result for each keystore in the order supplied.
+func (s *Service) ImportKeystores(ctx context.Context,
+	opts *api.ImportKeystoresOpts,
+) (
+	*api.Response[[]*apikeymanager.OperationResult],
+	error,
+) {
+	if s.ImportKeystoresFunc != nil {
+		return s.ImportKeystoresFunc(ctx, opts)
+	}
+
+	return &api.Response[[]*apikeymanager.OperationResult]{
+		Data:     operationResults(len(opts.Keystores), apikeymanager.OperationStatusImported),
+		Metadata: make(map[string]any),
+	}, nil
+}
@@ -0,0 +1,38 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apikeymanager "github.com/attestantio/go-eth2-client/api/v1/keymanager"
+)
+
+// RemoteKeys provides the remote keys held by the validator client.
+func (s *Service) RemoteKeys(ctx context.Context,
+	opts *api.RemoteKeysOpts,
+) (
+	*api.Response[[]*apikeymanager.RemoteKey],
+	error,
+) {
+	if s.RemoteKeysFunc != nil {
+		return s.RemoteKeysFunc(ctx, opts)
+	}
+
+	return &api.Response[[]*apikeymanager.RemoteKey]{
+		Data:     make([]*apikeymanager.RemoteKey, 0),
+		Metadata: make(map[string]any),
+	}, nil
+}
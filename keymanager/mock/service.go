@@ -0,0 +1,90 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apikeymanager "github.com/attestantio/go-eth2-client/api/v1/keymanager"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service is a mock keymanager service, providing data locally.
+type Service struct {
+	log  zerolog.Logger
+	name string
+
+	// Functions that can be provided to mock specific responses from this client.
+	DeleteFeeRecipientFunc func(context.Context, *api.ValidatorFeeRecipientOpts) error
+	DeleteGasLimitFunc     func(context.Context, *api.ValidatorGasLimitOpts) error
+	DeleteGraffitiFunc     func(context.Context, *api.ValidatorGraffitiOpts) error
+	DeleteKeystoresFunc    func(context.Context, *api.DeleteKeystoresOpts) (*api.Response[*apikeymanager.DeleteKeystoresResponse], error)
+	DeleteRemoteKeysFunc   func(context.Context, *api.DeleteRemoteKeysOpts) (*api.Response[[]*apikeymanager.OperationResult], error)
+	FeeRecipientFunc       func(context.Context, *api.ValidatorFeeRecipientOpts) (*api.Response[*apikeymanager.FeeRecipient], error)
+	GasLimitFunc           func(context.Context, *api.ValidatorGasLimitOpts) (*api.Response[*apikeymanager.GasLimit], error)
+	GraffitiFunc           func(context.Context, *api.ValidatorGraffitiOpts) (*api.Response[*apikeymanager.Graffiti], error)
+	ImportKeystoresFunc    func(context.Context, *api.ImportKeystoresOpts) (*api.Response[[]*apikeymanager.OperationResult], error)
+	ImportRemoteKeysFunc   func(context.Context, *api.ImportRemoteKeysOpts) (*api.Response[[]*apikeymanager.OperationResult], error)
+	KeystoresFunc          func(context.Context, *api.KeystoresOpts) (*api.Response[[]*apikeymanager.Keystore], error)
+	RemoteKeysFunc         func(context.Context, *api.RemoteKeysOpts) (*api.Response[[]*apikeymanager.RemoteKey], error)
+	SetFeeRecipientFunc    func(context.Context, *api.SetValidatorFeeRecipientOpts) error
+	SetGasLimitFunc        func(context.Context, *api.SetValidatorGasLimitOpts) error
+	SetGraffitiFunc        func(context.Context, *api.SetValidatorGraffitiOpts) error
+	SignVoluntaryExitFunc  func(context.Context, *api.SignVoluntaryExitOpts) (*api.Response[*phase0.SignedVoluntaryExit], error)
+}
+
+// New creates a new keymanager service, mocking connections.
+func New(_ context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "keymanager").Str("impl", "mock").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	return &Service{
+		log:  log,
+		name: parameters.name,
+	}, nil
+}
+
+// Name provides the name of the service.
+func (*Service) Name() string {
+	return "Mock"
+}
+
+// Address provides the address of the service.
+func (s *Service) Address() string {
+	return s.name
+}
+
+// operationResults returns a number of operation results with the given status.
+func operationResults(count int, status apikeymanager.OperationStatus) []*apikeymanager.OperationResult {
+	res := make([]*apikeymanager.OperationResult, count)
+	for i := range res {
+		res[i] = &apikeymanager.OperationResult{
+			Status: status,
+		}
+	}
+
+	return res
+}
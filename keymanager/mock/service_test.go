@@ -0,0 +1,51 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apikeymanager "github.com/attestantio/go-eth2-client/api/v1/keymanager"
+	"github.com/attestantio/go-eth2-client/keymanager"
+	"github.com/attestantio/go-eth2-client/keymanager/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	var service keymanager.Service
+	service, err := mock.New(ctx, mock.WithName("mock"))
+	require.NoError(t, err)
+	require.Equal(t, "Mock", service.Name())
+	require.Equal(t, "mock", service.Address())
+
+	response, err := service.(keymanager.KeystoresImporter).ImportKeystores(ctx, &api.ImportKeystoresOpts{
+		Keystores: []string{"{}", "{}"},
+		Passwords: []string{"", ""},
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Data, 2)
+	require.Equal(t, apikeymanager.OperationStatusImported, response.Data[1].Status)
+
+	epoch := phase0.Epoch(5)
+	exitResponse, err := service.(keymanager.VoluntaryExitSigner).SignVoluntaryExit(ctx, &api.SignVoluntaryExitOpts{
+		Epoch: &epoch,
+	})
+	require.NoError(t, err)
+	require.Equal(t, epoch, exitResponse.Data.Message.Epoch)
+}
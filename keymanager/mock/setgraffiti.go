@@ -0,0 +1,31 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// SetGraffiti sets the graffiti for a validator.
+func (s *Service) SetGraffiti(ctx context.Context,
+	opts *api.SetValidatorGraffitiOpts,
+) error {
+	if s.SetGraffitiFunc != nil {
+		return s.SetGraffitiFunc(ctx, opts)
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// SignVoluntaryExit has the validator client sign a voluntary exit for a validator.
+// The exit is returned but not submitted to the network.
+func (s *Service) SignVoluntaryExit(ctx context.Context,
+	opts *api.SignVoluntaryExitOpts,
+) (
+	*api.Response[*phase0.SignedVoluntaryExit],
+	error,
+) {
+	if s.SignVoluntaryExitFunc != nil {
+		return s.SignVoluntaryExitFunc(ctx, opts)
+	}
+
+	epoch := phase0.Epoch(0)
+	if opts.Epoch != nil {
+		epoch = *opts.Epoch
+	}
+
+	return &api.Response[*phase0.SignedVoluntaryExit]{
+		Data: &phase0.SignedVoluntaryExit{
+			Message: &phase0.VoluntaryExit{
+				Epoch: epoch,
+			},
+		},
+		Metadata: make(map[string]any),
+	}, nil
+}
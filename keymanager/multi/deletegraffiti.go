@@ -0,0 +1,37 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/keymanager"
+)
+
+// DeleteGraffiti deletes the graffiti for a validator, reverting it to the default.
+func (s *Service) DeleteGraffiti(ctx context.Context,
+	opts *api.ValidatorGraffitiOpts,
+) error {
+	_, err := s.doWrite(ctx, func(ctx context.Context, client keymanager.Service) (any, error) {
+		err := client.(keymanager.GraffitiDeleter).DeleteGraffiti(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		return true, nil
+	})
+
+	return err
+}
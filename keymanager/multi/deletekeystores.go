@@ -0,0 +1,50 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apikeymanager "github.com/attestantio/go-eth2-client/api/v1/keymanager"
+	"github.com/attestantio/go-eth2-client/keymanager"
+)
+
+// DeleteKeystores deletes keystores, returning the result for each keystore in the order supplied
+// along with the slashing protection data for the deleted keys.
+func (s *Service) DeleteKeystores(ctx context.Context,
+	opts *api.DeleteKeystoresOpts,
+) (
+	*api.Response[*apikeymanager.DeleteKeystoresResponse],
+	error,
+) {
+	res, err := s.doWrite(ctx, func(ctx context.Context, client keymanager.Service) (any, error) {
+		res, err := client.(keymanager.KeystoresDeleter).DeleteKeystores(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		return res, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response, isResponse := res.(*api.Response[*apikeymanager.DeleteKeystoresResponse])
+	if !isResponse {
+		return nil, ErrIncorrectType
+	}
+
+	return response, nil
+}
@@ -0,0 +1,110 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"errors"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/keymanager"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel     zerolog.Level
+	clients      []keymanager.Service
+	addresses    []string
+	bearerToken  string
+	timeout      time.Duration
+	extraHeaders map[string]string
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithClients sets the pre-existing clients to add to the multi list.
+func WithClients(clients []keymanager.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.clients = clients
+	})
+}
+
+// WithAddresses sets the addresses of clients to add to the multi list.
+// All addresses share the same bearer token.
+func WithAddresses(addresses []string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.addresses = addresses
+	})
+}
+
+// WithBearerToken sets the bearer token used for clients created from addresses.
+func WithBearerToken(bearerToken string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.bearerToken = bearerToken
+	})
+}
+
+// WithTimeout sets the timeout for client requests.
+func WithTimeout(timeout time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.timeout = timeout
+	})
+}
+
+// WithExtraHeaders sets additional headers to be sent with each HTTP request.
+func WithExtraHeaders(headers map[string]string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.extraHeaders = headers
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:     zerolog.GlobalLevel(),
+		timeout:      10 * time.Second,
+		extraHeaders: make(map[string]string),
+	}
+
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if len(parameters.clients)+len(parameters.addresses) == 0 {
+		return nil, errors.New("no keymanager clients specified")
+	}
+
+	if len(parameters.addresses) > 0 && parameters.bearerToken == "" {
+		return nil, errors.New("no bearer token specified")
+	}
+
+	return &parameters, nil
+}
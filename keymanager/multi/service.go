@@ -0,0 +1,143 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/keymanager"
+	"github.com/attestantio/go-eth2-client/keymanager/http"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service handles multiple keymanager clients.
+// Requests that only read state are sent to each client in turn until one succeeds.  Requests that
+// change state, such as importing or deleting keys, are sent only to the first client: a request
+// whose response was lost may still have taken effect, so sending it to another client could leave
+// the same key active on two validator clients.
+type Service struct {
+	log zerolog.Logger
+
+	clients []keymanager.Service
+}
+
+// New creates a new keymanager client with multiple endpoints.
+func New(ctx context.Context, params ...Parameter) (keymanager.Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "keymanager").Str("impl", "multi").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	clients := make([]keymanager.Service, 0, len(parameters.clients)+len(parameters.addresses))
+	clients = append(clients, parameters.clients...)
+
+	for _, address := range parameters.addresses {
+		client, err := http.New(ctx,
+			http.WithLogLevel(parameters.logLevel),
+			http.WithTimeout(parameters.timeout),
+			http.WithAddress(address),
+			http.WithBearerToken(parameters.bearerToken),
+			http.WithExtraHeaders(parameters.extraHeaders),
+		)
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to create client"), err)
+		}
+
+		clients = append(clients, client)
+	}
+
+	return &Service{
+		log:     log,
+		clients: clients,
+	}, nil
+}
+
+// Name returns the name of the client implementation.
+func (*Service) Name() string {
+	return "multi"
+}
+
+// Address returns the address of the first client.
+func (s *Service) Address() string {
+	return s.clients[0].Address()
+}
+
+// callFunc is the definition for a call function.  It provides a generic return interface
+// to allow the caller to unpick the results as it sees fit.
+type callFunc func(ctx context.Context, client keymanager.Service) (any, error)
+
+// doCall carries out a read-only call on the clients in turn until one succeeds.
+// Errors from the keymanager API itself, such as an unknown validator, are returned
+// immediately rather than being retried against the next client.
+func (s *Service) doCall(ctx context.Context, call callFunc) (any, error) {
+	var (
+		err error
+		res any
+	)
+
+	for _, client := range s.clients {
+		log := s.log.With().Str("client", client.Name()).Str("address", client.Address()).Logger()
+
+		res, err = call(ctx, client)
+		if err != nil {
+			var apiErr *api.Error
+			switch {
+			case errors.As(err, &apiErr) && statusCodeFamily(apiErr.StatusCode) == 4:
+				log.Trace().Err(err).Msg("Not failing over on user error")
+
+				return res, err
+			case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+				log.Trace().Err(err).Msg("Not failing over on context error")
+
+				return res, err
+			}
+
+			log.Debug().Err(err).Msg("Call failed; trying next client")
+
+			continue
+		}
+
+		return res, nil
+	}
+
+	return nil, err
+}
+
+// doWrite carries out a call that changes state on the first client only.
+// The call is never failed over to another client, whatever the error.
+func (s *Service) doWrite(ctx context.Context, call callFunc) (any, error) {
+	client := s.clients[0]
+
+	res, err := call(ctx, client)
+	if err != nil {
+		s.log.Debug().Str("client", client.Name()).Str("address", client.Address()).Err(err).Msg("Call failed; not failing over as it changes state")
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func statusCodeFamily(status int) int {
+	return status / 100
+}
@@ -0,0 +1,155 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apikeymanager "github.com/attestantio/go-eth2-client/api/v1/keymanager"
+	"github.com/attestantio/go-eth2-client/keymanager"
+	"github.com/attestantio/go-eth2-client/keymanager/mock"
+	"github.com/attestantio/go-eth2-client/keymanager/multi"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := multi.New(ctx, multi.WithLogLevel(zerolog.Disabled))
+	require.EqualError(t, err, "problem with parameters\nno keymanager clients specified")
+
+	_, err = multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithAddresses([]string{"localhost:5062"}),
+	)
+	require.EqualError(t, err, "problem with parameters\nno bearer token specified")
+
+	client, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	service, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]keymanager.Service{client}),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "multi", service.Name())
+	require.Equal(t, "mock 1", service.Address())
+}
+
+func TestFailover(t *testing.T) {
+	ctx := context.Background()
+
+	failing, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	failing.KeystoresFunc = func(context.Context, *api.KeystoresOpts) (*api.Response[[]*apikeymanager.Keystore], error) {
+		return nil, errors.New("connection refused")
+	}
+	working, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+
+	service, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]keymanager.Service{failing, working}),
+	)
+	require.NoError(t, err)
+
+	response, err := service.(keymanager.KeystoresProvider).Keystores(ctx, &api.KeystoresOpts{})
+	require.NoError(t, err)
+	require.NotNil(t, response.Data)
+}
+
+func TestNoFailoverOnUserError(t *testing.T) {
+	ctx := context.Background()
+
+	rejecting, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	rejecting.SetGraffitiFunc = func(context.Context, *api.SetValidatorGraffitiOpts) error {
+		return &api.Error{
+			Method:     "POST",
+			StatusCode: 404,
+			Endpoint:   "/eth/v1/validator/0x01/graffiti",
+		}
+	}
+	working, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+	called := false
+	working.SetGraffitiFunc = func(context.Context, *api.SetValidatorGraffitiOpts) error {
+		called = true
+
+		return nil
+	}
+
+	service, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]keymanager.Service{rejecting, working}),
+	)
+	require.NoError(t, err)
+
+	err = service.(keymanager.GraffitiSetter).SetGraffiti(ctx, &api.SetValidatorGraffitiOpts{Graffiti: "hello"})
+	var apiErr *api.Error
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, 404, apiErr.StatusCode)
+	require.False(t, called)
+}
+
+func TestNoFailoverOnWrite(t *testing.T) {
+	ctx := context.Background()
+
+	failing, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	failing.ImportKeystoresFunc = func(context.Context, *api.ImportKeystoresOpts) (*api.Response[[]*apikeymanager.OperationResult], error) {
+		return nil, &api.Error{
+			Method:     "POST",
+			StatusCode: 503,
+			Endpoint:   "/eth/v1/keystores",
+		}
+	}
+	working, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+	called := false
+	working.ImportKeystoresFunc = func(context.Context, *api.ImportKeystoresOpts) (*api.Response[[]*apikeymanager.OperationResult], error) {
+		called = true
+
+		return &api.Response[[]*apikeymanager.OperationResult]{}, nil
+	}
+	working.DeleteKeystoresFunc = func(context.Context, *api.DeleteKeystoresOpts) (*api.Response[*apikeymanager.DeleteKeystoresResponse], error) {
+		called = true
+
+		return &api.Response[*apikeymanager.DeleteKeystoresResponse]{}, nil
+	}
+
+	service, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]keymanager.Service{failing, working}),
+	)
+	require.NoError(t, err)
+
+	// A server error on an import is returned rather than being sent to the next client.
+	_, err = service.(keymanager.KeystoresImporter).ImportKeystores(ctx, &api.ImportKeystoresOpts{})
+	var apiErr *api.Error
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, 503, apiErr.StatusCode)
+
+	// As is a connection error on a delete.
+	failing.DeleteKeystoresFunc = func(context.Context, *api.DeleteKeystoresOpts) (*api.Response[*apikeymanager.DeleteKeystoresResponse], error) {
+		return nil, errors.New("connection refused")
+	}
+	_, err = service.(keymanager.KeystoresDeleter).DeleteKeystores(ctx, &api.DeleteKeystoresOpts{})
+	require.EqualError(t, err, "connection refused")
+
+	require.False(t, called)
+}
@@ -0,0 +1,50 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/keymanager"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// SignVoluntaryExit has the validator client sign a voluntary exit for a validator.
+// The exit is returned but not submitted to the network.
+func (s *Service) SignVoluntaryExit(ctx context.Context,
+	opts *api.SignVoluntaryExitOpts,
+) (
+	*api.Response[*phase0.SignedVoluntaryExit],
+	error,
+) {
+	res, err := s.doCall(ctx, func(ctx context.Context, client keymanager.Service) (any, error) {
+		res, err := client.(keymanager.VoluntaryExitSigner).SignVoluntaryExit(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		return res, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response, isResponse := res.(*api.Response[*phase0.SignedVoluntaryExit])
+	if !isResponse {
+		return nil, ErrIncorrectType
+	}
+
+	return response, nil
+}
@@ -0,0 +1,191 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apikeymanager "github.com/attestantio/go-eth2-client/api/v1/keymanager"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Service is the service providing a connection to a validator client's keymanager API.
+type Service interface {
+	// Name returns the name of the keymanager implementation.
+	Name() string
+
+	// Address returns the address of the keymanager.
+	Address() string
+}
+
+// KeystoresProvider is the interface for providing local keystores.
+type KeystoresProvider interface {
+	// Keystores provides the keystores held by the validator client.
+	Keystores(ctx context.Context,
+		opts *api.KeystoresOpts,
+	) (
+		*api.Response[[]*apikeymanager.Keystore],
+		error,
+	)
+}
+
+// KeystoresImporter is the interface for importing local keystores.
+type KeystoresImporter interface {
+	// ImportKeystores imports keystores, returning the result for each keystore in the order supplied.
+	ImportKeystores(ctx context.Context,
+		opts *api.ImportKeystoresOpts,
+	) (
+		*api.Response[[]*apikeymanager.OperationResult],
+		error,
+	)
+}
+
+// KeystoresDeleter is the interface for deleting local keystores.
+type KeystoresDeleter interface {
+	// DeleteKeystores deletes keystores, returning the result for each keystore in the order supplied
+	// along with the slashing protection data for the deleted keys.
+	DeleteKeystores(ctx context.Context,
+		opts *api.DeleteKeystoresOpts,
+	) (
+		*api.Response[*apikeymanager.DeleteKeystoresResponse],
+		error,
+	)
+}
+
+// RemoteKeysProvider is the interface for providing remote keys.
+type RemoteKeysProvider interface {
+	// RemoteKeys provides the remote keys held by the validator client.
+	RemoteKeys(ctx context.Context,
+		opts *api.RemoteKeysOpts,
+	) (
+		*api.Response[[]*apikeymanager.RemoteKey],
+		error,
+	)
+}
+
+// RemoteKeysImporter is the interface for importing remote keys.
+type RemoteKeysImporter interface {
+	// ImportRemoteKeys imports remote keys, returning the result for each key in the order supplied.
+	ImportRemoteKeys(ctx context.Context,
+		opts *api.ImportRemoteKeysOpts,
+	) (
+		*api.Response[[]*apikeymanager.OperationResult],
+		error,
+	)
+}
+
+// RemoteKeysDeleter is the interface for deleting remote keys.
+type RemoteKeysDeleter interface {
+	// DeleteRemoteKeys deletes remote keys, returning the result for each key in the order supplied.
+	DeleteRemoteKeys(ctx context.Context,
+		opts *api.DeleteRemoteKeysOpts,
+	) (
+		*api.Response[[]*apikeymanager.OperationResult],
+		error,
+	)
+}
+
+// FeeRecipientProvider is the interface for providing validator fee recipients.
+type FeeRecipientProvider interface {
+	// FeeRecipient provides the fee recipient for a validator.
+	FeeRecipient(ctx context.Context,
+		opts *api.ValidatorFeeRecipientOpts,
+	) (
+		*api.Response[*apikeymanager.FeeRecipient],
+		error,
+	)
+}
+
+// FeeRecipientSetter is the interface for setting validator fee recipients.
+type FeeRecipientSetter interface {
+	// SetFeeRecipient sets the fee recipient for a validator.
+	SetFeeRecipient(ctx context.Context,
+		opts *api.SetValidatorFeeRecipientOpts,
+	) error
+}
+
+// FeeRecipientDeleter is the interface for deleting validator fee recipients.
+type FeeRecipientDeleter interface {
+	// DeleteFeeRecipient deletes the fee recipient for a validator, reverting it to the default.
+	DeleteFeeRecipient(ctx context.Context,
+		opts *api.ValidatorFeeRecipientOpts,
+	) error
+}
+
+// GasLimitProvider is the interface for providing validator gas limits.
+type GasLimitProvider interface {
+	// GasLimit provides the gas limit for a validator.
+	GasLimit(ctx context.Context,
+		opts *api.ValidatorGasLimitOpts,
+	) (
+		*api.Response[*apikeymanager.GasLimit],
+		error,
+	)
+}
+
+// GasLimitSetter is the interface for setting validator gas limits.
+type GasLimitSetter interface {
+	// SetGasLimit sets the gas limit for a validator.
+	SetGasLimit(ctx context.Context,
+		opts *api.SetValidatorGasLimitOpts,
+	) error
+}
+
+// GasLimitDeleter is the interface for deleting validator gas limits.
+type GasLimitDeleter interface {
+	// DeleteGasLimit deletes the gas limit for a validator, reverting it to the default.
+	DeleteGasLimit(ctx context.Context,
+		opts *api.ValidatorGasLimitOpts,
+	) error
+}
+
+// GraffitiProvider is the interface for providing validator graffiti.
+type GraffitiProvider interface {
+	// Graffiti provides the graffiti for a validator.
+	Graffiti(ctx context.Context,
+		opts *api.ValidatorGraffitiOpts,
+	) (
+		*api.Response[*apikeymanager.Graffiti],
+		error,
+	)
+}
+
+// GraffitiSetter is the interface for setting validator graffiti.
+type GraffitiSetter interface {
+	// SetGraffiti sets the graffiti for a validator.
+	SetGraffiti(ctx context.Context,
+		opts *api.SetValidatorGraffitiOpts,
+	) error
+}
+
+// GraffitiDeleter is the interface for deleting validator graffiti.
+type GraffitiDeleter interface {
+	// DeleteGraffiti deletes the graffiti for a validator, reverting it to the default.
+	DeleteGraffiti(ctx context.Context,
+		opts *api.ValidatorGraffitiOpts,
+	) error
+}
+
+// VoluntaryExitSigner is the interface for signing voluntary exits.
+type VoluntaryExitSigner interface {
+	// SignVoluntaryExit has the validator client sign a voluntary exit for a validator.
+	// The exit is returned but not submitted to the network.
+	SignVoluntaryExit(ctx context.Context,
+		opts *api.SignVoluntaryExitOpts,
+	) (
+		*api.Response[*phase0.SignedVoluntaryExit],
+		error,
+	)
+}
@@ -0,0 +1,38 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+// BlockAttestations fetches the attestations included in a block given a set of options.
+func (s *Service) BlockAttestations(ctx context.Context,
+	opts *api.BlockAttestationsOpts,
+) (
+	*api.Response[[]*spec.VersionedAttestation],
+	error,
+) {
+	if s.BlockAttestationsFunc != nil {
+		return s.BlockAttestationsFunc(ctx, opts)
+	}
+
+	return &api.Response[[]*spec.VersionedAttestation]{
+		Data:     make([]*spec.VersionedAttestation, 0),
+		Metadata: make(map[string]any),
+	}, nil
+}
@@ -15,15 +15,49 @@ package mock
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"github.com/attestantio/go-eth2-client/api"
 )
 
+// eventStream is a mock event stream, which is always connected until closed.
+type eventStream struct {
+	mu     sync.RWMutex
+	status api.EventStreamStatus
+}
+
+// Status returns the current status of the stream.
+func (e *eventStream) Status() *api.EventStreamStatus {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	status := e.status
+
+	return &status
+}
+
+// Close closes the stream.
+func (e *eventStream) Close() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.status.State != api.EventStreamStateClosed {
+		e.status.State = api.EventStreamStateClosed
+		e.status.Since = time.Now()
+	}
+}
+
 // Events feeds requested events with the given topics to the supplied handler.
-func (s *Service) Events(ctx context.Context, opts *api.EventsOpts) error {
+func (s *Service) Events(ctx context.Context, opts *api.EventsOpts) (api.EventStream, error) {
 	if s.EventsFunc != nil {
 		return s.EventsFunc(ctx, opts)
 	}
 
-	return nil
+	return &eventStream{
+		status: api.EventStreamStatus{
+			State: api.EventStreamStateConnected,
+			Since: time.Now(),
+		},
+	}, nil
 }
@@ -0,0 +1,49 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// LightClientBootstrap provides the light client bootstrap for a given block root.
+func (s *Service) LightClientBootstrap(ctx context.Context,
+	opts *api.LightClientBootstrapOpts,
+) (
+	*api.Response[*spec.VersionedLightClientBootstrap],
+	error,
+) {
+	if s.LightClientBootstrapFunc != nil {
+		return s.LightClientBootstrapFunc(ctx, opts)
+	}
+
+	return &api.Response[*spec.VersionedLightClientBootstrap]{
+		Data: &spec.VersionedLightClientBootstrap{
+			Version: spec.DataVersionAltair,
+			Altair: &altair.LightClientBootstrap{
+				Header: &altair.LightClientHeader{
+					Beacon: &phase0.BeaconBlockHeader{},
+				},
+				CurrentSyncCommittee:       &altair.SyncCommittee{},
+				CurrentSyncCommitteeBranch: make([]phase0.Root, 5),
+			},
+		},
+		Metadata: make(map[string]any),
+	}, nil
+}
@@ -0,0 +1,42 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// NodePeer provides information about a single peer of the node.
+func (s *Service) NodePeer(ctx context.Context,
+	opts *api.NodePeerOpts,
+) (
+	*api.Response[*apiv1.Peer],
+	error,
+) {
+	if s.NodePeerFunc != nil {
+		return s.NodePeerFunc(ctx, opts)
+	}
+
+	return &api.Response[*apiv1.Peer]{
+		Data: &apiv1.Peer{
+			PeerID:    opts.PeerID,
+			State:     "connected",
+			Direction: "outbound",
+		},
+		Metadata: make(map[string]any),
+	}, nil
+}
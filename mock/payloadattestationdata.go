@@ -0,0 +1,40 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/gloas"
+)
+
+// PayloadAttestationData fetches the payload attestation data for the given options.
+func (s *Service) PayloadAttestationData(ctx context.Context,
+	opts *api.PayloadAttestationDataOpts,
+) (
+	*api.Response[*gloas.PayloadAttestationData],
+	error,
+) {
+	if s.PayloadAttestationDataFunc != nil {
+		return s.PayloadAttestationDataFunc(ctx, opts)
+	}
+
+	return &api.Response[*gloas.PayloadAttestationData]{
+		Data: &gloas.PayloadAttestationData{
+			Slot: opts.Slot,
+		},
+		Metadata: make(map[string]any),
+	}, nil
+}
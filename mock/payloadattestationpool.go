@@ -0,0 +1,38 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/gloas"
+)
+
+// PayloadAttestationPool fetches the payload attestation pool for the given options.
+func (s *Service) PayloadAttestationPool(ctx context.Context,
+	opts *api.PayloadAttestationPoolOpts,
+) (
+	*api.Response[[]*gloas.PayloadAttestation],
+	error,
+) {
+	if s.PayloadAttestationPoolFunc != nil {
+		return s.PayloadAttestationPoolFunc(ctx, opts)
+	}
+
+	return &api.Response[[]*gloas.PayloadAttestation]{
+		Data:     []*gloas.PayloadAttestation{},
+		Metadata: make(map[string]any),
+	}, nil
+}
@@ -0,0 +1,38 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// ProposerLookahead obtains the proposer lookahead for the given options.
+func (s *Service) ProposerLookahead(ctx context.Context,
+	opts *api.ProposerLookaheadOpts,
+) (
+	*api.Response[[]phase0.ValidatorIndex],
+	error,
+) {
+	if s.ProposerLookaheadFunc != nil {
+		return s.ProposerLookaheadFunc(ctx, opts)
+	}
+
+	return &api.Response[[]phase0.ValidatorIndex]{
+		Data:     []phase0.ValidatorIndex{},
+		Metadata: make(map[string]any),
+	}, nil
+}
@@ -15,11 +15,11 @@ package multi
 
 import (
 	"context"
-	"strings"
 	"time"
 
 	consensusclient "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/http"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
@@ -174,10 +174,12 @@ func (s *Service) doCall(ctx context.Context, call callFunc, errHandler errHandl
 		res any
 	)
 
-	for _, client := range activeClients {
+	for _, client := range s.strategy.Order(activeClients) {
 		log := log.With().Str("client", client.Name()).Str("address", client.Address()).Logger()
 
+		started := time.Now()
 		res, err = call(ctx, client)
+		s.observeCall(ctx, client, time.Since(started), err)
 		if err != nil {
 			log.Trace().Err(err).Msg("Potentially deactivating client due to error")
 
@@ -226,33 +228,57 @@ func (s *Service) doCall(ctx context.Context, call callFunc, errHandler errHandl
 	return nil, err
 }
 
+// observeCall records the result of a call to a client with the metrics and the strategy.
+func (s *Service) observeCall(ctx context.Context, client consensusclient.Service, latency time.Duration, err error) {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		// The client has not failed, so we have nothing to record.
+		return
+	}
+
+	var apiErr *api.Error
+	if errors.As(err, &apiErr) && statusCodeFamily(apiErr.StatusCode) == 4 {
+		// The client responded correctly to a bad request.
+		err = nil
+	}
+
+	s.setProviderLatencyMetric(ctx, client.Address(), latency)
+	if err != nil {
+		s.incProviderErrorsMetric(ctx, client.Address())
+	}
+	s.strategy.Observe(client, latency, err)
+}
+
 // providerInfo returns information on the provider.
 // Currently this just returns the name of the service (lighthouse/teku/etc.).
 func (*Service) providerInfo(ctx context.Context, provider consensusclient.Service) string {
-	providerName := "<unknown>"
+	var nodeVersion *apiv1.NodeVersion
 
-	nodeVersionProvider, isNodeVersionProvider := provider.(consensusclient.NodeVersionProvider)
-	if isNodeVersionProvider {
-		response, err := nodeVersionProvider.NodeVersion(ctx, &api.NodeVersionOpts{})
+	if nodeVersionDetailsProvider, isProvider := provider.(consensusclient.NodeVersionDetailsProvider); isProvider {
+		response, err := nodeVersionDetailsProvider.NodeVersionDetails(ctx, &api.NodeVersionOpts{})
 		if err == nil {
-			switch {
-			case strings.Contains(strings.ToLower(response.Data), "lighthouse"):
-				providerName = "lighthouse"
-			case strings.Contains(strings.ToLower(response.Data), "lodestar"):
-				providerName = "lodestar"
-			case strings.Contains(strings.ToLower(response.Data), "prysm"):
-				providerName = "prysm"
-			case strings.Contains(strings.ToLower(response.Data), "teku"):
-				providerName = "teku"
-			case strings.Contains(strings.ToLower(response.Data), "nimbus"):
-				providerName = "nimbus"
-			default:
-				// Unknown provider, keep as "<unknown>"
+			nodeVersion = response.Data
+		}
+	}
+
+	if nodeVersion == nil {
+		if nodeVersionProvider, isProvider := provider.(consensusclient.NodeVersionProvider); isProvider {
+			response, err := nodeVersionProvider.NodeVersion(ctx, &api.NodeVersionOpts{})
+			if err == nil {
+				nodeVersion = apiv1.ParseNodeVersion(response.Data)
 			}
 		}
 	}
 
-	return providerName
+	if nodeVersion == nil || nodeVersion.BeaconNode == nil {
+		return "<unknown>"
+	}
+
+	switch client := nodeVersion.BeaconNode.Client(); client {
+	case "grandine", "lighthouse", "lodestar", "nimbus", "prysm", "teku":
+		return client
+	default:
+		return "<unknown>"
+	}
 }
 
 func statusCodeFamily(status int) int {
@@ -0,0 +1,148 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// eventDedupWindow is the minimum time for which an event is remembered
+// by the deduplicator.
+const eventDedupWindow = time.Minute
+
+// hashTreeRooter is implemented by events that are consensus objects.
+type hashTreeRooter interface {
+	HashTreeRoot() ([32]byte, error)
+}
+
+// eventArrival records the arrival of an event from one or more clients.
+type eventArrival struct {
+	first   time.Time
+	servers map[string]struct{}
+}
+
+// eventDeduplicator tracks events arriving from multiple clients, allowing
+// only the first arrival of each event to be forwarded.
+type eventDeduplicator struct {
+	s        *Service
+	window   time.Duration
+	mu       sync.Mutex
+	current  map[string]*eventArrival
+	previous map[string]*eventArrival
+	rotated  time.Time
+}
+
+// newEventDeduplicator creates a new event deduplicator.
+func newEventDeduplicator(s *Service, window time.Duration) *eventDeduplicator {
+	return &eventDeduplicator{
+		s:        s,
+		window:   window,
+		current:  make(map[string]*eventArrival),
+		previous: make(map[string]*eventArrival),
+		rotated:  time.Now(),
+	}
+}
+
+// firstArrival returns true if this is the first time that the event has
+// been seen from any client.  The delay between the first arrival of the
+// event and its arrival from this client is recorded.
+func (d *eventDeduplicator) firstArrival(server string, topic string, data any) bool {
+	key, err := eventKey(topic, data)
+	if err != nil {
+		// Unable to identify the event, so forward it.
+		d.s.log.Debug().Str("topic", topic).Err(err).Msg("Failed to obtain event key")
+
+		return true
+	}
+
+	now := time.Now()
+
+	d.mu.Lock()
+	if now.Sub(d.rotated) > d.window {
+		d.previous = d.current
+		d.current = make(map[string]*eventArrival)
+		d.rotated = now
+	}
+
+	arrival, exists := d.current[key]
+	if !exists {
+		arrival, exists = d.previous[key]
+		if exists {
+			d.current[key] = arrival
+		}
+	}
+	if !exists {
+		d.current[key] = &eventArrival{
+			first: now,
+			servers: map[string]struct{}{
+				server: {},
+			},
+		}
+		d.mu.Unlock()
+		d.s.monitorEventArrival(server, topic, 0)
+
+		return true
+	}
+
+	_, seen := arrival.servers[server]
+	arrival.servers[server] = struct{}{}
+	delay := now.Sub(arrival.first)
+	d.mu.Unlock()
+
+	if !seen {
+		d.s.monitorEventArrival(server, topic, delay)
+	}
+
+	return false
+}
+
+// eventKey returns a key identifying the content of the event.
+func eventKey(topic string, data any) (string, error) {
+	switch event := data.(type) {
+	case *apiv1.HeadEvent:
+		return fmt.Sprintf("%s:%#x", topic, event.Block), nil
+	case *apiv1.BlockEvent:
+		return fmt.Sprintf("%s:%#x", topic, event.Block), nil
+	case *apiv1.BlockGossipEvent:
+		return fmt.Sprintf("%s:%#x", topic, event.Block), nil
+	case *apiv1.FinalizedCheckpointEvent:
+		return fmt.Sprintf("%s:%d:%#x", topic, event.Epoch, event.Block), nil
+	case *apiv1.ChainReorgEvent:
+		return fmt.Sprintf("%s:%d:%#x:%#x", topic, event.Slot, event.OldHeadBlock, event.NewHeadBlock), nil
+	case *apiv1.BlobSidecarEvent:
+		return fmt.Sprintf("%s:%#x:%d", topic, event.BlockRoot, event.Index), nil
+	case *apiv1.DataColumnSidecarEvent:
+		return fmt.Sprintf("%s:%#x:%d", topic, event.BlockRoot, event.Index), nil
+	case hashTreeRooter:
+		root, err := event.HashTreeRoot()
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("%s:%#x", topic, root), nil
+	default:
+		data, err := json.Marshal(event)
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("%s:%#x", topic, sha256.Sum256(data)), nil
+	}
+}
@@ -31,11 +31,20 @@ import (
 )
 
 // Events feeds requested events with the given topics to the supplied handler.
+// The connected handler is called when the first client's stream connects, and the
+// disconnected handler when the last connected client's stream disconnects.  The
+// reconnecting handler is called for each client's attempt to reconnect whilst no
+// client's stream is connected.
+// Handlers are called one at a time, although events from different clients may be
+// handled by different goroutines.
 func (s *Service) Events(ctx context.Context,
 	opts *api.EventsOpts,
-) error {
+) (
+	api.EventStream,
+	error,
+) {
 	if opts == nil {
-		return consensusclient.ErrNoOptions
+		return nil, consensusclient.ErrNoOptions
 	}
 
 	// #nosec G404
@@ -43,6 +52,11 @@ func (s *Service) Events(ctx context.Context,
 
 	// Because events are streams we treat them differently from all other calls.
 	// We listen to all active clients, and only pass along events from the currently active provider.
+	// If fan-in is enabled we instead pass along each event from whichever client supplies it first.
+	var dedup *eventDeduplicator
+	if s.eventsFanIn {
+		dedup = newEventDeduplicator(s, eventDedupWindow)
+	}
 
 	// Grab local copy of both active and inactive clients in case it is updated whilst we are using it.
 	s.clientsMu.RLock()
@@ -50,35 +64,27 @@ func (s *Service) Events(ctx context.Context,
 	inactiveClients := s.inactiveClients
 	s.clientsMu.RUnlock()
 
+	ctx, cancel := context.WithCancel(ctx)
+	stream := newEventStream(cancel)
+
 	// Call all active clients immediately.
 	for _, client := range activeClients {
 		ah := &activeHandler{
 			s:       s,
 			log:     log.With().Logger(),
 			address: client.Address(),
-			opts: &api.EventsOpts{
-				Common: opts.Common,
-			},
+			opts:    opts,
+			dedup:   dedup,
+			stream:  stream,
 		}
-		ah.opts.Handler = ah.genericHandler
-		ah.opts.AttestationHandler = ah.attestationHandler
-		ah.opts.AttesterSlashingHandler = ah.attesterSlashingHandler
-		ah.opts.BlobSidecarHandler = ah.blobSidecarHandler
-		ah.opts.BLSToExecutionChangeHandler = ah.blsToExecutionChangeHandler
-		ah.opts.ChainReorgHandler = ah.chainReorgHandler
-		ah.opts.ContributionAndProofHandler = ah.contributionAndProofHandler
-		ah.opts.FinalizedCheckpointHandler = ah.finalizedCheckpointHandler
-		ah.opts.HeadHandler = ah.headHandler
-		ah.opts.PayloadAttributesHandler = ah.payloadAttributesHandler
-		ah.opts.ProposerSlashingHandler = ah.proposerSlashingHandler
-		ah.opts.SingleAttestationHandler = ah.singleAttestationHandler
-		ah.opts.VoluntaryExitHandler = ah.voluntaryExitHandler
-
-		if err := client.(consensusclient.EventsProvider).Events(ctx, ah.opts); err != nil {
+
+		clientStream, err := client.(consensusclient.EventsProvider).Events(ctx, ah.clientOpts())
+		if err != nil {
 			inactiveClients = append(inactiveClients, client)
 
 			continue
 		}
+		stream.add(clientStream)
 
 		log.Trace().Str("address", ah.address).Strs("topics", opts.Topics).Msg("Events handler active")
 	}
@@ -90,6 +96,8 @@ func (s *Service) Events(ctx context.Context,
 			log:     log.With().Logger(),
 			address: inactiveClient.Address(),
 			opts:    opts,
+			dedup:   dedup,
+			stream:  stream,
 		}
 		go func(c consensusclient.Service, ah *activeHandler) {
 			for {
@@ -116,46 +124,185 @@ func (s *Service) Events(ctx context.Context,
 
 				if !syncResponse.Data.IsSyncing {
 					// Client is now synced, set up the events call.
-					if err := c.(consensusclient.EventsProvider).Events(ctx, opts); err != nil {
+					clientStream, err := c.(consensusclient.EventsProvider).Events(ctx, ah.clientOpts())
+					if err != nil {
 						ah.log.Error().
 							Str("address", ah.address).
 							Strs("topics", opts.Topics).
 							Err(err).
 							Msg("Failed to set up events handler")
+					} else {
+						stream.add(clientStream)
 					}
 
 					// Return either way.
 					return
 				}
 
-				time.Sleep(5 * time.Second)
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(5 * time.Second):
+				}
 			}
 		}(inactiveClient, ah)
 	}
 
-	return nil
+	return stream, nil
 }
 
+// activeHandler filters the events from a single client, forwarding those
+// from the currently active provider, or those arriving first if fan-in is
+// enabled, to the handlers in opts.
 type activeHandler struct {
 	s       *Service
 	log     zerolog.Logger
 	address string
 	opts    *api.EventsOpts
+	dedup   *eventDeduplicator
+	stream  *eventStream
 }
 
-func (h *activeHandler) attestationHandler(ctx context.Context, data *spec.VersionedAttestation) {
-	log := h.log.With().Str("address", h.address).Logger()
-	log.Trace().Msg("Attestation event received")
+// clientOpts returns the options for the client's events call, with a
+// handler in place for each handler supplied by the caller.
+func (h *activeHandler) clientOpts() *api.EventsOpts {
+	opts := &api.EventsOpts{
+		Common:              h.opts.Common,
+		Topics:              h.opts.Topics,
+		ReconnectBackoff:    h.opts.ReconnectBackoff,
+		MaxReconnectBackoff: h.opts.MaxReconnectBackoff,
+		Backfill:            h.opts.Backfill,
+		MaxBackfillBlocks:   h.opts.MaxBackfillBlocks,
+	}
+
+	if h.opts.ConnectedHandler != nil || h.opts.DisconnectedHandler != nil || h.opts.ReconnectingHandler != nil {
+		// Lifecycle handlers are called on changes to the combined state of the clients' streams.
+		opts.ConnectedHandler = h.connectedHandler
+		opts.DisconnectedHandler = h.disconnectedHandler
+		opts.ReconnectingHandler = h.reconnectingHandler
+	}
+
+	if h.opts.Handler != nil {
+		opts.Handler = h.genericHandler
+	}
+	if h.opts.AttestationHandler != nil {
+		opts.AttestationHandler = h.attestationHandler
+	}
+	if h.opts.AttesterSlashingHandler != nil {
+		opts.AttesterSlashingHandler = h.attesterSlashingHandler
+	}
+	if h.opts.BlobSidecarHandler != nil {
+		opts.BlobSidecarHandler = h.blobSidecarHandler
+	}
+	if h.opts.BlockHandler != nil {
+		opts.BlockHandler = h.blockHandler
+	}
+	if h.opts.BlockGossipHandler != nil {
+		opts.BlockGossipHandler = h.blockGossipHandler
+	}
+	if h.opts.BLSToExecutionChangeHandler != nil {
+		opts.BLSToExecutionChangeHandler = h.blsToExecutionChangeHandler
+	}
+	if h.opts.ChainReorgHandler != nil {
+		opts.ChainReorgHandler = h.chainReorgHandler
+	}
+	if h.opts.ContributionAndProofHandler != nil {
+		opts.ContributionAndProofHandler = h.contributionAndProofHandler
+	}
+	if h.opts.DataColumnSidecarHandler != nil {
+		opts.DataColumnSidecarHandler = h.dataColumnSidecarHandler
+	}
+	if h.opts.FinalizedCheckpointHandler != nil {
+		opts.FinalizedCheckpointHandler = h.finalizedCheckpointHandler
+	}
+	if h.opts.HeadHandler != nil {
+		opts.HeadHandler = h.headHandler
+	}
+	if h.opts.LightClientFinalityUpdateHandler != nil {
+		opts.LightClientFinalityUpdateHandler = h.lightClientFinalityUpdateHandler
+	}
+	if h.opts.LightClientOptimisticUpdateHandler != nil {
+		opts.LightClientOptimisticUpdateHandler = h.lightClientOptimisticUpdateHandler
+	}
+	if h.opts.PayloadAttributesHandler != nil {
+		opts.PayloadAttributesHandler = h.payloadAttributesHandler
+	}
+	if h.opts.ProposerSlashingHandler != nil {
+		opts.ProposerSlashingHandler = h.proposerSlashingHandler
+	}
+	if h.opts.SingleAttestationHandler != nil {
+		opts.SingleAttestationHandler = h.singleAttestationHandler
+	}
+	if h.opts.VoluntaryExitHandler != nil {
+		opts.VoluntaryExitHandler = h.voluntaryExitHandler
+	}
+
+	return opts
+}
+
+// connectedHandler calls the connected handler when the first client's stream connects.
+func (h *activeHandler) connectedHandler(ctx context.Context) {
+	if h.stream.setClientConnected(h.address) && h.opts.ConnectedHandler != nil {
+		h.stream.handlerMu.Lock()
+		defer h.stream.handlerMu.Unlock()
+		h.opts.ConnectedHandler(ctx)
+	}
+}
+
+// disconnectedHandler calls the disconnected handler when the last connected client's stream disconnects.
+func (h *activeHandler) disconnectedHandler(ctx context.Context, err error) {
+	if h.stream.setClientDisconnected(h.address) && h.opts.DisconnectedHandler != nil {
+		h.stream.handlerMu.Lock()
+		defer h.stream.handlerMu.Unlock()
+		h.opts.DisconnectedHandler(ctx, err)
+	}
+}
+
+// reconnectingHandler calls the reconnecting handler for client streams attempting to
+// reconnect whilst no client's stream is connected.
+func (h *activeHandler) reconnectingHandler(ctx context.Context, attempt int, delay time.Duration) {
+	if !h.stream.isConnected() && h.opts.ReconnectingHandler != nil {
+		h.stream.handlerMu.Lock()
+		defer h.stream.handlerMu.Unlock()
+		h.opts.ReconnectingHandler(ctx, attempt, delay)
+	}
+}
+
+// shouldForward returns true if the event should be forwarded to the caller.
+func (h *activeHandler) shouldForward(log zerolog.Logger, topic string, data any) bool {
+	if h.dedup != nil {
+		// Events are forwarded from whichever client reports them first.
+		if !h.dedup.firstArrival(h.address, topic, data) {
+			return false
+		}
+
+		log.Trace().Msg("Forwarding due to first arrival")
+
+		return true
+	}
 
 	// We only forward events from the currently active provider.  If we did not do this then we could end up with
 	// inconsistent results, for example a client may receive a `head` event and a subsequent call to fetch the head
 	// block end up with an earlier block.
 	if h.s.Address() != h.address {
-		return
+		return false
 	}
 
 	log.Trace().Msg("Forwarding due to primary active address")
 
+	return true
+}
+
+func (h *activeHandler) attestationHandler(ctx context.Context, data *spec.VersionedAttestation) {
+	log := h.log.With().Str("address", h.address).Logger()
+	log.Trace().Msg("Attestation event received")
+
+	if !h.shouldForward(log, "attestation", data) {
+		return
+	}
+
+	h.stream.handlerMu.Lock()
+	defer h.stream.handlerMu.Unlock()
 	h.opts.AttestationHandler(ctx, data)
 }
 
@@ -163,15 +310,12 @@ func (h *activeHandler) attesterSlashingHandler(ctx context.Context, data *elect
 	log := h.log.With().Str("address", h.address).Logger()
 	log.Trace().Msg("Attester slashing event received")
 
-	// We only forward events from the currently active provider.  If we did not do this then we could end up with
-	// inconsistent results, for example a client may receive a `head` event and a subsequent call to fetch the head
-	// block end up with an earlier block.
-	if h.s.Address() != h.address {
+	if !h.shouldForward(log, "attester_slashing", data) {
 		return
 	}
 
-	log.Trace().Msg("Forwarding due to primary active address")
-
+	h.stream.handlerMu.Lock()
+	defer h.stream.handlerMu.Unlock()
 	h.opts.AttesterSlashingHandler(ctx, data)
 }
 
@@ -179,31 +323,51 @@ func (h *activeHandler) blobSidecarHandler(ctx context.Context, data *apiv1.Blob
 	log := h.log.With().Str("address", h.address).Logger()
 	log.Trace().Msg("Blob sidecar event received")
 
-	// We only forward events from the currently active provider.  If we did not do this then we could end up with
-	// inconsistent results, for example a client may receive a `head` event and a subsequent call to fetch the head
-	// block end up with an earlier block.
-	if h.s.Address() != h.address {
+	if !h.shouldForward(log, "blob_sidecar", data) {
 		return
 	}
 
-	log.Trace().Msg("Forwarding due to primary active address")
-
+	h.stream.handlerMu.Lock()
+	defer h.stream.handlerMu.Unlock()
 	h.opts.BlobSidecarHandler(ctx, data)
 }
 
+func (h *activeHandler) blockHandler(ctx context.Context, data *apiv1.BlockEvent) {
+	log := h.log.With().Str("address", h.address).Logger()
+	log.Trace().Msg("Block event received")
+
+	if !h.shouldForward(log, "block", data) {
+		return
+	}
+
+	h.stream.handlerMu.Lock()
+	defer h.stream.handlerMu.Unlock()
+	h.opts.BlockHandler(ctx, data)
+}
+
+func (h *activeHandler) blockGossipHandler(ctx context.Context, data *apiv1.BlockGossipEvent) {
+	log := h.log.With().Str("address", h.address).Logger()
+	log.Trace().Msg("Block gossip event received")
+
+	if !h.shouldForward(log, "block_gossip", data) {
+		return
+	}
+
+	h.stream.handlerMu.Lock()
+	defer h.stream.handlerMu.Unlock()
+	h.opts.BlockGossipHandler(ctx, data)
+}
+
 func (h *activeHandler) blsToExecutionChangeHandler(ctx context.Context, data *capella.SignedBLSToExecutionChange) {
 	log := h.log.With().Str("address", h.address).Logger()
 	log.Trace().Msg("BLS to execution change event received")
 
-	// We only forward events from the currently active provider.  If we did not do this then we could end up with
-	// inconsistent results, for example a client may receive a `head` event and a subsequent call to fetch the head
-	// block end up with an earlier block.
-	if h.s.Address() != h.address {
+	if !h.shouldForward(log, "bls_to_execution_change", data) {
 		return
 	}
 
-	log.Trace().Msg("Forwarding due to primary active address")
-
+	h.stream.handlerMu.Lock()
+	defer h.stream.handlerMu.Unlock()
 	h.opts.BLSToExecutionChangeHandler(ctx, data)
 }
 
@@ -211,47 +375,51 @@ func (h *activeHandler) chainReorgHandler(ctx context.Context, data *apiv1.Chain
 	log := h.log.With().Str("address", h.address).Logger()
 	log.Trace().Msg("Chain reorg event received")
 
-	// We only forward events from the currently active provider.  If we did not do this then we could end up with
-	// inconsistent results, for example a client may receive a `head` event and a subsequent call to fetch the head
-	// block end up with an earlier block.
-	if h.s.Address() != h.address {
+	if !h.shouldForward(log, "chain_reorg", data) {
 		return
 	}
 
-	log.Trace().Msg("Forwarding due to primary active address")
-
+	h.stream.handlerMu.Lock()
+	defer h.stream.handlerMu.Unlock()
 	h.opts.ChainReorgHandler(ctx, data)
 }
 
 func (h *activeHandler) contributionAndProofHandler(ctx context.Context, data *altair.SignedContributionAndProof) {
 	log := h.log.With().Str("address", h.address).Logger()
-	log.Trace().Msg("Chain reorg event received")
+	log.Trace().Msg("Contribution and proof event received")
 
-	// We only forward events from the currently active provider.  If we did not do this then we could end up with
-	// inconsistent results, for example a client may receive a `head` event and a subsequent call to fetch the head
-	// block end up with an earlier block.
-	if h.s.Address() != h.address {
+	if !h.shouldForward(log, "contribution_and_proof", data) {
 		return
 	}
 
-	log.Trace().Msg("Forwarding due to primary active address")
-
+	h.stream.handlerMu.Lock()
+	defer h.stream.handlerMu.Unlock()
 	h.opts.ContributionAndProofHandler(ctx, data)
 }
 
+func (h *activeHandler) dataColumnSidecarHandler(ctx context.Context, data *apiv1.DataColumnSidecarEvent) {
+	log := h.log.With().Str("address", h.address).Logger()
+	log.Trace().Msg("Data column sidecar event received")
+
+	if !h.shouldForward(log, "data_column_sidecar", data) {
+		return
+	}
+
+	h.stream.handlerMu.Lock()
+	defer h.stream.handlerMu.Unlock()
+	h.opts.DataColumnSidecarHandler(ctx, data)
+}
+
 func (h *activeHandler) finalizedCheckpointHandler(ctx context.Context, data *apiv1.FinalizedCheckpointEvent) {
 	log := h.log.With().Str("address", h.address).Logger()
 	log.Trace().Msg("Finalized checkpoint event received")
 
-	// We only forward events from the currently active provider.  If we did not do this then we could end up with
-	// inconsistent results, for example a client may receive a `head` event and a subsequent call to fetch the head
-	// block end up with an earlier block.
-	if h.s.Address() != h.address {
+	if !h.shouldForward(log, "finalized_checkpoint", data) {
 		return
 	}
 
-	log.Trace().Msg("Forwarding due to primary active address")
-
+	h.stream.handlerMu.Lock()
+	defer h.stream.handlerMu.Unlock()
 	h.opts.FinalizedCheckpointHandler(ctx, data)
 }
 
@@ -259,31 +427,51 @@ func (h *activeHandler) headHandler(ctx context.Context, data *apiv1.HeadEvent)
 	log := h.log.With().Str("address", h.address).Logger()
 	log.Trace().Msg("Head event received")
 
-	// We only forward events from the currently active provider.  If we did not do this then we could end up with
-	// inconsistent results, for example a client may receive a `head` event and a subsequent call to fetch the head
-	// block end up with an earlier block.
-	if h.s.Address() != h.address {
+	if !h.shouldForward(log, "head", data) {
 		return
 	}
 
-	log.Trace().Msg("Forwarding due to primary active address")
-
+	h.stream.handlerMu.Lock()
+	defer h.stream.handlerMu.Unlock()
 	h.opts.HeadHandler(ctx, data)
 }
 
+func (h *activeHandler) lightClientFinalityUpdateHandler(ctx context.Context, data *spec.VersionedLightClientFinalityUpdate) {
+	log := h.log.With().Str("address", h.address).Logger()
+	log.Trace().Msg("Light client finality update event received")
+
+	if !h.shouldForward(log, "light_client_finality_update", data) {
+		return
+	}
+
+	h.stream.handlerMu.Lock()
+	defer h.stream.handlerMu.Unlock()
+	h.opts.LightClientFinalityUpdateHandler(ctx, data)
+}
+
+func (h *activeHandler) lightClientOptimisticUpdateHandler(ctx context.Context, data *spec.VersionedLightClientOptimisticUpdate) {
+	log := h.log.With().Str("address", h.address).Logger()
+	log.Trace().Msg("Light client optimistic update event received")
+
+	if !h.shouldForward(log, "light_client_optimistic_update", data) {
+		return
+	}
+
+	h.stream.handlerMu.Lock()
+	defer h.stream.handlerMu.Unlock()
+	h.opts.LightClientOptimisticUpdateHandler(ctx, data)
+}
+
 func (h *activeHandler) payloadAttributesHandler(ctx context.Context, data *apiv1.PayloadAttributesEvent) {
 	log := h.log.With().Str("address", h.address).Logger()
 	log.Trace().Msg("Payload attributes event received")
 
-	// We only forward events from the currently active provider.  If we did not do this then we could end up with
-	// inconsistent results, for example a client may receive a `head` event and a subsequent call to fetch the head
-	// block end up with an earlier block.
-	if h.s.Address() != h.address {
+	if !h.shouldForward(log, "payload_attributes", data) {
 		return
 	}
 
-	log.Trace().Msg("Forwarding due to primary active address")
-
+	h.stream.handlerMu.Lock()
+	defer h.stream.handlerMu.Unlock()
 	h.opts.PayloadAttributesHandler(ctx, data)
 }
 
@@ -291,15 +479,12 @@ func (h *activeHandler) proposerSlashingHandler(ctx context.Context, data *phase
 	log := h.log.With().Str("address", h.address).Logger()
 	log.Trace().Msg("Proposer slashing event received")
 
-	// We only forward events from the currently active provider.  If we did not do this then we could end up with
-	// inconsistent results, for example a client may receive a `head` event and a subsequent call to fetch the head
-	// block end up with an earlier block.
-	if h.s.Address() != h.address {
+	if !h.shouldForward(log, "proposer_slashing", data) {
 		return
 	}
 
-	log.Trace().Msg("Forwarding due to primary active address")
-
+	h.stream.handlerMu.Lock()
+	defer h.stream.handlerMu.Unlock()
 	h.opts.ProposerSlashingHandler(ctx, data)
 }
 
@@ -307,15 +492,12 @@ func (h *activeHandler) singleAttestationHandler(ctx context.Context, data *elec
 	log := h.log.With().Str("address", h.address).Logger()
 	log.Trace().Msg("Single attestation event received")
 
-	// We only forward events from the currently active provider.  If we did not do this then we could end up with
-	// inconsistent results, for example a client may receive a `head` event and a subsequent call to fetch the head
-	// block end up with an earlier block.
-	if h.s.Address() != h.address {
+	if !h.shouldForward(log, "single_attestation", data) {
 		return
 	}
 
-	log.Trace().Msg("Forwarding due to primary active address")
-
+	h.stream.handlerMu.Lock()
+	defer h.stream.handlerMu.Unlock()
 	h.opts.SingleAttestationHandler(ctx, data)
 }
 
@@ -323,15 +505,12 @@ func (h *activeHandler) voluntaryExitHandler(ctx context.Context, data *phase0.S
 	log := h.log.With().Str("address", h.address).Logger()
 	log.Trace().Msg("Voluntary exit event received")
 
-	// We only forward events from the currently active provider.  If we did not do this then we could end up with
-	// inconsistent results, for example a client may receive a `head` event and a subsequent call to fetch the head
-	// block end up with an earlier block.
-	if h.s.Address() != h.address {
+	if !h.shouldForward(log, "voluntary_exit", data) {
 		return
 	}
 
-	log.Trace().Msg("Forwarding due to primary active address")
-
+	h.stream.handlerMu.Lock()
+	defer h.stream.handlerMu.Unlock()
 	h.opts.VoluntaryExitHandler(ctx, data)
 }
 
@@ -339,16 +518,11 @@ func (h *activeHandler) genericHandler(event *apiv1.Event) {
 	log := h.log.With().Str("address", h.address).Str("topic", event.Topic).Logger()
 	log.Trace().Msg("Event received")
 
-	// We only forward events from the currently active provider.  If we did not do this then we could end up with
-	// inconsistent results, for example a client may receive a `head` event and a subsequent call to fetch the head
-	// block end up with an earlier block.
-	if h.s.Address() != h.address {
+	if !h.shouldForward(log, event.Topic, event.Data) {
 		return
 	}
 
-	log.Trace().Msg("Forwarding due to primary active address")
-
-	if h.opts.Handler != nil {
-		h.opts.Handler(event)
-	}
+	h.stream.handlerMu.Lock()
+	defer h.stream.handlerMu.Unlock()
+	h.opts.Handler(event)
 }
@@ -15,14 +15,21 @@ package multi_test
 
 import (
 	"context"
+	"errors"
+	"sync"
 	"testing"
+	"time"
 
 	consensusclient "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/mock"
 	"github.com/attestantio/go-eth2-client/multi"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/attestantio/go-eth2-client/testclients"
 	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -50,7 +57,286 @@ func TestEvents(t *testing.T) {
 	)
 	require.NoError(t, err)
 
-	require.NoError(t, multiClient.(consensusclient.EventsProvider).Events(ctx, &api.EventsOpts{
+	stream, err := multiClient.(consensusclient.EventsProvider).Events(ctx, &api.EventsOpts{
 		Topics: []string{"block"},
-	}))
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, api.EventStreamStateClosed, stream.Status().State)
+	stream.Close()
+	require.Equal(t, api.EventStreamStateClosed, stream.Status().State)
+}
+
+func TestEventsForwarding(t *testing.T) {
+	ctx := context.Background()
+
+	clientOpts := make(map[string]*api.EventsOpts)
+	eventsFunc := func(name string) func(context.Context, *api.EventsOpts) (api.EventStream, error) {
+		return func(_ context.Context, opts *api.EventsOpts) (api.EventStream, error) {
+			clientOpts[name] = opts
+
+			return nil, nil
+		}
+	}
+
+	client1, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	client1.EventsFunc = eventsFunc("mock 1")
+	client2, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+	client2.EventsFunc = eventsFunc("mock 2")
+
+	multiClient, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]consensusclient.Service{
+			client1,
+			client2,
+		}),
+	)
+	require.NoError(t, err)
+
+	var received []phase0.Slot
+	_, err = multiClient.(consensusclient.EventsProvider).Events(ctx, &api.EventsOpts{
+		Topics: []string{"head"},
+		HeadHandler: func(_ context.Context, event *apiv1.HeadEvent) {
+			received = append(received, event.Slot)
+		},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, clientOpts, 2)
+	for _, opts := range clientOpts {
+		require.Equal(t, []string{"head"}, opts.Topics)
+		require.NotNil(t, opts.HeadHandler)
+		require.Nil(t, opts.Handler)
+		require.Nil(t, opts.BlockHandler)
+	}
+
+	// Only events from the active provider are forwarded.
+	clientOpts["mock 1"].HeadHandler(ctx, &apiv1.HeadEvent{Slot: 1})
+	clientOpts["mock 2"].HeadHandler(ctx, &apiv1.HeadEvent{Slot: 2})
+	require.Equal(t, []phase0.Slot{1}, received)
+}
+
+func TestEventsFanIn(t *testing.T) {
+	ctx := context.Background()
+
+	clientOpts := make(map[string]*api.EventsOpts)
+	eventsFunc := func(name string) func(context.Context, *api.EventsOpts) (api.EventStream, error) {
+		return func(_ context.Context, opts *api.EventsOpts) (api.EventStream, error) {
+			clientOpts[name] = opts
+
+			return nil, nil
+		}
+	}
+
+	client1, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	client1.EventsFunc = eventsFunc("mock 1")
+	client2, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+	client2.EventsFunc = eventsFunc("mock 2")
+
+	multiClient, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]consensusclient.Service{
+			client1,
+			client2,
+		}),
+		multi.WithEventsFanIn(true),
+	)
+	require.NoError(t, err)
+
+	var heads []phase0.Slot
+	var checkpoints []phase0.Epoch
+	var exits []phase0.ValidatorIndex
+	var topics []string
+	_, err = multiClient.(consensusclient.EventsProvider).Events(ctx, &api.EventsOpts{
+		Topics: []string{"head", "finalized_checkpoint", "voluntary_exit", "payload_attributes"},
+		HeadHandler: func(_ context.Context, event *apiv1.HeadEvent) {
+			heads = append(heads, event.Slot)
+		},
+		FinalizedCheckpointHandler: func(_ context.Context, event *apiv1.FinalizedCheckpointEvent) {
+			checkpoints = append(checkpoints, event.Epoch)
+		},
+		VoluntaryExitHandler: func(_ context.Context, event *phase0.SignedVoluntaryExit) {
+			exits = append(exits, event.Message.ValidatorIndex)
+		},
+		Handler: func(event *apiv1.Event) {
+			topics = append(topics, event.Topic)
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, clientOpts, 2)
+
+	// Head events are forwarded on first arrival from any client, and deduplicated by block root.
+	clientOpts["mock 2"].HeadHandler(ctx, &apiv1.HeadEvent{Slot: 1, Block: phase0.Root{0x01}})
+	clientOpts["mock 1"].HeadHandler(ctx, &apiv1.HeadEvent{Slot: 1, Block: phase0.Root{0x01}})
+	clientOpts["mock 1"].HeadHandler(ctx, &apiv1.HeadEvent{Slot: 2, Block: phase0.Root{0x02}})
+	clientOpts["mock 2"].HeadHandler(ctx, &apiv1.HeadEvent{Slot: 2, Block: phase0.Root{0x02}})
+	clientOpts["mock 2"].HeadHandler(ctx, &apiv1.HeadEvent{Slot: 2, Block: phase0.Root{0x03}})
+	require.Equal(t, []phase0.Slot{1, 2, 2}, heads)
+
+	// Finalized checkpoints are deduplicated by checkpoint.
+	clientOpts["mock 1"].FinalizedCheckpointHandler(ctx, &apiv1.FinalizedCheckpointEvent{Epoch: 1, Block: phase0.Root{0x01}})
+	clientOpts["mock 2"].FinalizedCheckpointHandler(ctx, &apiv1.FinalizedCheckpointEvent{Epoch: 1, Block: phase0.Root{0x01}})
+	clientOpts["mock 2"].FinalizedCheckpointHandler(ctx, &apiv1.FinalizedCheckpointEvent{Epoch: 2, Block: phase0.Root{0x01}})
+	require.Equal(t, []phase0.Epoch{1, 2}, checkpoints)
+
+	// Operations are deduplicated by hash tree root.
+	exit := func(index phase0.ValidatorIndex) *phase0.SignedVoluntaryExit {
+		return &phase0.SignedVoluntaryExit{
+			Message: &phase0.VoluntaryExit{
+				Epoch:          1,
+				ValidatorIndex: index,
+			},
+		}
+	}
+	clientOpts["mock 2"].VoluntaryExitHandler(ctx, exit(5))
+	clientOpts["mock 1"].VoluntaryExitHandler(ctx, exit(5))
+	clientOpts["mock 1"].VoluntaryExitHandler(ctx, exit(6))
+	require.Equal(t, []phase0.ValidatorIndex{5, 6}, exits)
+
+	// Events to the generic handler are deduplicated by content.
+	attributes := func(slot phase0.Slot) *apiv1.Event {
+		return &apiv1.Event{
+			Topic: "payload_attributes",
+			Data: &apiv1.PayloadAttributesEvent{
+				Version: spec.DataVersionBellatrix,
+				Data: &apiv1.PayloadAttributesData{
+					ProposalSlot: slot,
+					V1:           &apiv1.PayloadAttributesV1{},
+				},
+			},
+		}
+	}
+	clientOpts["mock 1"].Handler(attributes(1))
+	clientOpts["mock 2"].Handler(attributes(1))
+	clientOpts["mock 2"].Handler(attributes(2))
+	require.Equal(t, []string{"payload_attributes", "payload_attributes"}, topics)
+}
+
+func TestEventsLifecycle(t *testing.T) {
+	ctx := context.Background()
+
+	clientOpts := make(map[string]*api.EventsOpts)
+	eventsFunc := func(name string) func(context.Context, *api.EventsOpts) (api.EventStream, error) {
+		return func(_ context.Context, opts *api.EventsOpts) (api.EventStream, error) {
+			clientOpts[name] = opts
+
+			return nil, nil
+		}
+	}
+
+	client1, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	client1.EventsFunc = eventsFunc("mock 1")
+	client2, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+	client2.EventsFunc = eventsFunc("mock 2")
+
+	multiClient, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]consensusclient.Service{
+			client1,
+			client2,
+		}),
+	)
+	require.NoError(t, err)
+
+	var transitions []string
+	_, err = multiClient.(consensusclient.EventsProvider).Events(ctx, &api.EventsOpts{
+		Topics:      []string{"head"},
+		HeadHandler: func(context.Context, *apiv1.HeadEvent) {},
+		ConnectedHandler: func(context.Context) {
+			transitions = append(transitions, "connected")
+		},
+		DisconnectedHandler: func(context.Context, error) {
+			transitions = append(transitions, "disconnected")
+		},
+		ReconnectingHandler: func(context.Context, int, time.Duration) {
+			transitions = append(transitions, "reconnecting")
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, clientOpts, 2)
+
+	// Handlers are only called on changes to the combined state of the clients' streams.
+	clientOpts["mock 1"].ConnectedHandler(ctx)
+	clientOpts["mock 2"].ConnectedHandler(ctx)
+	require.Equal(t, []string{"connected"}, transitions)
+
+	clientOpts["mock 1"].DisconnectedHandler(ctx, errors.New("failed"))
+	clientOpts["mock 1"].ReconnectingHandler(ctx, 1, time.Second)
+	require.Equal(t, []string{"connected"}, transitions)
+
+	clientOpts["mock 2"].DisconnectedHandler(ctx, errors.New("failed"))
+	clientOpts["mock 2"].ReconnectingHandler(ctx, 1, time.Second)
+	require.Equal(t, []string{"connected", "disconnected", "reconnecting"}, transitions)
+
+	clientOpts["mock 1"].ConnectedHandler(ctx)
+	require.Equal(t, []string{"connected", "disconnected", "reconnecting", "connected"}, transitions)
+}
+
+func TestEventsFanInOneAtATime(t *testing.T) {
+	ctx := context.Background()
+
+	clientOpts := make(map[string]*api.EventsOpts)
+	eventsFunc := func(name string) func(context.Context, *api.EventsOpts) (api.EventStream, error) {
+		return func(_ context.Context, opts *api.EventsOpts) (api.EventStream, error) {
+			clientOpts[name] = opts
+
+			return nil, nil
+		}
+	}
+
+	client1, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	client1.EventsFunc = eventsFunc("mock 1")
+	client2, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+	client2.EventsFunc = eventsFunc("mock 2")
+
+	multiClient, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]consensusclient.Service{
+			client1,
+			client2,
+		}),
+		multi.WithEventsFanIn(true),
+	)
+	require.NoError(t, err)
+
+	// The handler is not safe for concurrent use; it relies on being called one at a time.
+	inHandler := false
+	heads := 0
+	_, err = multiClient.(consensusclient.EventsProvider).Events(ctx, &api.EventsOpts{
+		Topics: []string{"head"},
+		HeadHandler: func(_ context.Context, _ *apiv1.HeadEvent) {
+			assert.False(t, inHandler)
+			inHandler = true
+			time.Sleep(time.Millisecond)
+			heads++
+			inHandler = false
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, clientOpts, 2)
+
+	// Each client reports different blocks at the same time from its own goroutine.
+	var wg sync.WaitGroup
+	for i, name := range []string{"mock 1", "mock 2"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for slot := range 10 {
+				clientOpts[name].HeadHandler(ctx, &apiv1.HeadEvent{
+					Slot:  phase0.Slot(slot),
+					Block: phase0.Root{byte(i), byte(slot)},
+				})
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, 20, heads)
 }
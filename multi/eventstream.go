@@ -0,0 +1,176 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// eventStream is the handle for the event streams of the underlying clients.
+type eventStream struct {
+	cancel  context.CancelFunc
+	created time.Time
+
+	mu       sync.Mutex
+	streams  []api.EventStream
+	closed   bool
+	closedAt time.Time
+
+	// connected are the addresses of the clients whose streams are connected.
+	connected map[string]struct{}
+
+	// handlerMu ensures that the caller's handlers are called one at a time.
+	handlerMu sync.Mutex
+}
+
+func newEventStream(cancel context.CancelFunc) *eventStream {
+	return &eventStream{
+		cancel:    cancel,
+		created:   time.Now(),
+		connected: make(map[string]struct{}),
+	}
+}
+
+// add adds the stream of an underlying client.
+func (e *eventStream) add(stream api.EventStream) {
+	if stream == nil {
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.closed {
+		stream.Close()
+
+		return
+	}
+
+	e.streams = append(e.streams, stream)
+}
+
+// setClientConnected records that the client's stream is connected, returning
+// true if it is the only connected stream.
+func (e *eventStream) setClientConnected(address string) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.connected[address] = struct{}{}
+
+	return len(e.connected) == 1
+}
+
+// setClientDisconnected records that the client's stream is disconnected,
+// returning true if it was the last connected stream.
+func (e *eventStream) setClientDisconnected(address string) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if _, exists := e.connected[address]; !exists {
+		return false
+	}
+	delete(e.connected, address)
+
+	return len(e.connected) == 0
+}
+
+// isConnected returns true if any client's stream is connected.
+func (e *eventStream) isConnected() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return len(e.connected) > 0
+}
+
+// Status returns the combined status of the underlying streams.
+// The stream is connected if any underlying stream is connected.
+func (e *eventStream) Status() *api.EventStreamStatus {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.closed {
+		return &api.EventStreamStatus{
+			State: api.EventStreamStateClosed,
+			Since: e.closedAt,
+		}
+	}
+
+	status := &api.EventStreamStatus{
+		State: api.EventStreamStateConnecting,
+		Since: e.created,
+	}
+
+	var lastErrorSince time.Time
+	for _, stream := range e.streams {
+		streamStatus := stream.Status()
+		status.Reconnects += streamStatus.Reconnects
+
+		if streamStatus.LastEventTime.After(status.LastEventTime) {
+			status.LastEventTime = streamStatus.LastEventTime
+			status.LastEventID = streamStatus.LastEventID
+		}
+
+		if streamStatus.LastError != nil && streamStatus.Since.After(lastErrorSince) {
+			status.LastError = streamStatus.LastError
+			lastErrorSince = streamStatus.Since
+		}
+
+		switch {
+		case streamStatus.State == api.EventStreamStateClosed:
+			// Closed streams do not contribute to the state.
+		case stateRank(streamStatus.State) > stateRank(status.State):
+			status.State = streamStatus.State
+			status.Since = streamStatus.Since
+		case streamStatus.State == status.State && streamStatus.Since.Before(status.Since):
+			status.Since = streamStatus.Since
+		}
+	}
+
+	return status
+}
+
+// Close closes all underlying streams.
+func (e *eventStream) Close() {
+	e.cancel()
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.closed {
+		return
+	}
+
+	for _, stream := range e.streams {
+		stream.Close()
+	}
+	e.closed = true
+	e.closedAt = time.Now()
+}
+
+// stateRank ranks stream states, with higher ranks taking precedence
+// when combining the states of underlying streams.
+func stateRank(state api.EventStreamState) int {
+	switch state {
+	case api.EventStreamStateConnected:
+		return 2
+	case api.EventStreamStateReconnecting:
+		return 1
+	default:
+		return 0
+	}
+}
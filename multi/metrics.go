@@ -15,6 +15,7 @@ package multi
 
 import (
 	"context"
+	"time"
 
 	"github.com/attestantio/go-eth2-client/metrics"
 	"github.com/pkg/errors"
@@ -22,8 +23,12 @@ import (
 )
 
 var (
-	connectionsMetric *prometheus.GaugeVec
-	stateMetric       *prometheus.GaugeVec
+	connectionsMetric   *prometheus.GaugeVec
+	stateMetric         *prometheus.GaugeVec
+	eventsDroppedMetric *prometheus.CounterVec
+	eventArrivalMetric  *prometheus.HistogramVec
+	latencyMetric       *prometheus.HistogramVec
+	errorsMetric        *prometheus.CounterVec
 )
 
 func registerMetrics(ctx context.Context, monitor metrics.Service) error {
@@ -65,6 +70,48 @@ func registerPrometheusMetrics(_ context.Context) error {
 		return errors.Wrap(err, "failed to register connection_state")
 	}
 
+	latencyMetric = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "consensusclient",
+		Subsystem: "multi",
+		Name:      "request_duration_seconds",
+		Help:      "The time taken for each client to respond to a request",
+		Buckets:   []float64{0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+	}, []string{"name", "server"})
+	if err := prometheus.Register(latencyMetric); err != nil {
+		return errors.Wrap(err, "failed to register request_duration_seconds")
+	}
+
+	errorsMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "consensusclient",
+		Subsystem: "multi",
+		Name:      "request_errors_total",
+		Help:      "Number of failed requests for each client",
+	}, []string{"name", "server"})
+	if err := prometheus.Register(errorsMetric); err != nil {
+		return errors.Wrap(err, "failed to register request_errors_total")
+	}
+
+	eventsDroppedMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "consensusclient",
+		Subsystem: "multi",
+		Name:      "events_dropped_total",
+		Help:      "Number of events dropped due to a full subscription buffer",
+	}, []string{"name", "topic"})
+	if err := prometheus.Register(eventsDroppedMetric); err != nil {
+		return errors.Wrap(err, "failed to register events_dropped_total")
+	}
+
+	eventArrivalMetric = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "consensusclient",
+		Subsystem: "multi",
+		Name:      "event_arrival_delay_seconds",
+		Help:      "The delay between an event first arriving from any client and its arrival from each client",
+		Buckets:   []float64{0, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+	}, []string{"name", "server", "topic"})
+	if err := prometheus.Register(eventArrivalMetric); err != nil {
+		return errors.Wrap(err, "failed to register event_arrival_delay_seconds")
+	}
+
 	return nil
 }
 
@@ -85,6 +132,22 @@ func (s *Service) setProviderStateMetric(_ context.Context, server string, state
 	}
 }
 
+func (s *Service) setProviderLatencyMetric(_ context.Context, server string, latency time.Duration) {
+	if latencyMetric == nil {
+		return
+	}
+
+	latencyMetric.WithLabelValues(s.name, server).Observe(latency.Seconds())
+}
+
+func (s *Service) incProviderErrorsMetric(_ context.Context, server string) {
+	if errorsMetric == nil {
+		return
+	}
+
+	errorsMetric.WithLabelValues(s.name, server).Inc()
+}
+
 func (s *Service) setConnectionsMetric(_ context.Context, active int, inactive int) {
 	if connectionsMetric == nil {
 		return
@@ -93,3 +156,19 @@ func (s *Service) setConnectionsMetric(_ context.Context, active int, inactive i
 	connectionsMetric.WithLabelValues(s.name, "active").Set(float64(active))
 	connectionsMetric.WithLabelValues(s.name, "inactive").Set(float64(inactive))
 }
+
+func (s *Service) monitorEventDropped(topic string) {
+	if eventsDroppedMetric == nil {
+		return
+	}
+
+	eventsDroppedMetric.WithLabelValues(s.name, topic).Inc()
+}
+
+func (s *Service) monitorEventArrival(server string, topic string, delay time.Duration) {
+	if eventArrivalMetric == nil {
+		return
+	}
+
+	eventArrivalMetric.WithLabelValues(s.name, server, topic).Observe(delay.Seconds())
+}
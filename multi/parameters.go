@@ -32,6 +32,8 @@ type parameters struct {
 	enforceJSON       bool
 	allowDelayedStart bool
 	name              string
+	eventsFanIn       bool
+	strategy          Strategy
 }
 
 // Parameter is the interface for service parameters.
@@ -108,6 +110,24 @@ func WithName(name string) Parameter {
 	})
 }
 
+// WithEventsFanIn forwards events from all clients rather than just the active client.
+// Each event is forwarded once, as soon as the first client reports it, with later
+// reports of the same event from other clients discarded.  Handlers are still called
+// one at a time.
+func WithEventsFanIn(eventsFanIn bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.eventsFanIn = eventsFanIn
+	})
+}
+
+// WithStrategy sets the strategy for selecting the order in which active clients are tried for each call.
+// If not supplied then clients are tried in the order in which they are listed.
+func WithStrategy(strategy Strategy) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.strategy = strategy
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
@@ -122,6 +142,10 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 		}
 	}
 
+	if parameters.strategy == nil {
+		parameters.strategy = NewOrderedStrategy()
+	}
+
 	if len(parameters.addresses) > 0 && parameters.timeout == 0 {
 		return nil, errors.New("no timeout specified")
 	}
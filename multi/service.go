@@ -28,7 +28,9 @@ import (
 type Service struct {
 	log zerolog.Logger
 
-	name string
+	name        string
+	eventsFanIn bool
+	strategy    Strategy
 
 	clientsMu       sync.RWMutex
 	activeClients   []consensusclient.Service
@@ -104,6 +106,8 @@ func New(ctx context.Context, params ...Parameter) (consensusclient.Service, err
 	s := &Service{
 		log:             log,
 		name:            parameters.name,
+		eventsFanIn:     parameters.eventsFanIn,
+		strategy:        parameters.strategy,
 		activeClients:   activeClients,
 		inactiveClients: inactiveClients,
 	}
@@ -108,37 +108,62 @@ func TestInterfaces(t *testing.T) {
 	assert.Implements(t, (*client.AttestationRewardsProvider)(nil), s)
 	assert.Implements(t, (*client.AttestationsSubmitter)(nil), s)
 	assert.Implements(t, (*client.AttesterDutiesProvider)(nil), s)
+	assert.Implements(t, (*client.AttesterSlashingPoolProvider)(nil), s)
+	assert.Implements(t, (*client.BLSToExecutionChangePoolProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockHeadersProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockRootProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockSubmitter)(nil), s)
 	assert.Implements(t, (*client.BeaconCommitteeSubscriptionsSubmitter)(nil), s)
 	assert.Implements(t, (*client.BeaconCommitteeSelectionsProvider)(nil), s)
+	assert.Implements(t, (*client.BeaconHeadsProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconStateProvider)(nil), s)
 	assert.Implements(t, (*client.BlindedBeaconBlockSubmitter)(nil), s)
+	assert.Implements(t, (*client.BlockAttestationsProvider)(nil), s)
 	assert.Implements(t, (*client.BlockRewardsProvider)(nil), s)
 	assert.Implements(t, (*client.BlobsProvider)(nil), s)
 	assert.Implements(t, (*client.BlobSidecarsProvider)(nil), s)
 	assert.Implements(t, (*client.ValidatorRegistrationsSubmitter)(nil), s)
+	assert.Implements(t, (*client.DataColumnSidecarsProvider)(nil), s)
 	assert.Implements(t, (*client.DepositContractProvider)(nil), s)
+	assert.Implements(t, (*client.DepositSnapshotProvider)(nil), s)
+	assert.Implements(t, (*client.EventSubscriptionProvider)(nil), s)
 	assert.Implements(t, (*client.EventsProvider)(nil), s)
+	assert.Implements(t, (*client.ExecutionPayloadEnvelopeSubmitter)(nil), s)
 	assert.Implements(t, (*client.FinalityProvider)(nil), s)
 	assert.Implements(t, (*client.ForkChoiceProvider)(nil), s)
 	assert.Implements(t, (*client.ForkProvider)(nil), s)
 	assert.Implements(t, (*client.ForkScheduleProvider)(nil), s)
 	assert.Implements(t, (*client.GenesisProvider)(nil), s)
+	assert.Implements(t, (*client.LightClientBootstrapProvider)(nil), s)
+	assert.Implements(t, (*client.LightClientFinalityUpdateProvider)(nil), s)
+	assert.Implements(t, (*client.LightClientOptimisticUpdateProvider)(nil), s)
+	assert.Implements(t, (*client.LightClientUpdatesProvider)(nil), s)
+	assert.Implements(t, (*client.NodeHealthProvider)(nil), s)
+	assert.Implements(t, (*client.NodeIdentityProvider)(nil), s)
+	assert.Implements(t, (*client.NodePeerCountProvider)(nil), s)
+	assert.Implements(t, (*client.NodePeerProvider)(nil), s)
 	assert.Implements(t, (*client.NodeSyncingProvider)(nil), s)
+	assert.Implements(t, (*client.NodeVersionDetailsProvider)(nil), s)
+	assert.Implements(t, (*client.PayloadAttestationDataProvider)(nil), s)
+	assert.Implements(t, (*client.PayloadAttestationMessagesSubmitter)(nil), s)
+	assert.Implements(t, (*client.PayloadAttestationPoolProvider)(nil), s)
 	assert.Implements(t, (*client.ProposalPreparationsSubmitter)(nil), s)
 	assert.Implements(t, (*client.ProposalProvider)(nil), s)
 	assert.Implements(t, (*client.ProposerDutiesProvider)(nil), s)
+	assert.Implements(t, (*client.ProposerLookaheadProvider)(nil), s)
+	assert.Implements(t, (*client.ProposerSlashingPoolProvider)(nil), s)
+	assert.Implements(t, (*client.SignedExecutionPayloadEnvelopeProvider)(nil), s)
 	assert.Implements(t, (*client.SpecProvider)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeContributionProvider)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeContributionsSubmitter)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeDutiesProvider)(nil), s)
+	assert.Implements(t, (*client.SyncCommitteeSelectionsProvider)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeMessagesSubmitter)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeRewardsProvider)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteesProvider)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeSubscriptionsSubmitter)(nil), s)
 	assert.Implements(t, (*client.ValidatorBalancesProvider)(nil), s)
+	assert.Implements(t, (*client.ValidatorIdentitiesProvider)(nil), s)
 	assert.Implements(t, (*client.ValidatorLivenessProvider)(nil), s)
 	assert.Implements(t, (*client.ValidatorsProvider)(nil), s)
 	assert.Implements(t, (*client.VoluntaryExitSubmitter)(nil), s)
@@ -0,0 +1,265 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"math"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+)
+
+// Strategy selects the order in which active clients are tried for a call.
+// Strategies are used concurrently, so must be safe for concurrent use.
+type Strategy interface {
+	// Order returns the clients in the order in which they should be tried.
+	// The supplied slice must not be modified.
+	Order(clients []consensusclient.Service) []consensusclient.Service
+	// Observe is informed of the latency of each call made to a client, and the
+	// error if the call failed.
+	Observe(client consensusclient.Service, latency time.Duration, err error)
+}
+
+// orderedStrategy tries clients in the order in which they are listed.
+type orderedStrategy struct{}
+
+// NewOrderedStrategy creates a strategy that tries clients in the order in which they are listed.
+// This is the default strategy.
+func NewOrderedStrategy() Strategy {
+	return &orderedStrategy{}
+}
+
+// Order returns the clients unchanged.
+func (*orderedStrategy) Order(clients []consensusclient.Service) []consensusclient.Service {
+	return clients
+}
+
+// Observe does nothing.
+func (*orderedStrategy) Observe(consensusclient.Service, time.Duration, error) {}
+
+// roundRobinStrategy rotates the client tried first with each call.
+type roundRobinStrategy struct {
+	next atomic.Uint64
+}
+
+// NewRoundRobinStrategy creates a strategy that rotates the client tried first with each call,
+// spreading load evenly across clients.
+func NewRoundRobinStrategy() Strategy {
+	return &roundRobinStrategy{}
+}
+
+// Order returns the clients rotated by one place more than the previous call.
+func (s *roundRobinStrategy) Order(clients []consensusclient.Service) []consensusclient.Service {
+	if len(clients) < 2 {
+		return clients
+	}
+
+	start := int(s.next.Add(1)-1) % len(clients)
+	res := make([]consensusclient.Service, 0, len(clients))
+	res = append(res, clients[start:]...)
+	res = append(res, clients[:start]...)
+
+	return res
+}
+
+// Observe does nothing.
+func (*roundRobinStrategy) Observe(consensusclient.Service, time.Duration, error) {}
+
+const (
+	// defaultLatencyDecay is the default weight given to the most recent latency observation.
+	defaultLatencyDecay = 0.2
+	// latencyErrorPenalty is the latency recorded for a failed call.
+	latencyErrorPenalty = 5 * time.Second
+	// latencyHalfLife is the time over which an average latency that has not been updated halves.
+	latencyHalfLife = 30 * time.Second
+)
+
+// latencyAverage is the average latency observed for a client.
+type latencyAverage struct {
+	seconds float64
+	updated time.Time
+}
+
+// latencyStrategy tries clients in order of their observed latency.
+type latencyStrategy struct {
+	decay     float64
+	halfLife  time.Duration
+	mu        sync.RWMutex
+	latencies map[string]*latencyAverage
+}
+
+// NewLatencyStrategy creates a strategy that tries clients in order of the exponentially weighted
+// moving average of their observed latency, lowest first.  Decay is the weight given to the most
+// recent observation, between 0 and 1; if outside this range a default of 0.2 is used.
+// Failed calls are recorded with a latency of 5 seconds, and clients without observations are
+// tried first so that their latency is measured.  Averages that are not updated halve every
+// 30 seconds, so that clients that are not being tried are eventually tried again.
+func NewLatencyStrategy(decay float64) Strategy {
+	if decay <= 0 || decay > 1 {
+		decay = defaultLatencyDecay
+	}
+
+	return &latencyStrategy{
+		decay:     decay,
+		halfLife:  latencyHalfLife,
+		latencies: make(map[string]*latencyAverage),
+	}
+}
+
+// Order returns the clients sorted by their average latency.
+func (s *latencyStrategy) Order(clients []consensusclient.Service) []consensusclient.Service {
+	if len(clients) < 2 {
+		return clients
+	}
+
+	now := time.Now()
+	s.mu.RLock()
+	latencies := make(map[string]float64, len(clients))
+	for _, client := range clients {
+		latencies[client.Address()] = s.current(client.Address(), now)
+	}
+	s.mu.RUnlock()
+
+	res := slices.Clone(clients)
+	slices.SortStableFunc(res, func(a, b consensusclient.Service) int {
+		latencyA := latencies[a.Address()]
+		latencyB := latencies[b.Address()]
+
+		switch {
+		case latencyA < latencyB:
+			return -1
+		case latencyA > latencyB:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	return res
+}
+
+// Observe updates the average latency for the client.
+func (s *latencyStrategy) Observe(client consensusclient.Service, latency time.Duration, err error) {
+	if err != nil && latency < latencyErrorPenalty {
+		latency = latencyErrorPenalty
+	}
+
+	now := time.Now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	average := &latencyAverage{
+		seconds: latency.Seconds(),
+		updated: now,
+	}
+	if _, exists := s.latencies[client.Address()]; exists {
+		average.seconds = s.decay*latency.Seconds() + (1-s.decay)*s.current(client.Address(), now)
+	}
+	s.latencies[client.Address()] = average
+}
+
+// current returns the average latency for the client, decayed according to its age.
+// The caller must hold the lock.
+func (s *latencyStrategy) current(address string, now time.Time) float64 {
+	average, exists := s.latencies[address]
+	if !exists {
+		return 0
+	}
+
+	age := now.Sub(average.updated)
+	if age <= 0 || s.halfLife <= 0 {
+		return average.seconds
+	}
+
+	return average.seconds * math.Pow(0.5, age.Seconds()/s.halfLife.Seconds())
+}
+
+// untieredPriority is the tier for clients without a configured tier.
+const untieredPriority = math.MaxInt
+
+// priorityStrategy tries clients in tiers, balancing within each tier.
+type priorityStrategy struct {
+	tiers        map[string]int
+	newInTier    func() Strategy
+	mu           sync.Mutex
+	inTierByTier map[int]Strategy
+}
+
+// NewPriorityStrategy creates a strategy that tries clients in tiers of priority, lowest tier first.
+// Tiers maps client addresses to their tier; clients not in the map are tried after all others.
+// The order of clients within each tier is selected by a strategy created for the tier by newInTier;
+// if nil then round-robin is used.
+func NewPriorityStrategy(tiers map[string]int, newInTier func() Strategy) Strategy {
+	if newInTier == nil {
+		newInTier = NewRoundRobinStrategy
+	}
+
+	return &priorityStrategy{
+		tiers:        tiers,
+		newInTier:    newInTier,
+		inTierByTier: make(map[int]Strategy),
+	}
+}
+
+// Order returns the clients grouped by tier, each tier ordered by its in-tier strategy.
+func (s *priorityStrategy) Order(clients []consensusclient.Service) []consensusclient.Service {
+	tierClients := make(map[int][]consensusclient.Service)
+	for _, client := range clients {
+		tier := s.tier(client)
+		tierClients[tier] = append(tierClients[tier], client)
+	}
+
+	tiers := make([]int, 0, len(tierClients))
+	for tier := range tierClients {
+		tiers = append(tiers, tier)
+	}
+	slices.Sort(tiers)
+
+	res := make([]consensusclient.Service, 0, len(clients))
+	for _, tier := range tiers {
+		res = append(res, s.inTier(tier).Order(tierClients[tier])...)
+	}
+
+	return res
+}
+
+// Observe passes the observation to the client's in-tier strategy.
+func (s *priorityStrategy) Observe(client consensusclient.Service, latency time.Duration, err error) {
+	s.inTier(s.tier(client)).Observe(client, latency, err)
+}
+
+func (s *priorityStrategy) tier(client consensusclient.Service) int {
+	tier, exists := s.tiers[client.Address()]
+	if !exists {
+		return untieredPriority
+	}
+
+	return tier
+}
+
+func (s *priorityStrategy) inTier(tier int) Strategy {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	strategy, exists := s.inTierByTier[tier]
+	if !exists {
+		strategy = s.newInTier()
+		s.inTierByTier[tier] = strategy
+	}
+
+	return strategy
+}
@@ -0,0 +1,54 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLatencyStrategyRecovery ensures that a client demoted by an error is tried
+// again once its average has decayed below that of the clients in use.
+func TestLatencyStrategyRecovery(t *testing.T) {
+	ctx := context.Background()
+
+	clients := make([]consensusclient.Service, 0, 2)
+	for _, name := range []string{"a", "b"} {
+		client, err := mock.New(ctx, mock.WithName(name))
+		require.NoError(t, err)
+		clients = append(clients, client)
+	}
+
+	strategy := NewLatencyStrategy(0.5).(*latencyStrategy)
+	strategy.halfLife = 20 * time.Millisecond
+
+	strategy.Observe(clients[0], 10*time.Millisecond, errors.New("failed"))
+	strategy.Observe(clients[1], 100*time.Millisecond, nil)
+	require.Equal(t, "b", strategy.Order(clients)[0].Address())
+
+	// Client b continues to be used, client a is not.
+	time.Sleep(400 * time.Millisecond)
+	strategy.Observe(clients[1], 100*time.Millisecond, nil)
+	require.Equal(t, "a", strategy.Order(clients)[0].Address())
+
+	// A successful call keeps client a at the front.
+	strategy.Observe(clients[0], 50*time.Millisecond, nil)
+	require.Equal(t, "a", strategy.Order(clients)[0].Address())
+}
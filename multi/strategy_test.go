@@ -0,0 +1,160 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/multi"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func strategyClients(ctx context.Context, t *testing.T, names ...string) []consensusclient.Service {
+	t.Helper()
+
+	clients := make([]consensusclient.Service, 0, len(names))
+	for _, name := range names {
+		client, err := mock.New(ctx, mock.WithName(name))
+		require.NoError(t, err)
+		clients = append(clients, client)
+	}
+
+	return clients
+}
+
+func addresses(clients []consensusclient.Service) []string {
+	res := make([]string, 0, len(clients))
+	for _, client := range clients {
+		res = append(res, client.Address())
+	}
+
+	return res
+}
+
+func TestOrderedStrategy(t *testing.T) {
+	ctx := context.Background()
+	clients := strategyClients(ctx, t, "a", "b", "c")
+
+	strategy := multi.NewOrderedStrategy()
+	require.Equal(t, []string{"a", "b", "c"}, addresses(strategy.Order(clients)))
+	strategy.Observe(clients[0], time.Second, errors.New("failed"))
+	require.Equal(t, []string{"a", "b", "c"}, addresses(strategy.Order(clients)))
+}
+
+func TestRoundRobinStrategy(t *testing.T) {
+	ctx := context.Background()
+	clients := strategyClients(ctx, t, "a", "b", "c")
+
+	strategy := multi.NewRoundRobinStrategy()
+	require.Equal(t, []string{"a", "b", "c"}, addresses(strategy.Order(clients)))
+	require.Equal(t, []string{"b", "c", "a"}, addresses(strategy.Order(clients)))
+	require.Equal(t, []string{"c", "a", "b"}, addresses(strategy.Order(clients)))
+	require.Equal(t, []string{"a", "b", "c"}, addresses(strategy.Order(clients)))
+
+	// Original list is unchanged.
+	require.Equal(t, []string{"a", "b", "c"}, addresses(clients))
+}
+
+func TestLatencyStrategy(t *testing.T) {
+	ctx := context.Background()
+	clients := strategyClients(ctx, t, "a", "b", "c")
+
+	strategy := multi.NewLatencyStrategy(0.5)
+
+	// Clients without observations are tried first.
+	strategy.Observe(clients[0], 300*time.Millisecond, nil)
+	strategy.Observe(clients[1], 100*time.Millisecond, nil)
+	require.Equal(t, []string{"c", "b", "a"}, addresses(strategy.Order(clients)))
+
+	strategy.Observe(clients[2], 200*time.Millisecond, nil)
+	require.Equal(t, []string{"b", "c", "a"}, addresses(strategy.Order(clients)))
+
+	// A slow response moves the average, but does not replace it.
+	strategy.Observe(clients[1], 400*time.Millisecond, nil)
+	require.Equal(t, []string{"c", "b", "a"}, addresses(strategy.Order(clients)))
+
+	// Errors demote the client.
+	strategy.Observe(clients[2], 10*time.Millisecond, errors.New("failed"))
+	require.Equal(t, []string{"b", "a", "c"}, addresses(strategy.Order(clients)))
+
+	// Original list is unchanged.
+	require.Equal(t, []string{"a", "b", "c"}, addresses(clients))
+}
+
+func TestPriorityStrategy(t *testing.T) {
+	ctx := context.Background()
+	clients := strategyClients(ctx, t, "a", "b", "c", "d", "e")
+
+	strategy := multi.NewPriorityStrategy(map[string]int{
+		"b": 1,
+		"c": 2,
+		"d": 1,
+		"e": 2,
+	}, nil)
+
+	// Tiers are tried in order, with round-robin within each tier and untiered clients last.
+	require.Equal(t, []string{"b", "d", "c", "e", "a"}, addresses(strategy.Order(clients)))
+	require.Equal(t, []string{"d", "b", "e", "c", "a"}, addresses(strategy.Order(clients)))
+	require.Equal(t, []string{"b", "d", "c", "e", "a"}, addresses(strategy.Order(clients)))
+
+	// In-tier strategy receives observations.
+	strategy = multi.NewPriorityStrategy(map[string]int{
+		"a": 1,
+		"b": 1,
+	}, func() multi.Strategy { return multi.NewLatencyStrategy(0) })
+	strategy.Observe(clients[0], time.Second, nil)
+	strategy.Observe(clients[1], time.Millisecond, nil)
+	require.Equal(t, []string{"b", "a"}, addresses(strategy.Order(clients[:2])))
+}
+
+func TestWithStrategy(t *testing.T) {
+	ctx := context.Background()
+
+	calls := make(map[string]int)
+	clients := strategyClients(ctx, t, "a", "b", "c")
+	for _, client := range clients {
+		client.(*mock.Service).BeaconBlockRootFunc = func(context.Context, *api.BeaconBlockRootOpts) (*api.Response[*phase0.Root], error) {
+			calls[client.Address()]++
+
+			return &api.Response[*phase0.Root]{
+				Data:     &phase0.Root{},
+				Metadata: make(map[string]any),
+			}, nil
+		}
+	}
+
+	multiClient, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients(clients),
+		multi.WithStrategy(multi.NewRoundRobinStrategy()),
+	)
+	require.NoError(t, err)
+
+	for range 6 {
+		_, err := multiClient.(consensusclient.BeaconBlockRootProvider).BeaconBlockRoot(ctx, &api.BeaconBlockRootOpts{
+			Block: "head",
+		})
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, map[string]int{"a": 2, "b": 2, "c": 2}, calls)
+}
@@ -0,0 +1,70 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"errors"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/internal/eventqueue"
+)
+
+// Subscribe returns a channel on which events with the given topics are delivered.
+func (s *Service) Subscribe(ctx context.Context,
+	opts *api.SubscribeOpts,
+) (
+	<-chan *api.Event,
+	error,
+) {
+	if opts == nil {
+		return nil, consensusclient.ErrNoOptions
+	}
+
+	if len(opts.Topics) == 0 {
+		return nil, errors.Join(errors.New("no topics supplied"), consensusclient.ErrInvalidOptions)
+	}
+
+	queue := eventqueue.New(opts.BufferSize, opts.OverflowPolicy, func(event *api.Event) {
+		s.monitorEventDropped(event.Topic)
+	})
+
+	stream, err := s.Events(ctx, &api.EventsOpts{
+		Common: opts.Common,
+		Topics: opts.Topics,
+		Handler: func(event *apiv1.Event) {
+			typedEvent, err := api.NewEvent(event)
+			if err != nil {
+				s.log.Error().Err(err).Str("topic", event.Topic).Msg("Failed to create typed event")
+
+				return
+			}
+
+			queue.Push(ctx, typedEvent)
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		<-ctx.Done()
+		stream.Close()
+		queue.Close()
+	}()
+
+	return queue.C(), nil
+}
@@ -0,0 +1,90 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/multi"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscribe(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	clientOpts := make(map[string]*api.EventsOpts)
+	eventsFunc := func(name string) func(context.Context, *api.EventsOpts) (api.EventStream, error) {
+		return func(_ context.Context, opts *api.EventsOpts) (api.EventStream, error) {
+			clientOpts[name] = opts
+
+			return nil, nil
+		}
+	}
+
+	// The mock clients are given a context that is never cancelled, as closing them
+	// writes to the mock's package-level logger.
+	client1, err := mock.New(context.Background(), mock.WithName("mock 1"))
+	require.NoError(t, err)
+	client1.EventsFunc = eventsFunc("mock 1")
+	client2, err := mock.New(context.Background(), mock.WithName("mock 2"))
+	require.NoError(t, err)
+	client2.EventsFunc = eventsFunc("mock 2")
+
+	multiClient, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]consensusclient.Service{
+			client1,
+			client2,
+		}),
+	)
+	require.NoError(t, err)
+
+	_, err = multiClient.(consensusclient.EventSubscriptionProvider).Subscribe(ctx, &api.SubscribeOpts{})
+	require.ErrorIs(t, err, consensusclient.ErrInvalidOptions)
+
+	subCtx, subCancel := context.WithCancel(ctx)
+	ch, err := multiClient.(consensusclient.EventSubscriptionProvider).Subscribe(subCtx, &api.SubscribeOpts{
+		Topics: []string{"head"},
+	})
+	require.NoError(t, err)
+	require.Len(t, clientOpts, 2)
+
+	// Only events from the active provider are delivered.
+	clientOpts["mock 2"].Handler(&apiv1.Event{Topic: "head", Data: &apiv1.HeadEvent{Slot: 2}})
+	clientOpts["mock 1"].Handler(&apiv1.Event{Topic: "head", Data: &apiv1.HeadEvent{Slot: 1}})
+
+	select {
+	case event := <-ch:
+		require.Equal(t, phase0.Slot(1), event.Head.Slot)
+	case <-time.After(time.Second):
+		require.Fail(t, "timed out waiting for event")
+	}
+
+	subCancel()
+	select {
+	case _, open := <-ch:
+		require.False(t, open)
+	case <-time.After(time.Second):
+		require.Fail(t, "timed out waiting for channel to close")
+	}
+}
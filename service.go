@@ -24,6 +24,8 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
 	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/fulu"
+	"github.com/attestantio/go-eth2-client/spec/gloas"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -80,6 +82,18 @@ type FarFutureEpochProvider interface {
 	FarFutureEpoch(ctx context.Context) (phase0.Epoch, error)
 }
 
+// SyncCommitteeSelectionsProvider is the interface for obtaining sync committee selections.
+// Used by DV middleware clients.
+type SyncCommitteeSelectionsProvider interface {
+	// SyncCommitteeSelections obtains sync committee selections.
+	SyncCommitteeSelections(ctx context.Context,
+		opts *api.SyncCommitteeSelectionsOpts,
+	) (
+		*api.Response[[]*apiv1.SyncCommitteeSelection],
+		error,
+	)
+}
+
 // TargetAggregatorsPerCommitteeProvider is the interface for providing the target number of
 // aggregators in each attestation committee.
 type TargetAggregatorsPerCommitteeProvider interface {
@@ -138,6 +152,17 @@ type BlobSidecarsProvider interface {
 		error)
 }
 
+// DataColumnSidecarsProvider is the interface for providing data column sidecars for a given beacon block.
+type DataColumnSidecarsProvider interface {
+	// DataColumnSidecars fetches the data column sidecars given a block ID.
+	DataColumnSidecars(ctx context.Context,
+		opts *api.DataColumnSidecarsOpts,
+	) (
+		*api.Response[[]*fulu.DataColumnSidecar],
+		error,
+	)
+}
+
 // BeaconCommitteesProvider is the interface for providing beacon committees.
 type BeaconCommitteesProvider interface {
 	// BeaconCommittees fetches all beacon committees for the given options.
@@ -219,6 +244,17 @@ type AttestationsSubmitter interface {
 	SubmitAttestations(ctx context.Context, opts *api.SubmitAttestationsOpts) error
 }
 
+// AttesterSlashingPoolProvider is the interface for providing attester slashing pools.
+type AttesterSlashingPoolProvider interface {
+	// AttesterSlashingPool fetches the attester slashing pool.
+	AttesterSlashingPool(ctx context.Context,
+		opts *api.AttesterSlashingPoolOpts,
+	) (
+		*api.Response[[]*spec.VersionedAttesterSlashing],
+		error,
+	)
+}
+
 // AttesterSlashingSubmitter is the interface for submitting attester slashings.
 type AttesterSlashingSubmitter interface {
 	// SubmitAttesterSlashing submits an attester slashing
@@ -258,6 +294,17 @@ type DepositContractProvider interface {
 	)
 }
 
+// DepositSnapshotProvider is the interface for providing the EIP-4881 deposit snapshot.
+type DepositSnapshotProvider interface {
+	// DepositSnapshot provides the snapshot of the deposit tree as at the latest finalized checkpoint.
+	DepositSnapshot(ctx context.Context,
+		opts *api.DepositSnapshotOpts,
+	) (
+		*api.Response[*apiv1.DepositSnapshot],
+		error,
+	)
+}
+
 // SyncCommitteeDutiesProvider is the interface for providing sync committee duties.
 type SyncCommitteeDutiesProvider interface {
 	// SyncCommitteeDuties obtains sync committee duties.
@@ -310,6 +357,17 @@ type SyncCommitteeRewardsProvider interface {
 	)
 }
 
+// BLSToExecutionChangePoolProvider is the interface for providing BLS to execution address change pools.
+type BLSToExecutionChangePoolProvider interface {
+	// BLSToExecutionChangePool fetches the BLS to execution address change pool.
+	BLSToExecutionChangePool(ctx context.Context,
+		opts *api.BLSToExecutionChangePoolOpts,
+	) (
+		*api.Response[[]*capella.SignedBLSToExecutionChange],
+		error,
+	)
+}
+
 // BLSToExecutionChangesSubmitter is the interface for submitting BLS to execution address changes.
 type BLSToExecutionChangesSubmitter interface {
 	// SubmitBLSToExecutionChanges submits BLS to execution address change operations.
@@ -325,6 +383,14 @@ type BeaconBlockHeadersProvider interface {
 		*api.Response[*apiv1.BeaconBlockHeader],
 		error,
 	)
+
+	// BeaconBlockHeaders provides the block headers matching the given slot and parent root.
+	BeaconBlockHeaders(ctx context.Context,
+		opts *api.BeaconBlockHeadersOpts,
+	) (
+		*api.Response[[]*apiv1.BeaconBlockHeader],
+		error,
+	)
 }
 
 // ProposalProvider is the interface for providing proposals.
@@ -338,11 +404,33 @@ type ProposalProvider interface {
 	)
 }
 
+// ProposerSlashingPoolProvider is the interface for providing proposer slashing pools.
+type ProposerSlashingPoolProvider interface {
+	// ProposerSlashingPool fetches the proposer slashing pool.
+	ProposerSlashingPool(ctx context.Context,
+		opts *api.ProposerSlashingPoolOpts,
+	) (
+		*api.Response[[]*phase0.ProposerSlashing],
+		error,
+	)
+}
+
 // ProposalSlashingSubmitter is the interface for submitting proposal slashings.
 type ProposalSlashingSubmitter interface {
 	SubmitProposalSlashing(ctx context.Context, slashing *phase0.ProposerSlashing) error
 }
 
+// BlockAttestationsProvider is the interface for providing the attestations included in a block.
+type BlockAttestationsProvider interface {
+	// BlockAttestations fetches the attestations included in a block given a set of options.
+	BlockAttestations(ctx context.Context,
+		opts *api.BlockAttestationsOpts,
+	) (
+		*api.Response[[]*spec.VersionedAttestation],
+		error,
+	)
+}
+
 // BeaconBlockRootProvider is the interface for providing beacon block roots.
 type BeaconBlockRootProvider interface {
 	// BeaconBlockRoot fetches a block's root given a set of options.
@@ -388,6 +476,17 @@ type BeaconCommitteeSelectionsProvider interface {
 	)
 }
 
+// BeaconHeadsProvider is the interface for providing fork choice heads.
+type BeaconHeadsProvider interface {
+	// BeaconHeads fetches all heads of the node's fork choice.
+	BeaconHeads(ctx context.Context,
+		opts *api.BeaconHeadsOpts,
+	) (
+		*api.Response[[]*apiv1.BeaconHead],
+		error,
+	)
+}
+
 // BeaconStateProvider is the interface for providing beacon state.
 type BeaconStateProvider interface {
 	// BeaconState fetches a beacon state given a state ID.
@@ -445,7 +544,17 @@ type ValidatorRegistrationsSubmitter interface {
 // EventsProvider is the interface for providing events.
 type EventsProvider interface {
 	// Events feeds requested events with the given topics to the supplied handler.
-	Events(ctx context.Context, opts *api.EventsOpts) error
+	// The returned stream can be used to monitor the connection and to close it.
+	Events(ctx context.Context, opts *api.EventsOpts) (api.EventStream, error)
+}
+
+// EventSubscriptionProvider is the interface for providing event subscriptions.
+type EventSubscriptionProvider interface {
+	// Subscribe returns a channel on which events with the given topics are delivered.
+	// Events are buffered, so that a slow reader does not stall the event stream; the
+	// options define what happens when the buffer is full.
+	// The channel is closed when the context is done.
+	Subscribe(ctx context.Context, opts *api.SubscribeOpts) (<-chan *api.Event, error)
 }
 
 // FinalityProvider is the interface for providing finality information.
@@ -503,6 +612,50 @@ type GenesisProvider interface {
 	)
 }
 
+// NodeHealthProvider is the interface for providing node health.
+type NodeHealthProvider interface {
+	// NodeHealth provides the health of the node.
+	NodeHealth(ctx context.Context,
+		opts *api.NodeHealthOpts,
+	) (
+		*api.Response[apiv1.NodeHealth],
+		error,
+	)
+}
+
+// NodeIdentityProvider is the interface for providing node network identity.
+type NodeIdentityProvider interface {
+	// NodeIdentity provides the network identity of the node.
+	NodeIdentity(ctx context.Context,
+		opts *api.NodeIdentityOpts,
+	) (
+		*api.Response[*apiv1.NodeIdentity],
+		error,
+	)
+}
+
+// NodePeerProvider is the interface for providing information about a single peer.
+type NodePeerProvider interface {
+	// NodePeer provides information about a single peer of the node.
+	NodePeer(ctx context.Context,
+		opts *api.NodePeerOpts,
+	) (
+		*api.Response[*apiv1.Peer],
+		error,
+	)
+}
+
+// NodePeerCountProvider is the interface for providing peer counts.
+type NodePeerCountProvider interface {
+	// NodePeerCount provides the number of peers of the node by connection state.
+	NodePeerCount(ctx context.Context,
+		opts *api.NodePeerCountOpts,
+	) (
+		*api.Response[*apiv1.PeerCount],
+		error,
+	)
+}
+
 // NodePeersProvider is the interface for providing peer information.
 type NodePeersProvider interface {
 	// NodePeers provides the peers of the node.
@@ -547,6 +700,17 @@ type NodeVersionProvider interface {
 	)
 }
 
+// NodeVersionDetailsProvider is the interface for providing the structured node version.
+type NodeVersionDetailsProvider interface {
+	// NodeVersionDetails returns the identity of the beacon node and its execution client.
+	NodeVersionDetails(ctx context.Context,
+		opts *api.NodeVersionOpts,
+	) (
+		*api.Response[*apiv1.NodeVersion],
+		error,
+	)
+}
+
 // ProposalPreparationsSubmitter is the interface for submitting proposal preparations.
 type ProposalPreparationsSubmitter interface {
 	// SubmitProposalPreparations provides the beacon node with information required if a proposal for the given validators
@@ -565,6 +729,19 @@ type ProposerDutiesProvider interface {
 	)
 }
 
+// ProposerLookaheadProvider is the interface for providing the proposer lookahead.
+type ProposerLookaheadProvider interface {
+	// ProposerLookahead obtains the proposer lookahead for the given options.
+	// The first entry is the proposer for the first slot of the state's epoch, with entries for
+	// subsequent slots following in order.
+	ProposerLookahead(ctx context.Context,
+		opts *api.ProposerLookaheadOpts,
+	) (
+		*api.Response[[]phase0.ValidatorIndex],
+		error,
+	)
+}
+
 // SpecProvider is the interface for providing spec data.
 type SpecProvider interface {
 	// Spec provides the spec information of the chain.
@@ -595,6 +772,17 @@ type ValidatorBalancesProvider interface {
 	)
 }
 
+// ValidatorIdentitiesProvider is the interface for providing validator identities.
+type ValidatorIdentitiesProvider interface {
+	// ValidatorIdentities provides the index, public key and activation epoch of validators for the given options.
+	ValidatorIdentities(ctx context.Context,
+		opts *api.ValidatorIdentitiesOpts,
+	) (
+		*api.Response[[]*apiv1.ValidatorIdentity],
+		error,
+	)
+}
+
 // ValidatorsProvider is the interface for providing validator information.
 type ValidatorsProvider interface {
 	// Validators provides the validators, with their balance and status, for the given options.
@@ -656,6 +844,95 @@ type PendingPartialWithdrawalsProvider interface {
 	)
 }
 
+// LightClientBootstrapProvider is the interface for providing light client bootstraps.
+type LightClientBootstrapProvider interface {
+	// LightClientBootstrap provides the light client bootstrap for a given block root.
+	LightClientBootstrap(ctx context.Context,
+		opts *api.LightClientBootstrapOpts,
+	) (
+		*api.Response[*spec.VersionedLightClientBootstrap],
+		error,
+	)
+}
+
+// LightClientUpdatesProvider is the interface for providing light client updates.
+type LightClientUpdatesProvider interface {
+	// LightClientUpdates provides the light client updates for a range of sync committee periods.
+	LightClientUpdates(ctx context.Context,
+		opts *api.LightClientUpdatesOpts,
+	) (
+		*api.Response[[]*spec.VersionedLightClientUpdate],
+		error,
+	)
+}
+
+// LightClientFinalityUpdateProvider is the interface for providing light client finality updates.
+type LightClientFinalityUpdateProvider interface {
+	// LightClientFinalityUpdate provides the latest light client finality update.
+	LightClientFinalityUpdate(ctx context.Context,
+		opts *api.LightClientFinalityUpdateOpts,
+	) (
+		*api.Response[*spec.VersionedLightClientFinalityUpdate],
+		error,
+	)
+}
+
+// LightClientOptimisticUpdateProvider is the interface for providing light client optimistic updates.
+type LightClientOptimisticUpdateProvider interface {
+	// LightClientOptimisticUpdate provides the latest light client optimistic update.
+	LightClientOptimisticUpdate(ctx context.Context,
+		opts *api.LightClientOptimisticUpdateOpts,
+	) (
+		*api.Response[*spec.VersionedLightClientOptimisticUpdate],
+		error,
+	)
+}
+
+// PayloadAttestationDataProvider is the interface for providing payload attestation data.
+type PayloadAttestationDataProvider interface {
+	// PayloadAttestationData fetches the payload attestation data for the given options.
+	PayloadAttestationData(ctx context.Context,
+		opts *api.PayloadAttestationDataOpts,
+	) (
+		*api.Response[*gloas.PayloadAttestationData],
+		error,
+	)
+}
+
+// PayloadAttestationPoolProvider is the interface for providing payload attestation pools.
+type PayloadAttestationPoolProvider interface {
+	// PayloadAttestationPool fetches the payload attestation pool for the given options.
+	PayloadAttestationPool(ctx context.Context,
+		opts *api.PayloadAttestationPoolOpts,
+	) (
+		*api.Response[[]*gloas.PayloadAttestation],
+		error,
+	)
+}
+
+// PayloadAttestationMessagesSubmitter is the interface for submitting payload attestation messages.
+type PayloadAttestationMessagesSubmitter interface {
+	// SubmitPayloadAttestationMessages submits payload attestation messages.
+	SubmitPayloadAttestationMessages(ctx context.Context, opts *api.SubmitPayloadAttestationMessagesOpts) error
+}
+
+// SignedExecutionPayloadEnvelopeProvider is the interface for providing signed execution payload envelopes.
+type SignedExecutionPayloadEnvelopeProvider interface {
+	// SignedExecutionPayloadEnvelope fetches the signed execution payload envelope for a given block.
+	SignedExecutionPayloadEnvelope(ctx context.Context,
+		opts *api.SignedExecutionPayloadEnvelopeOpts,
+	) (
+		*api.Response[*gloas.SignedExecutionPayloadEnvelope],
+		error,
+	)
+}
+
+// ExecutionPayloadEnvelopeSubmitter is the interface for submitting signed execution payload envelopes.
+type ExecutionPayloadEnvelopeSubmitter interface {
+	// SubmitExecutionPayloadEnvelope submits a signed execution payload envelope.
+	SubmitExecutionPayloadEnvelope(ctx context.Context, opts *api.SubmitExecutionPayloadEnvelopeOpts) error
+}
+
 //
 // Local extensions
 //
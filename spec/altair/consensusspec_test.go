@@ -107,6 +107,26 @@ func TestConsensusSpec(t *testing.T) {
 			name: "IndexedAttestation",
 			s:    &phase0.IndexedAttestation{},
 		},
+		{
+			name: "LightClientBootstrap",
+			s:    &altair.LightClientBootstrap{},
+		},
+		{
+			name: "LightClientFinalityUpdate",
+			s:    &altair.LightClientFinalityUpdate{},
+		},
+		{
+			name: "LightClientHeader",
+			s:    &altair.LightClientHeader{},
+		},
+		{
+			name: "LightClientOptimisticUpdate",
+			s:    &altair.LightClientOptimisticUpdate{},
+		},
+		{
+			name: "LightClientUpdate",
+			s:    &altair.LightClientUpdate{},
+		},
 		{
 			name: "PendingAttestation",
 			s:    &phase0.PendingAttestation{},
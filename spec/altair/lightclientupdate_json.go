@@ -0,0 +1,96 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package altair
+
+import (
+	"encoding/json"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// lightClientUpdateJSON is the spec representation of the struct.
+type lightClientUpdateJSON struct {
+	AttestedHeader          *LightClientHeader `json:"attested_header"`
+	NextSyncCommittee       *SyncCommittee     `json:"next_sync_committee"`
+	NextSyncCommitteeBranch []phase0.Root      `json:"next_sync_committee_branch"`
+	FinalizedHeader         *LightClientHeader `json:"finalized_header"`
+	FinalityBranch          []phase0.Root      `json:"finality_branch"`
+	SyncAggregate           *SyncAggregate     `json:"sync_aggregate"`
+	SignatureSlot           phase0.Slot        `json:"signature_slot"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (l *LightClientUpdate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&lightClientUpdateJSON{
+		AttestedHeader:          l.AttestedHeader,
+		NextSyncCommittee:       l.NextSyncCommittee,
+		NextSyncCommitteeBranch: l.NextSyncCommitteeBranch,
+		FinalizedHeader:         l.FinalizedHeader,
+		FinalityBranch:          l.FinalityBranch,
+		SyncAggregate:           l.SyncAggregate,
+		SignatureSlot:           l.SignatureSlot,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (l *LightClientUpdate) UnmarshalJSON(input []byte) error {
+	raw, err := codecs.RawJSON(&lightClientUpdateJSON{}, input)
+	if err != nil {
+		return err
+	}
+
+	l.AttestedHeader = &LightClientHeader{}
+	if err := l.AttestedHeader.UnmarshalJSON(raw["attested_header"]); err != nil {
+		return errors.Wrap(err, "attested_header")
+	}
+
+	l.NextSyncCommittee = &SyncCommittee{}
+	if err := l.NextSyncCommittee.UnmarshalJSON(raw["next_sync_committee"]); err != nil {
+		return errors.Wrap(err, "next_sync_committee")
+	}
+
+	if err := json.Unmarshal(raw["next_sync_committee_branch"], &l.NextSyncCommitteeBranch); err != nil {
+		return errors.Wrap(err, "next_sync_committee_branch")
+	}
+
+	if len(l.NextSyncCommitteeBranch) != 5 {
+		return errors.New("next_sync_committee_branch: incorrect length")
+	}
+
+	l.FinalizedHeader = &LightClientHeader{}
+	if err := l.FinalizedHeader.UnmarshalJSON(raw["finalized_header"]); err != nil {
+		return errors.Wrap(err, "finalized_header")
+	}
+
+	if err := json.Unmarshal(raw["finality_branch"], &l.FinalityBranch); err != nil {
+		return errors.Wrap(err, "finality_branch")
+	}
+
+	if len(l.FinalityBranch) != 6 {
+		return errors.New("finality_branch: incorrect length")
+	}
+
+	l.SyncAggregate = &SyncAggregate{}
+	if err := l.SyncAggregate.UnmarshalJSON(raw["sync_aggregate"]); err != nil {
+		return errors.Wrap(err, "sync_aggregate")
+	}
+
+	if err := l.SignatureSlot.UnmarshalJSON(raw["signature_slot"]); err != nil {
+		return errors.Wrap(err, "signature_slot")
+	}
+
+	return nil
+}
@@ -120,6 +120,26 @@ func TestConsensusSpec(t *testing.T) {
 			name: "IndexedAttestation",
 			s:    &phase0.IndexedAttestation{},
 		},
+		{
+			name: "LightClientBootstrap",
+			s:    &capella.LightClientBootstrap{},
+		},
+		{
+			name: "LightClientFinalityUpdate",
+			s:    &capella.LightClientFinalityUpdate{},
+		},
+		{
+			name: "LightClientHeader",
+			s:    &capella.LightClientHeader{},
+		},
+		{
+			name: "LightClientOptimisticUpdate",
+			s:    &capella.LightClientOptimisticUpdate{},
+		},
+		{
+			name: "LightClientUpdate",
+			s:    &capella.LightClientUpdate{},
+		},
 		{
 			name: "PendingAttestation",
 			s:    &phase0.PendingAttestation{},
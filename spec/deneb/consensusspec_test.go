@@ -133,6 +133,26 @@ func TestConsensusSpec(t *testing.T) {
 			name: "IndexedAttestation",
 			s:    &phase0.IndexedAttestation{},
 		},
+		{
+			name: "LightClientBootstrap",
+			s:    &deneb.LightClientBootstrap{},
+		},
+		{
+			name: "LightClientFinalityUpdate",
+			s:    &deneb.LightClientFinalityUpdate{},
+		},
+		{
+			name: "LightClientHeader",
+			s:    &deneb.LightClientHeader{},
+		},
+		{
+			name: "LightClientOptimisticUpdate",
+			s:    &deneb.LightClientOptimisticUpdate{},
+		},
+		{
+			name: "LightClientUpdate",
+			s:    &deneb.LightClientUpdate{},
+		},
 		{
 			name: "PendingAttestation",
 			s:    &phase0.PendingAttestation{},
@@ -142,6 +142,26 @@ func TestConsensusSpec(t *testing.T) {
 			name: "IndexedAttestation",
 			s:    &electra.IndexedAttestation{},
 		},
+		{
+			name: "LightClientBootstrap",
+			s:    &electra.LightClientBootstrap{},
+		},
+		{
+			name: "LightClientFinalityUpdate",
+			s:    &electra.LightClientFinalityUpdate{},
+		},
+		{
+			name: "LightClientHeader",
+			s:    &deneb.LightClientHeader{},
+		},
+		{
+			name: "LightClientOptimisticUpdate",
+			s:    &electra.LightClientOptimisticUpdate{},
+		},
+		{
+			name: "LightClientUpdate",
+			s:    &electra.LightClientUpdate{},
+		},
 		{
 			name: "PendingAttestation",
 			s:    &phase0.PendingAttestation{},
@@ -103,6 +103,10 @@ func TestConsensusSpec(t *testing.T) {
 			name: "ContributionAndProof",
 			s:    &altair.ContributionAndProof{},
 		},
+		{
+			name: "DataColumnSidecar",
+			s:    &fulu.DataColumnSidecar{},
+		},
 		{
 			name: "Deposit",
 			s:    &phase0.Deposit{},
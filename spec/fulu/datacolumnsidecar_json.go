@@ -0,0 +1,81 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulu
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// dataColumnSidecarJSON is the spec representation of the struct.
+type dataColumnSidecarJSON struct {
+	Index                        string                                     `json:"index"`
+	Column                       []Cell                                     `json:"column"`
+	KZGCommitments               []deneb.KZGCommitment                      `json:"kzg_commitments"`
+	KZGProofs                    []deneb.KZGProof                           `json:"kzg_proofs"`
+	SignedBlockHeader            *phase0.SignedBeaconBlockHeader            `json:"signed_block_header"`
+	KZGCommitmentsInclusionProof []deneb.KZGCommitmentInclusionProofElement `json:"kzg_commitments_inclusion_proof"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d *DataColumnSidecar) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&dataColumnSidecarJSON{
+		Index:                        fmt.Sprintf("%d", d.Index),
+		Column:                       d.Column,
+		KZGCommitments:               d.KZGCommitments,
+		KZGProofs:                    d.KZGProofs,
+		SignedBlockHeader:            d.SignedBlockHeader,
+		KZGCommitmentsInclusionProof: d.KZGCommitmentsInclusionProof,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DataColumnSidecar) UnmarshalJSON(input []byte) error {
+	raw, err := codecs.RawJSON(&dataColumnSidecarJSON{}, input)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Index.UnmarshalJSON(raw["index"]); err != nil {
+		return errors.Wrap(err, "index")
+	}
+
+	if err := json.Unmarshal(raw["column"], &d.Column); err != nil {
+		return errors.Wrap(err, "column")
+	}
+
+	if err := json.Unmarshal(raw["kzg_commitments"], &d.KZGCommitments); err != nil {
+		return errors.Wrap(err, "kzg_commitments")
+	}
+
+	if err := json.Unmarshal(raw["kzg_proofs"], &d.KZGProofs); err != nil {
+		return errors.Wrap(err, "kzg_proofs")
+	}
+
+	d.SignedBlockHeader = &phase0.SignedBeaconBlockHeader{}
+	if err := d.SignedBlockHeader.UnmarshalJSON(raw["signed_block_header"]); err != nil {
+		return errors.Wrap(err, "signed_block_header")
+	}
+
+	if err := json.Unmarshal(raw["kzg_commitments_inclusion_proof"], &d.KZGCommitmentsInclusionProof); err != nil {
+		return errors.Wrap(err, "kzg_commitments_inclusion_proof")
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulu
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
+)
+
+// dataColumnSidecarYAML is the spec representation of the struct.
+type dataColumnSidecarYAML struct {
+	Index                        uint64                                     `yaml:"index"`
+	Column                       []Cell                                     `yaml:"column"`
+	KZGCommitments               []deneb.KZGCommitment                      `yaml:"kzg_commitments"`
+	KZGProofs                    []deneb.KZGProof                           `yaml:"kzg_proofs"`
+	SignedBlockHeader            *phase0.SignedBeaconBlockHeader            `yaml:"signed_block_header"`
+	KZGCommitmentsInclusionProof []deneb.KZGCommitmentInclusionProofElement `yaml:"kzg_commitments_inclusion_proof"`
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (d *DataColumnSidecar) MarshalYAML() ([]byte, error) {
+	yamlBytes, err := yaml.MarshalWithOptions(&dataColumnSidecarYAML{
+		Index:                        uint64(d.Index),
+		Column:                       d.Column,
+		KZGCommitments:               d.KZGCommitments,
+		KZGProofs:                    d.KZGProofs,
+		SignedBlockHeader:            d.SignedBlockHeader,
+		KZGCommitmentsInclusionProof: d.KZGCommitmentsInclusionProof,
+	}, yaml.Flow(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.ReplaceAll(yamlBytes, []byte(`"`), []byte(`'`)), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (d *DataColumnSidecar) UnmarshalYAML(input []byte) error {
+	// This is very inefficient, but YAML is only used for spec tests so we do this
+	// rather than maintain a custom YAML unmarshaller.
+	var unmarshaled dataColumnSidecarJSON
+	if err := yaml.Unmarshal(input, &unmarshaled); err != nil {
+		return errors.Wrap(err, "failed to unmarshal YAML")
+	}
+
+	marshaled, err := json.Marshal(&unmarshaled)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal JSON")
+	}
+
+	return d.UnmarshalJSON(marshaled)
+}
@@ -13,5 +13,5 @@
 
 package fulu
 
-//go:generate rm -f beaconstate_ssz.go
+//go:generate rm -f beaconstate_ssz.go datacolumnidentifier_ssz.go datacolumnsidecar_ssz.go
 //go:generate go tool dynssz-gen -config generate.yaml
@@ -0,0 +1,58 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gloas
+
+import (
+	"encoding/json"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// builderPendingPaymentJSON is the spec representation of the struct.
+type builderPendingPaymentJSON struct {
+	Weight     phase0.Gwei               `json:"weight"`
+	Withdrawal *BuilderPendingWithdrawal `json:"withdrawal"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b *BuilderPendingPayment) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&builderPendingPaymentJSON{
+		Weight:     b.Weight,
+		Withdrawal: b.Withdrawal,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BuilderPendingPayment) UnmarshalJSON(input []byte) error {
+	raw, err := codecs.RawJSON(&builderPendingPaymentJSON{}, input)
+	if err != nil {
+		return err
+	}
+
+	if err := b.Weight.UnmarshalJSON(raw["weight"]); err != nil {
+		return errors.Wrap(err, "weight")
+	}
+
+	if err := json.Unmarshal(raw["withdrawal"], &b.Withdrawal); err != nil {
+		return errors.Wrap(err, "withdrawal")
+	}
+
+	if b.Withdrawal == nil {
+		return errors.New("withdrawal: missing")
+	}
+
+	return nil
+}
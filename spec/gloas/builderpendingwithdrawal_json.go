@@ -0,0 +1,67 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gloas
+
+import (
+	"encoding/json"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// builderPendingWithdrawalJSON is the spec representation of the struct.
+type builderPendingWithdrawalJSON struct {
+	FeeRecipient      bellatrix.ExecutionAddress `json:"fee_recipient"`
+	Amount            phase0.Gwei                `json:"amount"`
+	BuilderIndex      phase0.ValidatorIndex      `json:"builder_index"`
+	WithdrawableEpoch phase0.Epoch               `json:"withdrawable_epoch"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b *BuilderPendingWithdrawal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&builderPendingWithdrawalJSON{
+		FeeRecipient:      b.FeeRecipient,
+		Amount:            b.Amount,
+		BuilderIndex:      b.BuilderIndex,
+		WithdrawableEpoch: b.WithdrawableEpoch,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BuilderPendingWithdrawal) UnmarshalJSON(input []byte) error {
+	raw, err := codecs.RawJSON(&builderPendingWithdrawalJSON{}, input)
+	if err != nil {
+		return err
+	}
+
+	if err := b.FeeRecipient.UnmarshalJSON(raw["fee_recipient"]); err != nil {
+		return errors.Wrap(err, "fee_recipient")
+	}
+
+	if err := b.Amount.UnmarshalJSON(raw["amount"]); err != nil {
+		return errors.Wrap(err, "amount")
+	}
+
+	if err := b.BuilderIndex.UnmarshalJSON(raw["builder_index"]); err != nil {
+		return errors.Wrap(err, "builder_index")
+	}
+
+	if err := b.WithdrawableEpoch.UnmarshalJSON(raw["withdrawable_epoch"]); err != nil {
+		return errors.Wrap(err, "withdrawable_epoch")
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gloas
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// executionPayloadBidJSON is the spec representation of the struct.
+type executionPayloadBidJSON struct {
+	ParentBlockHash        phase0.Hash32              `json:"parent_block_hash"`
+	ParentBlockRoot        phase0.Root                `json:"parent_block_root"`
+	BlockHash              phase0.Hash32              `json:"block_hash"`
+	FeeRecipient           bellatrix.ExecutionAddress `json:"fee_recipient"`
+	GasLimit               string                     `json:"gas_limit"`
+	BuilderIndex           phase0.ValidatorIndex      `json:"builder_index"`
+	Slot                   phase0.Slot                `json:"slot"`
+	Value                  phase0.Gwei                `json:"value"`
+	BlobKZGCommitmentsRoot phase0.Root                `json:"blob_kzg_commitments_root"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e *ExecutionPayloadBid) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&executionPayloadBidJSON{
+		ParentBlockHash:        e.ParentBlockHash,
+		ParentBlockRoot:        e.ParentBlockRoot,
+		BlockHash:              e.BlockHash,
+		FeeRecipient:           e.FeeRecipient,
+		GasLimit:               fmt.Sprintf("%d", e.GasLimit),
+		BuilderIndex:           e.BuilderIndex,
+		Slot:                   e.Slot,
+		Value:                  e.Value,
+		BlobKZGCommitmentsRoot: e.BlobKZGCommitmentsRoot,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *ExecutionPayloadBid) UnmarshalJSON(input []byte) error {
+	raw, err := codecs.RawJSON(&executionPayloadBidJSON{}, input)
+	if err != nil {
+		return err
+	}
+
+	if err := e.ParentBlockHash.UnmarshalJSON(raw["parent_block_hash"]); err != nil {
+		return errors.Wrap(err, "parent_block_hash")
+	}
+
+	if err := e.ParentBlockRoot.UnmarshalJSON(raw["parent_block_root"]); err != nil {
+		return errors.Wrap(err, "parent_block_root")
+	}
+
+	if err := e.BlockHash.UnmarshalJSON(raw["block_hash"]); err != nil {
+		return errors.Wrap(err, "block_hash")
+	}
+
+	if err := e.FeeRecipient.UnmarshalJSON(raw["fee_recipient"]); err != nil {
+		return errors.Wrap(err, "fee_recipient")
+	}
+
+	var gasLimit string
+	if err := json.Unmarshal(raw["gas_limit"], &gasLimit); err != nil {
+		return errors.Wrap(err, "gas_limit")
+	}
+
+	if e.GasLimit, err = strconv.ParseUint(gasLimit, 10, 64); err != nil {
+		return errors.Wrap(err, "gas_limit")
+	}
+
+	if err := e.BuilderIndex.UnmarshalJSON(raw["builder_index"]); err != nil {
+		return errors.Wrap(err, "builder_index")
+	}
+
+	if err := e.Slot.UnmarshalJSON(raw["slot"]); err != nil {
+		return errors.Wrap(err, "slot")
+	}
+
+	if err := e.Value.UnmarshalJSON(raw["value"]); err != nil {
+		return errors.Wrap(err, "value")
+	}
+
+	if err := e.BlobKZGCommitmentsRoot.UnmarshalJSON(raw["blob_kzg_commitments_root"]); err != nil {
+		return errors.Wrap(err, "blob_kzg_commitments_root")
+	}
+
+	return nil
+}
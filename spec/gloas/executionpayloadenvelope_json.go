@@ -0,0 +1,99 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gloas
+
+import (
+	"encoding/json"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// executionPayloadEnvelopeJSON is the spec representation of the struct.
+type executionPayloadEnvelopeJSON struct {
+	Payload            *deneb.ExecutionPayload    `json:"payload"`
+	ExecutionRequests  *electra.ExecutionRequests `json:"execution_requests"`
+	BuilderIndex       phase0.ValidatorIndex      `json:"builder_index"`
+	BeaconBlockRoot    phase0.Root                `json:"beacon_block_root"`
+	Slot               phase0.Slot                `json:"slot"`
+	BlobKZGCommitments []string                   `json:"blob_kzg_commitments"`
+	StateRoot          phase0.Root                `json:"state_root"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e *ExecutionPayloadEnvelope) MarshalJSON() ([]byte, error) {
+	blobKZGCommitments := make([]string, len(e.BlobKZGCommitments))
+	for i := range e.BlobKZGCommitments {
+		blobKZGCommitments[i] = e.BlobKZGCommitments[i].String()
+	}
+
+	return json.Marshal(&executionPayloadEnvelopeJSON{
+		Payload:            e.Payload,
+		ExecutionRequests:  e.ExecutionRequests,
+		BuilderIndex:       e.BuilderIndex,
+		BeaconBlockRoot:    e.BeaconBlockRoot,
+		Slot:               e.Slot,
+		BlobKZGCommitments: blobKZGCommitments,
+		StateRoot:          e.StateRoot,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *ExecutionPayloadEnvelope) UnmarshalJSON(input []byte) error {
+	raw, err := codecs.RawJSON(&executionPayloadEnvelopeJSON{}, input)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(raw["payload"], &e.Payload); err != nil {
+		return errors.Wrap(err, "payload")
+	}
+
+	if e.Payload == nil {
+		return errors.New("payload: missing")
+	}
+
+	if err := json.Unmarshal(raw["execution_requests"], &e.ExecutionRequests); err != nil {
+		return errors.Wrap(err, "execution_requests")
+	}
+
+	if e.ExecutionRequests == nil {
+		return errors.New("execution_requests: missing")
+	}
+
+	if err := e.BuilderIndex.UnmarshalJSON(raw["builder_index"]); err != nil {
+		return errors.Wrap(err, "builder_index")
+	}
+
+	if err := e.BeaconBlockRoot.UnmarshalJSON(raw["beacon_block_root"]); err != nil {
+		return errors.Wrap(err, "beacon_block_root")
+	}
+
+	if err := e.Slot.UnmarshalJSON(raw["slot"]); err != nil {
+		return errors.Wrap(err, "slot")
+	}
+
+	if err := json.Unmarshal(raw["blob_kzg_commitments"], &e.BlobKZGCommitments); err != nil {
+		return errors.Wrap(err, "blob_kzg_commitments")
+	}
+
+	if err := e.StateRoot.UnmarshalJSON(raw["state_root"]); err != nil {
+		return errors.Wrap(err, "state_root")
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gloas
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
+)
+
+// executionPayloadEnvelopeYAML is the spec representation of the struct.
+type executionPayloadEnvelopeYAML struct {
+	Payload            *deneb.ExecutionPayload    `yaml:"payload"`
+	ExecutionRequests  *electra.ExecutionRequests `yaml:"execution_requests"`
+	BuilderIndex       phase0.ValidatorIndex      `yaml:"builder_index"`
+	BeaconBlockRoot    phase0.Root                `yaml:"beacon_block_root"`
+	Slot               phase0.Slot                `yaml:"slot"`
+	BlobKZGCommitments []string                   `yaml:"blob_kzg_commitments"`
+	StateRoot          phase0.Root                `yaml:"state_root"`
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (e *ExecutionPayloadEnvelope) MarshalYAML() ([]byte, error) {
+	blobKZGCommitments := make([]string, len(e.BlobKZGCommitments))
+	for i := range e.BlobKZGCommitments {
+		blobKZGCommitments[i] = e.BlobKZGCommitments[i].String()
+	}
+
+	yamlBytes, err := yaml.MarshalWithOptions(&executionPayloadEnvelopeYAML{
+		Payload:            e.Payload,
+		ExecutionRequests:  e.ExecutionRequests,
+		BuilderIndex:       e.BuilderIndex,
+		BeaconBlockRoot:    e.BeaconBlockRoot,
+		Slot:               e.Slot,
+		BlobKZGCommitments: blobKZGCommitments,
+		StateRoot:          e.StateRoot,
+	}, yaml.Flow(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.ReplaceAll(yamlBytes, []byte(`"`), []byte(`'`)), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (e *ExecutionPayloadEnvelope) UnmarshalYAML(input []byte) error {
+	// This is very inefficient, but YAML is only used for spec tests so we do this
+	// rather than maintain a custom YAML unmarshaller.
+	var unmarshaled executionPayloadEnvelopeJSON
+	if err := yaml.Unmarshal(input, &unmarshaled); err != nil {
+		return errors.Wrap(err, "failed to unmarshal YAML")
+	}
+
+	marshaled, err := json.Marshal(&unmarshaled)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal JSON")
+	}
+
+	return e.UnmarshalJSON(marshaled)
+}
@@ -0,0 +1,64 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gloas
+
+import (
+	"encoding/json"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// indexedPayloadAttestationJSON is the spec representation of the struct.
+type indexedPayloadAttestationJSON struct {
+	AttestingIndices []phase0.ValidatorIndex `json:"attesting_indices"`
+	Data             *PayloadAttestationData `json:"data"`
+	Signature        phase0.BLSSignature     `json:"signature"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (i *IndexedPayloadAttestation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&indexedPayloadAttestationJSON{
+		AttestingIndices: i.AttestingIndices,
+		Data:             i.Data,
+		Signature:        i.Signature,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (i *IndexedPayloadAttestation) UnmarshalJSON(input []byte) error {
+	raw, err := codecs.RawJSON(&indexedPayloadAttestationJSON{}, input)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(raw["attesting_indices"], &i.AttestingIndices); err != nil {
+		return errors.Wrap(err, "attesting_indices")
+	}
+
+	if err := json.Unmarshal(raw["data"], &i.Data); err != nil {
+		return errors.Wrap(err, "data")
+	}
+
+	if i.Data == nil {
+		return errors.New("data: missing")
+	}
+
+	if err := i.Signature.UnmarshalJSON(raw["signature"]); err != nil {
+		return errors.Wrap(err, "signature")
+	}
+
+	return nil
+}
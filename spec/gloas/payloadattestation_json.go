@@ -0,0 +1,76 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gloas
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// payloadAttestationJSON is the spec representation of the struct.
+type payloadAttestationJSON struct {
+	AggregationBits string                  `json:"aggregation_bits"`
+	Data            *PayloadAttestationData `json:"data"`
+	Signature       phase0.BLSSignature     `json:"signature"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p *PayloadAttestation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&payloadAttestationJSON{
+		AggregationBits: fmt.Sprintf("%#x", []byte(p.AggregationBits)),
+		Data:            p.Data,
+		Signature:       p.Signature,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *PayloadAttestation) UnmarshalJSON(input []byte) error {
+	raw, err := codecs.RawJSON(&payloadAttestationJSON{}, input)
+	if err != nil {
+		return err
+	}
+
+	var aggregationBits string
+	if err := json.Unmarshal(raw["aggregation_bits"], &aggregationBits); err != nil {
+		return errors.Wrap(err, "aggregation_bits")
+	}
+
+	if p.AggregationBits, err = hex.DecodeString(strings.TrimPrefix(aggregationBits, "0x")); err != nil {
+		return errors.Wrap(err, "aggregation_bits")
+	}
+
+	if len(p.AggregationBits) != 64 {
+		return fmt.Errorf("aggregation_bits: incorrect length %d", len(p.AggregationBits))
+	}
+
+	if err := json.Unmarshal(raw["data"], &p.Data); err != nil {
+		return errors.Wrap(err, "data")
+	}
+
+	if p.Data == nil {
+		return errors.New("data: missing")
+	}
+
+	if err := p.Signature.UnmarshalJSON(raw["signature"]); err != nil {
+		return errors.Wrap(err, "signature")
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gloas
+
+import (
+	"encoding/json"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// payloadAttestationDataJSON is the spec representation of the struct.
+type payloadAttestationDataJSON struct {
+	BeaconBlockRoot   phase0.Root `json:"beacon_block_root"`
+	Slot              phase0.Slot `json:"slot"`
+	PayloadPresent    bool        `json:"payload_present"`
+	BlobDataAvailable bool        `json:"blob_data_available"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p *PayloadAttestationData) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&payloadAttestationDataJSON{
+		BeaconBlockRoot:   p.BeaconBlockRoot,
+		Slot:              p.Slot,
+		PayloadPresent:    p.PayloadPresent,
+		BlobDataAvailable: p.BlobDataAvailable,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *PayloadAttestationData) UnmarshalJSON(input []byte) error {
+	raw, err := codecs.RawJSON(&payloadAttestationDataJSON{}, input)
+	if err != nil {
+		return err
+	}
+
+	if err := p.BeaconBlockRoot.UnmarshalJSON(raw["beacon_block_root"]); err != nil {
+		return errors.Wrap(err, "beacon_block_root")
+	}
+
+	if err := p.Slot.UnmarshalJSON(raw["slot"]); err != nil {
+		return errors.Wrap(err, "slot")
+	}
+
+	if err := json.Unmarshal(raw["payload_present"], &p.PayloadPresent); err != nil {
+		return errors.Wrap(err, "payload_present")
+	}
+
+	if err := json.Unmarshal(raw["blob_data_available"], &p.BlobDataAvailable); err != nil {
+		return errors.Wrap(err, "blob_data_available")
+	}
+
+	return nil
+}
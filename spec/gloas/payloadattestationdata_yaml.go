@@ -0,0 +1,63 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gloas
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
+)
+
+// payloadAttestationDataYAML is the spec representation of the struct.
+type payloadAttestationDataYAML struct {
+	BeaconBlockRoot   phase0.Root `yaml:"beacon_block_root"`
+	Slot              phase0.Slot `yaml:"slot"`
+	PayloadPresent    bool        `yaml:"payload_present"`
+	BlobDataAvailable bool        `yaml:"blob_data_available"`
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (p *PayloadAttestationData) MarshalYAML() ([]byte, error) {
+	yamlBytes, err := yaml.MarshalWithOptions(&payloadAttestationDataYAML{
+		BeaconBlockRoot:   p.BeaconBlockRoot,
+		Slot:              p.Slot,
+		PayloadPresent:    p.PayloadPresent,
+		BlobDataAvailable: p.BlobDataAvailable,
+	}, yaml.Flow(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.ReplaceAll(yamlBytes, []byte(`"`), []byte(`'`)), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (p *PayloadAttestationData) UnmarshalYAML(input []byte) error {
+	// This is very inefficient, but YAML is only used for spec tests so we do this
+	// rather than maintain a custom YAML unmarshaller.
+	var unmarshaled payloadAttestationDataJSON
+	if err := yaml.Unmarshal(input, &unmarshaled); err != nil {
+		return errors.Wrap(err, "failed to unmarshal YAML")
+	}
+
+	marshaled, err := json.Marshal(&unmarshaled)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal JSON")
+	}
+
+	return p.UnmarshalJSON(marshaled)
+}
@@ -0,0 +1,58 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gloas
+
+import (
+	"encoding/json"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// signedExecutionPayloadBidJSON is the spec representation of the struct.
+type signedExecutionPayloadBidJSON struct {
+	Message   *ExecutionPayloadBid `json:"message"`
+	Signature phase0.BLSSignature  `json:"signature"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s *SignedExecutionPayloadBid) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&signedExecutionPayloadBidJSON{
+		Message:   s.Message,
+		Signature: s.Signature,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *SignedExecutionPayloadBid) UnmarshalJSON(input []byte) error {
+	raw, err := codecs.RawJSON(&signedExecutionPayloadBidJSON{}, input)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(raw["message"], &s.Message); err != nil {
+		return errors.Wrap(err, "message")
+	}
+
+	if s.Message == nil {
+		return errors.New("message: missing")
+	}
+
+	if err := s.Signature.UnmarshalJSON(raw["signature"]); err != nil {
+		return errors.Wrap(err, "signature")
+	}
+
+	return nil
+}
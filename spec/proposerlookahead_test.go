@@ -0,0 +1,109 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/fulu"
+	"github.com/attestantio/go-eth2-client/spec/gloas"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProposerLookahead(t *testing.T) {
+	lookahead := make([]phase0.ValidatorIndex, 64)
+	for i := range lookahead {
+		lookahead[i] = phase0.ValidatorIndex(1000 + i)
+	}
+
+	tests := []struct {
+		name     string
+		state    *spec.VersionedBeaconState
+		expected []*spec.ProposerLookaheadDuty
+		err      string
+	}{
+		{
+			name: "Electra",
+			state: &spec.VersionedBeaconState{
+				Version: spec.DataVersionElectra,
+				Electra: &electra.BeaconState{},
+			},
+			err: "state does not provide proposer lookahead",
+		},
+		{
+			name: "FuluMissing",
+			state: &spec.VersionedBeaconState{
+				Version: spec.DataVersionFulu,
+			},
+			err: "no Fulu state",
+		},
+		{
+			name: "FuluEmpty",
+			state: &spec.VersionedBeaconState{
+				Version: spec.DataVersionFulu,
+				Fulu:    &fulu.BeaconState{},
+			},
+			err: "proposer lookahead is empty",
+		},
+		{
+			name: "UnknownVersion",
+			state: &spec.VersionedBeaconState{
+				Version: spec.DataVersion(99),
+			},
+			err: "unknown version",
+		},
+		{
+			name: "Fulu",
+			state: &spec.VersionedBeaconState{
+				Version: spec.DataVersionFulu,
+				Fulu: &fulu.BeaconState{
+					Slot:              100,
+					ProposerLookahead: lookahead,
+				},
+			},
+		},
+		{
+			name: "Gloas",
+			state: &spec.VersionedBeaconState{
+				Version: spec.DataVersionGloas,
+				Gloas: &gloas.BeaconState{
+					Slot:              100,
+					ProposerLookahead: lookahead,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			duties, err := test.state.ProposerLookahead()
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, duties, len(lookahead))
+			for i := range duties {
+				// Slot 100 is in the epoch starting at slot 96.
+				require.Equal(t, phase0.Slot(96+i), duties[i].Slot)
+				require.Equal(t, lookahead[i], duties[i].ValidatorIndex)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import "github.com/attestantio/go-eth2-client/spec/phase0"
+
+// minSeedLookahead is the number of epochs beyond the current epoch covered by the proposer lookahead.
+const minSeedLookahead = 1
+
+// ProposerLookaheadDuty is a duty of a validator to propose a slot, as obtained from a state's proposer lookahead.
+type ProposerLookaheadDuty struct {
+	Slot           phase0.Slot
+	ValidatorIndex phase0.ValidatorIndex
+}
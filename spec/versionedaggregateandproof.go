@@ -30,6 +30,7 @@ type VersionedAggregateAndProof struct {
 	Deneb     *phase0.AggregateAndProof
 	Electra   *electra.AggregateAndProof
 	Fulu      *electra.AggregateAndProof
+	Gloas     *electra.AggregateAndProof
 }
 
 // AggregatorIndex returns the aggregator index of the aggregate.
@@ -77,6 +78,12 @@ func (v *VersionedAggregateAndProof) AggregatorIndex() (phase0.ValidatorIndex, e
 		}
 
 		return v.Fulu.AggregatorIndex, nil
+	case DataVersionGloas:
+		if v.Gloas == nil {
+			return 0, errors.New("no gloas aggregate and proof")
+		}
+
+		return v.Gloas.AggregatorIndex, nil
 	default:
 		return 0, errors.New("unknown version for aggregate and proof")
 	}
@@ -127,6 +134,12 @@ func (v *VersionedAggregateAndProof) HashTreeRoot() ([32]byte, error) {
 		}
 
 		return v.Fulu.HashTreeRoot()
+	case DataVersionGloas:
+		if v.Gloas == nil {
+			return [32]byte{}, errors.New("no gloas aggregate and proof")
+		}
+
+		return v.Gloas.HashTreeRoot()
 	default:
 		return [32]byte{}, errors.New("unknown version")
 	}
@@ -135,7 +148,8 @@ func (v *VersionedAggregateAndProof) HashTreeRoot() ([32]byte, error) {
 // IsEmpty returns true if there is no aggregate and proof.
 func (v *VersionedAggregateAndProof) IsEmpty() bool {
 	return v.Phase0 == nil && v.Altair == nil && v.Bellatrix == nil &&
-		v.Capella == nil && v.Deneb == nil && v.Electra == nil && v.Fulu == nil
+		v.Capella == nil && v.Deneb == nil && v.Electra == nil &&
+		v.Fulu == nil && v.Gloas == nil
 }
 
 // String returns a string version of the structure.
@@ -183,6 +197,12 @@ func (v *VersionedAggregateAndProof) String() string {
 		}
 
 		return v.Fulu.String()
+	case DataVersionGloas:
+		if v.Gloas == nil {
+			return ""
+		}
+
+		return v.Gloas.String()
 	default:
 		return "unknown version"
 	}
@@ -233,6 +253,12 @@ func (v *VersionedAggregateAndProof) SelectionProof() (phase0.BLSSignature, erro
 		}
 
 		return v.Fulu.SelectionProof, nil
+	case DataVersionGloas:
+		if v.Gloas == nil {
+			return phase0.BLSSignature{}, errors.New("no gloas aggregate and proof")
+		}
+
+		return v.Gloas.SelectionProof, nil
 	default:
 		return phase0.BLSSignature{}, errors.New("unknown version")
 	}
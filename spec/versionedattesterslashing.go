@@ -30,12 +30,14 @@ type VersionedAttesterSlashing struct {
 	Deneb     *phase0.AttesterSlashing
 	Electra   *electra.AttesterSlashing
 	Fulu      *electra.AttesterSlashing
+	Gloas     *electra.AttesterSlashing
 }
 
 // IsEmpty returns true if there is no block.
 func (v *VersionedAttesterSlashing) IsEmpty() bool {
 	return v.Phase0 == nil && v.Altair == nil && v.Bellatrix == nil &&
-		v.Capella == nil && v.Deneb == nil && v.Electra == nil && v.Fulu == nil
+		v.Capella == nil && v.Deneb == nil && v.Electra == nil &&
+		v.Fulu == nil && v.Gloas == nil
 }
 
 // Attestation1 returns the first indexed attestation.
@@ -117,6 +119,17 @@ func (v *VersionedAttesterSlashing) Attestation1() (*VersionedIndexedAttestation
 			Fulu:    v.Fulu.Attestation1,
 		}
 
+		return &versionedIndexedAttestation, nil
+	case DataVersionGloas:
+		if v.Gloas == nil {
+			return nil, errors.New("no Gloas indexed attestation")
+		}
+
+		versionedIndexedAttestation := VersionedIndexedAttestation{
+			Version: DataVersionGloas,
+			Gloas:   v.Gloas.Attestation1,
+		}
+
 		return &versionedIndexedAttestation, nil
 	default:
 		return nil, errors.New("unknown version")
@@ -202,6 +215,17 @@ func (v *VersionedAttesterSlashing) Attestation2() (*VersionedIndexedAttestation
 			Fulu:    v.Fulu.Attestation2,
 		}
 
+		return &versionedIndexedAttestation, nil
+	case DataVersionGloas:
+		if v.Gloas == nil {
+			return nil, errors.New("no Gloas indexed attestation")
+		}
+
+		versionedIndexedAttestation := VersionedIndexedAttestation{
+			Version: DataVersionGloas,
+			Gloas:   v.Gloas.Attestation2,
+		}
+
 		return &versionedIndexedAttestation, nil
 	default:
 		return nil, errors.New("unknown version")
@@ -253,6 +277,12 @@ func (v *VersionedAttesterSlashing) String() string {
 		}
 
 		return v.Fulu.String()
+	case DataVersionGloas:
+		if v.Gloas == nil {
+			return ""
+		}
+
+		return v.Gloas.String()
 	default:
 		return "unknown version"
 	}
@@ -22,6 +22,7 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/deneb"
 	"github.com/attestantio/go-eth2-client/spec/electra"
 	"github.com/attestantio/go-eth2-client/spec/fulu"
+	"github.com/attestantio/go-eth2-client/spec/gloas"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	proofutil "github.com/attestantio/go-eth2-client/util/proof"
 	dynssz "github.com/pk910/dynamic-ssz"
@@ -38,12 +39,14 @@ type VersionedBeaconState struct {
 	Deneb     *deneb.BeaconState
 	Electra   *electra.BeaconState
 	Fulu      *fulu.BeaconState
+	Gloas     *gloas.BeaconState
 }
 
 // IsEmpty returns true if there is no block.
 func (v *VersionedBeaconState) IsEmpty() bool {
 	return v.Phase0 == nil && v.Altair == nil && v.Bellatrix == nil &&
-		v.Capella == nil && v.Deneb == nil && v.Electra == nil && v.Fulu == nil
+		v.Capella == nil && v.Deneb == nil && v.Electra == nil &&
+		v.Fulu == nil && v.Gloas == nil
 }
 
 // Slot returns the slot of the state.
@@ -91,6 +94,12 @@ func (v *VersionedBeaconState) Slot() (phase0.Slot, error) {
 		}
 
 		return v.Fulu.Slot, nil
+	case DataVersionGloas:
+		if v.Gloas == nil {
+			return 0, errors.New("no Gloas state")
+		}
+
+		return v.Gloas.Slot, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -125,6 +134,12 @@ func (v *VersionedBeaconState) NextWithdrawalValidatorIndex() (phase0.ValidatorI
 		}
 
 		return v.Fulu.NextWithdrawalValidatorIndex, nil
+	case DataVersionGloas:
+		if v.Gloas == nil {
+			return 0, errors.New("no Gloas state")
+		}
+
+		return v.Gloas.NextWithdrawalValidatorIndex, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -175,6 +190,12 @@ func (v *VersionedBeaconState) Validators() ([]*phase0.Validator, error) {
 		}
 
 		return v.Fulu.Validators, nil
+	case DataVersionGloas:
+		if v.Gloas == nil {
+			return nil, errors.New("no Gloas state")
+		}
+
+		return v.Gloas.Validators, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -225,6 +246,12 @@ func (v *VersionedBeaconState) ValidatorBalances() ([]phase0.Gwei, error) {
 		}
 
 		return v.Fulu.Balances, nil
+	case DataVersionGloas:
+		if v.Gloas == nil {
+			return nil, errors.New("no Gloas state")
+		}
+
+		return v.Gloas.Balances, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -247,6 +274,12 @@ func (v *VersionedBeaconState) DepositRequestsStartIndex() (uint64, error) {
 		}
 
 		return v.Fulu.DepositRequestsStartIndex, nil
+	case DataVersionGloas:
+		if v.Gloas == nil {
+			return 0, errors.New("no Gloas state")
+		}
+
+		return v.Gloas.DepositRequestsStartIndex, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -269,6 +302,12 @@ func (v *VersionedBeaconState) DepositBalanceToConsume() (phase0.Gwei, error) {
 		}
 
 		return v.Fulu.DepositBalanceToConsume, nil
+	case DataVersionGloas:
+		if v.Gloas == nil {
+			return 0, errors.New("no Gloas state")
+		}
+
+		return v.Gloas.DepositBalanceToConsume, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -291,6 +330,12 @@ func (v *VersionedBeaconState) ExitBalanceToConsume() (phase0.Gwei, error) {
 		}
 
 		return v.Fulu.ExitBalanceToConsume, nil
+	case DataVersionGloas:
+		if v.Gloas == nil {
+			return 0, errors.New("no Gloas state")
+		}
+
+		return v.Gloas.ExitBalanceToConsume, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -313,6 +358,12 @@ func (v *VersionedBeaconState) EarliestExitEpoch() (phase0.Epoch, error) {
 		}
 
 		return v.Fulu.EarliestExitEpoch, nil
+	case DataVersionGloas:
+		if v.Gloas == nil {
+			return 0, errors.New("no Gloas state")
+		}
+
+		return v.Gloas.EarliestExitEpoch, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -335,6 +386,12 @@ func (v *VersionedBeaconState) ConsolidationBalanceToConsume() (phase0.Gwei, err
 		}
 
 		return v.Fulu.ConsolidationBalanceToConsume, nil
+	case DataVersionGloas:
+		if v.Gloas == nil {
+			return 0, errors.New("no Gloas state")
+		}
+
+		return v.Gloas.ConsolidationBalanceToConsume, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -357,6 +414,12 @@ func (v *VersionedBeaconState) EarliestConsolidationEpoch() (phase0.Epoch, error
 		}
 
 		return v.Fulu.EarliestConsolidationEpoch, nil
+	case DataVersionGloas:
+		if v.Gloas == nil {
+			return 0, errors.New("no Gloas state")
+		}
+
+		return v.Gloas.EarliestConsolidationEpoch, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -379,6 +442,12 @@ func (v *VersionedBeaconState) PendingDeposits() ([]*electra.PendingDeposit, err
 		}
 
 		return v.Fulu.PendingDeposits, nil
+	case DataVersionGloas:
+		if v.Gloas == nil {
+			return nil, errors.New("no Gloas state")
+		}
+
+		return v.Gloas.PendingDeposits, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -401,6 +470,12 @@ func (v *VersionedBeaconState) PendingPartialWithdrawals() ([]*electra.PendingPa
 		}
 
 		return v.Fulu.PendingPartialWithdrawals, nil
+	case DataVersionGloas:
+		if v.Gloas == nil {
+			return nil, errors.New("no Gloas state")
+		}
+
+		return v.Gloas.PendingPartialWithdrawals, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -423,11 +498,64 @@ func (v *VersionedBeaconState) PendingConsolidations() ([]*electra.PendingConsol
 		}
 
 		return v.Fulu.PendingConsolidations, nil
+	case DataVersionGloas:
+		if v.Gloas == nil {
+			return nil, errors.New("no Gloas state")
+		}
+
+		return v.Gloas.PendingConsolidations, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
 }
 
+// ProposerLookahead returns the proposers of the state's proposer lookahead.
+// The lookahead starts at the first slot of the state's epoch, and covers the following
+// MIN_SEED_LOOKAHEAD epochs in addition.
+func (v *VersionedBeaconState) ProposerLookahead() ([]*ProposerLookaheadDuty, error) {
+	var (
+		slot      phase0.Slot
+		lookahead []phase0.ValidatorIndex
+	)
+
+	switch v.Version {
+	case DataVersionPhase0, DataVersionAltair, DataVersionBellatrix, DataVersionCapella, DataVersionDeneb, DataVersionElectra:
+		return nil, errors.New("state does not provide proposer lookahead")
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return nil, errors.New("no Fulu state")
+		}
+
+		slot = v.Fulu.Slot
+		lookahead = v.Fulu.ProposerLookahead
+	case DataVersionGloas:
+		if v.Gloas == nil {
+			return nil, errors.New("no Gloas state")
+		}
+
+		slot = v.Gloas.Slot
+		lookahead = v.Gloas.ProposerLookahead
+	default:
+		return nil, errors.New("unknown version")
+	}
+
+	slotsPerEpoch := phase0.Slot(len(lookahead) / (minSeedLookahead + 1))
+	if slotsPerEpoch == 0 {
+		return nil, errors.New("proposer lookahead is empty")
+	}
+
+	startSlot := slot - slot%slotsPerEpoch
+	duties := make([]*ProposerLookaheadDuty, len(lookahead))
+	for i := range lookahead {
+		duties[i] = &ProposerLookaheadDuty{
+			Slot:           startSlot + phase0.Slot(i),
+			ValidatorIndex: lookahead[i],
+		}
+	}
+
+	return duties, nil
+}
+
 // ValidatorAtIndex returns the validator at the given index.
 // This is a convenience method that handles accessing the validators array.
 // Parameters:
@@ -515,6 +643,12 @@ func (v *VersionedBeaconState) GetTree() (*treeproof.Node, error) {
 		}
 
 		return dynssz.GetGlobalDynSsz().GetTree(v.Fulu)
+	case DataVersionGloas:
+		if v.Gloas == nil {
+			return nil, errors.New("no Gloas state")
+		}
+
+		return dynssz.GetGlobalDynSsz().GetTree(v.Gloas)
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -565,6 +699,12 @@ func (v *VersionedBeaconState) HashTreeRoot() (phase0.Hash32, error) {
 		}
 
 		return v.Fulu.HashTreeRoot()
+	case DataVersionGloas:
+		if v.Gloas == nil {
+			return phase0.Hash32{}, errors.New("no Gloas state")
+		}
+
+		return v.Gloas.HashTreeRoot()
 	default:
 		return phase0.Hash32{}, errors.New("unknown version")
 	}
@@ -617,6 +757,12 @@ func (v *VersionedBeaconState) FieldIndex(name string) (int, error) {
 		}
 
 		return proofutil.FieldIndex(v.Fulu, name)
+	case DataVersionGloas:
+		if v.Gloas == nil {
+			return 0, errors.New("no Gloas state")
+		}
+
+		return proofutil.FieldIndex(v.Gloas, name)
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -670,6 +816,12 @@ func (v *VersionedBeaconState) FieldGeneralizedIndex(name string) (int, error) {
 		}
 
 		return proofutil.FieldGeneralizedIndex(v.Fulu, name)
+	case DataVersionGloas:
+		if v.Gloas == nil {
+			return 0, errors.New("no Gloas state")
+		}
+
+		return proofutil.FieldGeneralizedIndex(v.Gloas, name)
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -829,6 +981,12 @@ func (v *VersionedBeaconState) String() string {
 		}
 
 		return v.Fulu.String()
+	case DataVersionGloas:
+		if v.Gloas == nil {
+			return ""
+		}
+
+		return v.Gloas.String()
 	default:
 		return "unknown version"
 	}
@@ -30,12 +30,14 @@ type VersionedIndexedAttestation struct {
 	Deneb     *phase0.IndexedAttestation
 	Electra   *electra.IndexedAttestation
 	Fulu      *electra.IndexedAttestation
+	Gloas     *electra.IndexedAttestation
 }
 
 // IsEmpty returns true if there is no block.
 func (v *VersionedIndexedAttestation) IsEmpty() bool {
 	return v.Phase0 == nil && v.Altair == nil && v.Bellatrix == nil &&
-		v.Capella == nil && v.Deneb == nil && v.Electra == nil && v.Fulu == nil
+		v.Capella == nil && v.Deneb == nil && v.Electra == nil &&
+		v.Fulu == nil && v.Gloas == nil
 }
 
 // AttestingIndices returns the attesting indices of the indexed attestation.
@@ -83,6 +85,12 @@ func (v *VersionedIndexedAttestation) AttestingIndices() ([]uint64, error) {
 		}
 
 		return v.Fulu.AttestingIndices, nil
+	case DataVersionGloas:
+		if v.Gloas == nil {
+			return nil, errors.New("no Gloas indexed attestation")
+		}
+
+		return v.Gloas.AttestingIndices, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -133,6 +141,12 @@ func (v *VersionedIndexedAttestation) Data() (*phase0.AttestationData, error) {
 		}
 
 		return v.Fulu.Data, nil
+	case DataVersionGloas:
+		if v.Gloas == nil {
+			return nil, errors.New("no Gloas indexed attestation")
+		}
+
+		return v.Gloas.Data, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -183,6 +197,12 @@ func (v *VersionedIndexedAttestation) Signature() (phase0.BLSSignature, error) {
 		}
 
 		return v.Fulu.Signature, nil
+	case DataVersionGloas:
+		if v.Gloas == nil {
+			return phase0.BLSSignature{}, errors.New("no Gloas indexed attestation")
+		}
+
+		return v.Gloas.Signature, nil
 	default:
 		return phase0.BLSSignature{}, errors.New("unknown version")
 	}
@@ -233,6 +253,12 @@ func (v *VersionedIndexedAttestation) String() string {
 		}
 
 		return v.Fulu.String()
+	case DataVersionGloas:
+		if v.Gloas == nil {
+			return ""
+		}
+
+		return v.Gloas.String()
 	default:
 		return "unknown version"
 	}
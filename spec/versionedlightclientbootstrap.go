@@ -0,0 +1,193 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// VersionedLightClientBootstrap contains a versioned light client bootstrap.
+type VersionedLightClientBootstrap struct {
+	Version   DataVersion
+	Altair    *altair.LightClientBootstrap
+	Bellatrix *altair.LightClientBootstrap
+	Capella   *capella.LightClientBootstrap
+	Deneb     *deneb.LightClientBootstrap
+	Electra   *electra.LightClientBootstrap
+	Fulu      *electra.LightClientBootstrap
+	Gloas     *electra.LightClientBootstrap
+}
+
+// IsEmpty returns true if there is no bootstrap.
+func (v *VersionedLightClientBootstrap) IsEmpty() bool {
+	return v.Altair == nil && v.Bellatrix == nil && v.Capella == nil &&
+		v.Deneb == nil && v.Electra == nil &&
+		v.Fulu == nil && v.Gloas == nil
+}
+
+// Header returns the beacon block header of the bootstrap.
+func (v *VersionedLightClientBootstrap) Header() (*phase0.BeaconBlockHeader, error) {
+	switch v.Version {
+	case DataVersionAltair:
+		if v.Altair == nil || v.Altair.Header == nil || v.Altair.Header.Beacon == nil {
+			return nil, errors.New("no altair bootstrap")
+		}
+
+		return v.Altair.Header.Beacon, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Header == nil || v.Bellatrix.Header.Beacon == nil {
+			return nil, errors.New("no bellatrix bootstrap")
+		}
+
+		return v.Bellatrix.Header.Beacon, nil
+	case DataVersionCapella:
+		if v.Capella == nil || v.Capella.Header == nil || v.Capella.Header.Beacon == nil {
+			return nil, errors.New("no capella bootstrap")
+		}
+
+		return v.Capella.Header.Beacon, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.Header == nil || v.Deneb.Header.Beacon == nil {
+			return nil, errors.New("no deneb bootstrap")
+		}
+
+		return v.Deneb.Header.Beacon, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Header == nil || v.Electra.Header.Beacon == nil {
+			return nil, errors.New("no electra bootstrap")
+		}
+
+		return v.Electra.Header.Beacon, nil
+	case DataVersionFulu:
+		if v.Fulu == nil || v.Fulu.Header == nil || v.Fulu.Header.Beacon == nil {
+			return nil, errors.New("no fulu bootstrap")
+		}
+
+		return v.Fulu.Header.Beacon, nil
+	case DataVersionGloas:
+		if v.Gloas == nil || v.Gloas.Header == nil || v.Gloas.Header.Beacon == nil {
+			return nil, errors.New("no gloas bootstrap")
+		}
+
+		return v.Gloas.Header.Beacon, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// CurrentSyncCommittee returns the current sync committee of the bootstrap.
+func (v *VersionedLightClientBootstrap) CurrentSyncCommittee() (*altair.SyncCommittee, error) {
+	switch v.Version {
+	case DataVersionAltair:
+		if v.Altair == nil || v.Altair.CurrentSyncCommittee == nil {
+			return nil, errors.New("no altair bootstrap")
+		}
+
+		return v.Altair.CurrentSyncCommittee, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.CurrentSyncCommittee == nil {
+			return nil, errors.New("no bellatrix bootstrap")
+		}
+
+		return v.Bellatrix.CurrentSyncCommittee, nil
+	case DataVersionCapella:
+		if v.Capella == nil || v.Capella.CurrentSyncCommittee == nil {
+			return nil, errors.New("no capella bootstrap")
+		}
+
+		return v.Capella.CurrentSyncCommittee, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.CurrentSyncCommittee == nil {
+			return nil, errors.New("no deneb bootstrap")
+		}
+
+		return v.Deneb.CurrentSyncCommittee, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.CurrentSyncCommittee == nil {
+			return nil, errors.New("no electra bootstrap")
+		}
+
+		return v.Electra.CurrentSyncCommittee, nil
+	case DataVersionFulu:
+		if v.Fulu == nil || v.Fulu.CurrentSyncCommittee == nil {
+			return nil, errors.New("no fulu bootstrap")
+		}
+
+		return v.Fulu.CurrentSyncCommittee, nil
+	case DataVersionGloas:
+		if v.Gloas == nil || v.Gloas.CurrentSyncCommittee == nil {
+			return nil, errors.New("no gloas bootstrap")
+		}
+
+		return v.Gloas.CurrentSyncCommittee, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// String returns a string version of the structure.
+func (v *VersionedLightClientBootstrap) String() string {
+	switch v.Version {
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return ""
+		}
+
+		return v.Altair.String()
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return ""
+		}
+
+		return v.Bellatrix.String()
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return ""
+		}
+
+		return v.Capella.String()
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return ""
+		}
+
+		return v.Deneb.String()
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return ""
+		}
+
+		return v.Electra.String()
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return ""
+		}
+
+		return v.Fulu.String()
+	case DataVersionGloas:
+		if v.Gloas == nil {
+			return ""
+		}
+
+		return v.Gloas.String()
+	default:
+		return "unknown version"
+	}
+}
@@ -0,0 +1,293 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// VersionedLightClientFinalityUpdate contains a versioned light client finality update.
+type VersionedLightClientFinalityUpdate struct {
+	Version   DataVersion
+	Altair    *altair.LightClientFinalityUpdate
+	Bellatrix *altair.LightClientFinalityUpdate
+	Capella   *capella.LightClientFinalityUpdate
+	Deneb     *deneb.LightClientFinalityUpdate
+	Electra   *electra.LightClientFinalityUpdate
+	Fulu      *electra.LightClientFinalityUpdate
+	Gloas     *electra.LightClientFinalityUpdate
+}
+
+// IsEmpty returns true if there is no finality update.
+func (v *VersionedLightClientFinalityUpdate) IsEmpty() bool {
+	return v.Altair == nil && v.Bellatrix == nil && v.Capella == nil &&
+		v.Deneb == nil && v.Electra == nil &&
+		v.Fulu == nil && v.Gloas == nil
+}
+
+// AttestedHeader returns the beacon block header attested to by the update.
+func (v *VersionedLightClientFinalityUpdate) AttestedHeader() (*phase0.BeaconBlockHeader, error) {
+	switch v.Version {
+	case DataVersionAltair:
+		if v.Altair == nil || v.Altair.AttestedHeader == nil || v.Altair.AttestedHeader.Beacon == nil {
+			return nil, errors.New("no altair finality update")
+		}
+
+		return v.Altair.AttestedHeader.Beacon, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.AttestedHeader == nil || v.Bellatrix.AttestedHeader.Beacon == nil {
+			return nil, errors.New("no bellatrix finality update")
+		}
+
+		return v.Bellatrix.AttestedHeader.Beacon, nil
+	case DataVersionCapella:
+		if v.Capella == nil || v.Capella.AttestedHeader == nil || v.Capella.AttestedHeader.Beacon == nil {
+			return nil, errors.New("no capella finality update")
+		}
+
+		return v.Capella.AttestedHeader.Beacon, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.AttestedHeader == nil || v.Deneb.AttestedHeader.Beacon == nil {
+			return nil, errors.New("no deneb finality update")
+		}
+
+		return v.Deneb.AttestedHeader.Beacon, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.AttestedHeader == nil || v.Electra.AttestedHeader.Beacon == nil {
+			return nil, errors.New("no electra finality update")
+		}
+
+		return v.Electra.AttestedHeader.Beacon, nil
+	case DataVersionFulu:
+		if v.Fulu == nil || v.Fulu.AttestedHeader == nil || v.Fulu.AttestedHeader.Beacon == nil {
+			return nil, errors.New("no fulu finality update")
+		}
+
+		return v.Fulu.AttestedHeader.Beacon, nil
+	case DataVersionGloas:
+		if v.Gloas == nil || v.Gloas.AttestedHeader == nil || v.Gloas.AttestedHeader.Beacon == nil {
+			return nil, errors.New("no gloas finality update")
+		}
+
+		return v.Gloas.AttestedHeader.Beacon, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// FinalizedHeader returns the finalized beacon block header of the update.
+func (v *VersionedLightClientFinalityUpdate) FinalizedHeader() (*phase0.BeaconBlockHeader, error) {
+	switch v.Version {
+	case DataVersionAltair:
+		if v.Altair == nil || v.Altair.FinalizedHeader == nil || v.Altair.FinalizedHeader.Beacon == nil {
+			return nil, errors.New("no altair finality update")
+		}
+
+		return v.Altair.FinalizedHeader.Beacon, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.FinalizedHeader == nil || v.Bellatrix.FinalizedHeader.Beacon == nil {
+			return nil, errors.New("no bellatrix finality update")
+		}
+
+		return v.Bellatrix.FinalizedHeader.Beacon, nil
+	case DataVersionCapella:
+		if v.Capella == nil || v.Capella.FinalizedHeader == nil || v.Capella.FinalizedHeader.Beacon == nil {
+			return nil, errors.New("no capella finality update")
+		}
+
+		return v.Capella.FinalizedHeader.Beacon, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.FinalizedHeader == nil || v.Deneb.FinalizedHeader.Beacon == nil {
+			return nil, errors.New("no deneb finality update")
+		}
+
+		return v.Deneb.FinalizedHeader.Beacon, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.FinalizedHeader == nil || v.Electra.FinalizedHeader.Beacon == nil {
+			return nil, errors.New("no electra finality update")
+		}
+
+		return v.Electra.FinalizedHeader.Beacon, nil
+	case DataVersionFulu:
+		if v.Fulu == nil || v.Fulu.FinalizedHeader == nil || v.Fulu.FinalizedHeader.Beacon == nil {
+			return nil, errors.New("no fulu finality update")
+		}
+
+		return v.Fulu.FinalizedHeader.Beacon, nil
+	case DataVersionGloas:
+		if v.Gloas == nil || v.Gloas.FinalizedHeader == nil || v.Gloas.FinalizedHeader.Beacon == nil {
+			return nil, errors.New("no gloas finality update")
+		}
+
+		return v.Gloas.FinalizedHeader.Beacon, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// SyncAggregate returns the sync aggregate of the update.
+func (v *VersionedLightClientFinalityUpdate) SyncAggregate() (*altair.SyncAggregate, error) {
+	switch v.Version {
+	case DataVersionAltair:
+		if v.Altair == nil || v.Altair.SyncAggregate == nil {
+			return nil, errors.New("no altair finality update")
+		}
+
+		return v.Altair.SyncAggregate, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.SyncAggregate == nil {
+			return nil, errors.New("no bellatrix finality update")
+		}
+
+		return v.Bellatrix.SyncAggregate, nil
+	case DataVersionCapella:
+		if v.Capella == nil || v.Capella.SyncAggregate == nil {
+			return nil, errors.New("no capella finality update")
+		}
+
+		return v.Capella.SyncAggregate, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.SyncAggregate == nil {
+			return nil, errors.New("no deneb finality update")
+		}
+
+		return v.Deneb.SyncAggregate, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.SyncAggregate == nil {
+			return nil, errors.New("no electra finality update")
+		}
+
+		return v.Electra.SyncAggregate, nil
+	case DataVersionFulu:
+		if v.Fulu == nil || v.Fulu.SyncAggregate == nil {
+			return nil, errors.New("no fulu finality update")
+		}
+
+		return v.Fulu.SyncAggregate, nil
+	case DataVersionGloas:
+		if v.Gloas == nil || v.Gloas.SyncAggregate == nil {
+			return nil, errors.New("no gloas finality update")
+		}
+
+		return v.Gloas.SyncAggregate, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// SignatureSlot returns the signature slot of the update.
+func (v *VersionedLightClientFinalityUpdate) SignatureSlot() (phase0.Slot, error) {
+	switch v.Version {
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return 0, errors.New("no altair finality update")
+		}
+
+		return v.Altair.SignatureSlot, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return 0, errors.New("no bellatrix finality update")
+		}
+
+		return v.Bellatrix.SignatureSlot, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return 0, errors.New("no capella finality update")
+		}
+
+		return v.Capella.SignatureSlot, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return 0, errors.New("no deneb finality update")
+		}
+
+		return v.Deneb.SignatureSlot, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no electra finality update")
+		}
+
+		return v.Electra.SignatureSlot, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return 0, errors.New("no fulu finality update")
+		}
+
+		return v.Fulu.SignatureSlot, nil
+	case DataVersionGloas:
+		if v.Gloas == nil {
+			return 0, errors.New("no gloas finality update")
+		}
+
+		return v.Gloas.SignatureSlot, nil
+	default:
+		return 0, errors.New("unknown version")
+	}
+}
+
+// String returns a string version of the structure.
+func (v *VersionedLightClientFinalityUpdate) String() string {
+	switch v.Version {
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return ""
+		}
+
+		return v.Altair.String()
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return ""
+		}
+
+		return v.Bellatrix.String()
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return ""
+		}
+
+		return v.Capella.String()
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return ""
+		}
+
+		return v.Deneb.String()
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return ""
+		}
+
+		return v.Electra.String()
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return ""
+		}
+
+		return v.Fulu.String()
+	case DataVersionGloas:
+		if v.Gloas == nil {
+			return ""
+		}
+
+		return v.Gloas.String()
+	default:
+		return "unknown version"
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionedLightClientOptimisticUpdateJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "Empty",
+			input: []byte{},
+			err:   "unexpected end of JSON input",
+		},
+		{
+			name:  "VersionMissing",
+			input: []byte(`{"data":{"attested_header":{"beacon":{"slot":"1","proposer_index":"2","parent_root":"0x0101010101010101010101010101010101010101010101010101010101010101","state_root":"0x0202020202020202020202020202020202020202020202020202020202020202","body_root":"0x0303030303030303030303030303030303030303030303030303030303030303"}},"sync_aggregate":{"sync_committee_bits":"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff","sync_committee_signature":"0x8e1111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111"},"signature_slot":"2"}}`),
+			err:   "unsupported version unknown",
+		},
+		{
+			name:  "VersionUnsupported",
+			input: []byte(`{"version":"phase0","data":{"attested_header":{"beacon":{"slot":"1","proposer_index":"2","parent_root":"0x0101010101010101010101010101010101010101010101010101010101010101","state_root":"0x0202020202020202020202020202020202020202020202020202020202020202","body_root":"0x0303030303030303030303030303030303030303030303030303030303030303"}},"sync_aggregate":{"sync_committee_bits":"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff","sync_committee_signature":"0x8e1111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111"},"signature_slot":"2"}}`),
+			err:   "unsupported version phase0",
+		},
+		{
+			name:  "DataInvalid",
+			input: []byte(`{"version":"altair","data":{}}`),
+			err:   "data: attested_header: missing",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"version":"altair","data":{"attested_header":{"beacon":{"slot":"1","proposer_index":"2","parent_root":"0x0101010101010101010101010101010101010101010101010101010101010101","state_root":"0x0202020202020202020202020202020202020202020202020202020202020202","body_root":"0x0303030303030303030303030303030303030303030303030303030303030303"}},"sync_aggregate":{"sync_committee_bits":"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff","sync_committee_signature":"0x8e1111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111"},"signature_slot":"2"}}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res spec.VersionedLightClientOptimisticUpdate
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+
+			slot, err := res.SignatureSlot()
+			require.NoError(t, err)
+			require.Equal(t, phase0.Slot(2), slot)
+
+			header, err := res.AttestedHeader()
+			require.NoError(t, err)
+			require.Equal(t, phase0.Slot(1), header.Slot)
+
+			rt, err := json.Marshal(&res)
+			require.NoError(t, err)
+			require.JSONEq(t, string(test.input), string(rt))
+		})
+	}
+}
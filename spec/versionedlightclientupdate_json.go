@@ -0,0 +1,107 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/pkg/errors"
+)
+
+// versionedLightClientUpdateJSON is the JSON representation of the struct, as used by the light client
+// endpoints and events.
+type versionedLightClientUpdateJSON struct {
+	Version DataVersion     `json:"version"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v *VersionedLightClientUpdate) MarshalJSON() ([]byte, error) {
+	var data any
+	switch v.Version {
+	case DataVersionAltair:
+		data = v.Altair
+	case DataVersionBellatrix:
+		data = v.Bellatrix
+	case DataVersionCapella:
+		data = v.Capella
+	case DataVersionDeneb:
+		data = v.Deneb
+	case DataVersionElectra:
+		data = v.Electra
+	case DataVersionFulu:
+		data = v.Fulu
+	case DataVersionGloas:
+		data = v.Gloas
+	default:
+		return nil, fmt.Errorf("unsupported version %s", v.Version)
+	}
+
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "data")
+	}
+
+	return json.Marshal(&versionedLightClientUpdateJSON{
+		Version: v.Version,
+		Data:    dataJSON,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *VersionedLightClientUpdate) UnmarshalJSON(input []byte) error {
+	var data versionedLightClientUpdateJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	v.Version = data.Version
+	switch data.Version {
+	case DataVersionAltair:
+		v.Altair = &altair.LightClientUpdate{}
+
+		return errors.Wrap(v.Altair.UnmarshalJSON(data.Data), "data")
+	case DataVersionBellatrix:
+		v.Bellatrix = &altair.LightClientUpdate{}
+
+		return errors.Wrap(v.Bellatrix.UnmarshalJSON(data.Data), "data")
+	case DataVersionCapella:
+		v.Capella = &capella.LightClientUpdate{}
+
+		return errors.Wrap(v.Capella.UnmarshalJSON(data.Data), "data")
+	case DataVersionDeneb:
+		v.Deneb = &deneb.LightClientUpdate{}
+
+		return errors.Wrap(v.Deneb.UnmarshalJSON(data.Data), "data")
+	case DataVersionElectra:
+		v.Electra = &electra.LightClientUpdate{}
+
+		return errors.Wrap(v.Electra.UnmarshalJSON(data.Data), "data")
+	case DataVersionFulu:
+		v.Fulu = &electra.LightClientUpdate{}
+
+		return errors.Wrap(v.Fulu.UnmarshalJSON(data.Data), "data")
+	case DataVersionGloas:
+		v.Gloas = &electra.LightClientUpdate{}
+
+		return errors.Wrap(v.Gloas.UnmarshalJSON(data.Data), "data")
+	default:
+		return fmt.Errorf("unsupported version %s", data.Version)
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
 	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/gloas"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -34,6 +35,7 @@ type VersionedSignedBeaconBlock struct {
 	Deneb     *deneb.SignedBeaconBlock
 	Electra   *electra.SignedBeaconBlock
 	Fulu      *electra.SignedBeaconBlock
+	Gloas     *gloas.SignedBeaconBlock
 }
 
 // Slot returns the slot of the signed beacon block.
@@ -81,6 +83,12 @@ func (v *VersionedSignedBeaconBlock) Slot() (phase0.Slot, error) {
 		}
 
 		return v.Fulu.Message.Slot, nil
+	case DataVersionGloas:
+		if v.Gloas == nil || v.Gloas.Message == nil {
+			return 0, errors.New("no gloas block")
+		}
+
+		return v.Gloas.Message.Slot, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -131,6 +139,12 @@ func (v *VersionedSignedBeaconBlock) ProposerIndex() (phase0.ValidatorIndex, err
 		}
 
 		return v.Fulu.Message.ProposerIndex, nil
+	case DataVersionGloas:
+		if v.Gloas == nil || v.Gloas.Message == nil {
+			return 0, errors.New("no gloas block")
+		}
+
+		return v.Gloas.Message.ProposerIndex, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -178,6 +192,16 @@ func (v *VersionedSignedBeaconBlock) ExecutionBlockHash() (phase0.Hash32, error)
 		}
 
 		return v.Fulu.Message.Body.ExecutionPayload.BlockHash, nil
+	case DataVersionGloas:
+		if v.Gloas == nil ||
+			v.Gloas.Message == nil ||
+			v.Gloas.Message.Body == nil ||
+			v.Gloas.Message.Body.SignedExecutionPayloadBid == nil ||
+			v.Gloas.Message.Body.SignedExecutionPayloadBid.Message == nil {
+			return phase0.Hash32{}, errors.New("no gloas block")
+		}
+
+		return v.Gloas.Message.Body.SignedExecutionPayloadBid.Message.BlockHash, nil
 	default:
 		return phase0.Hash32{}, errors.New("unknown version")
 	}
@@ -225,6 +249,8 @@ func (v *VersionedSignedBeaconBlock) ExecutionBlockNumber() (uint64, error) {
 		}
 
 		return v.Fulu.Message.Body.ExecutionPayload.BlockNumber, nil
+	case DataVersionGloas:
+		return 0, errors.New("gloas block does not have execution block number")
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -276,6 +302,8 @@ func (v *VersionedSignedBeaconBlock) ExecutionTransactions() ([]bellatrix.Transa
 		}
 
 		return v.Fulu.Message.Body.ExecutionPayload.Transactions, nil
+	case DataVersionGloas:
+		return nil, errors.New("gloas block does not have execution transactions")
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -326,6 +354,12 @@ func (v *VersionedSignedBeaconBlock) Graffiti() ([32]byte, error) {
 		}
 
 		return v.Fulu.Message.Body.Graffiti, nil
+	case DataVersionGloas:
+		if v.Gloas == nil || v.Gloas.Message == nil || v.Gloas.Message.Body == nil {
+			return [32]byte{}, errors.New("no gloas block")
+		}
+
+		return v.Gloas.Message.Body.Graffiti, nil
 	default:
 		return [32]byte{}, errors.New("unknown version")
 	}
@@ -433,6 +467,20 @@ func (v *VersionedSignedBeaconBlock) Attestations() ([]*VersionedAttestation, er
 			}
 		}
 
+		return versionedAttestations, nil
+	case DataVersionGloas:
+		if v.Gloas == nil || v.Gloas.Message == nil || v.Gloas.Message.Body == nil {
+			return nil, errors.New("no gloas block")
+		}
+
+		versionedAttestations := make([]*VersionedAttestation, len(v.Gloas.Message.Body.Attestations))
+		for i, attestation := range v.Gloas.Message.Body.Attestations {
+			versionedAttestations[i] = &VersionedAttestation{
+				Version: DataVersionGloas,
+				Gloas:   attestation,
+			}
+		}
+
 		return versionedAttestations, nil
 	default:
 		return nil, errors.New("unknown version")
@@ -484,6 +532,12 @@ func (v *VersionedSignedBeaconBlock) Root() (phase0.Root, error) {
 		}
 
 		return v.Fulu.Message.HashTreeRoot()
+	case DataVersionGloas:
+		if v.Gloas == nil || v.Gloas.Message == nil {
+			return phase0.Root{}, errors.New("no gloas block")
+		}
+
+		return v.Gloas.Message.HashTreeRoot()
 	default:
 		return phase0.Root{}, errors.New("unknown version")
 	}
@@ -534,6 +588,12 @@ func (v *VersionedSignedBeaconBlock) BodyRoot() (phase0.Root, error) {
 		}
 
 		return v.Fulu.Message.Body.HashTreeRoot()
+	case DataVersionGloas:
+		if v.Gloas == nil || v.Gloas.Message == nil || v.Gloas.Message.Body == nil {
+			return phase0.Root{}, errors.New("no gloas block")
+		}
+
+		return v.Gloas.Message.Body.HashTreeRoot()
 	default:
 		return phase0.Root{}, errors.New("unknown version")
 	}
@@ -584,6 +644,12 @@ func (v *VersionedSignedBeaconBlock) ParentRoot() (phase0.Root, error) {
 		}
 
 		return v.Fulu.Message.ParentRoot, nil
+	case DataVersionGloas:
+		if v.Gloas == nil || v.Gloas.Message == nil {
+			return phase0.Root{}, errors.New("no gloas block")
+		}
+
+		return v.Gloas.Message.ParentRoot, nil
 	default:
 		return phase0.Root{}, errors.New("unknown version")
 	}
@@ -634,6 +700,12 @@ func (v *VersionedSignedBeaconBlock) StateRoot() (phase0.Root, error) {
 		}
 
 		return v.Fulu.Message.StateRoot, nil
+	case DataVersionGloas:
+		if v.Gloas == nil || v.Gloas.Message == nil {
+			return phase0.Root{}, errors.New("no gloas block")
+		}
+
+		return v.Gloas.Message.StateRoot, nil
 	default:
 		return phase0.Root{}, errors.New("unknown version")
 	}
@@ -684,6 +756,12 @@ func (v *VersionedSignedBeaconBlock) RandaoReveal() (phase0.BLSSignature, error)
 		}
 
 		return v.Fulu.Message.Body.RANDAOReveal, nil
+	case DataVersionGloas:
+		if v.Gloas == nil || v.Gloas.Message == nil || v.Gloas.Message.Body == nil {
+			return phase0.BLSSignature{}, errors.New("no gloas block")
+		}
+
+		return v.Gloas.Message.Body.RANDAOReveal, nil
 	default:
 		return phase0.BLSSignature{}, errors.New("unknown version")
 	}
@@ -734,6 +812,12 @@ func (v *VersionedSignedBeaconBlock) ETH1Data() (*phase0.ETH1Data, error) {
 		}
 
 		return v.Fulu.Message.Body.ETH1Data, nil
+	case DataVersionGloas:
+		if v.Gloas == nil || v.Gloas.Message == nil || v.Gloas.Message.Body == nil {
+			return nil, errors.New("no gloas block")
+		}
+
+		return v.Gloas.Message.Body.ETH1Data, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -784,6 +868,12 @@ func (v *VersionedSignedBeaconBlock) Deposits() ([]*phase0.Deposit, error) {
 		}
 
 		return v.Fulu.Message.Body.Deposits, nil
+	case DataVersionGloas:
+		if v.Gloas == nil || v.Gloas.Message == nil || v.Gloas.Message.Body == nil {
+			return nil, errors.New("no gloas block")
+		}
+
+		return v.Gloas.Message.Body.Deposits, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -834,6 +924,12 @@ func (v *VersionedSignedBeaconBlock) VoluntaryExits() ([]*phase0.SignedVoluntary
 		}
 
 		return v.Fulu.Message.Body.VoluntaryExits, nil
+	case DataVersionGloas:
+		if v.Gloas == nil || v.Gloas.Message == nil || v.Gloas.Message.Body == nil {
+			return nil, errors.New("no gloas block")
+		}
+
+		return v.Gloas.Message.Body.VoluntaryExits, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -941,6 +1037,20 @@ func (v *VersionedSignedBeaconBlock) AttesterSlashings() ([]VersionedAttesterSla
 			}
 		}
 
+		return versionedAttesterSlashings, nil
+	case DataVersionGloas:
+		if v.Gloas == nil || v.Gloas.Message == nil || v.Gloas.Message.Body == nil {
+			return nil, errors.New("no gloas block")
+		}
+
+		versionedAttesterSlashings := make([]VersionedAttesterSlashing, len(v.Gloas.Message.Body.AttesterSlashings))
+		for i, attesterSlashing := range v.Gloas.Message.Body.AttesterSlashings {
+			versionedAttesterSlashings[i] = VersionedAttesterSlashing{
+				Version: DataVersionGloas,
+				Gloas:   attesterSlashing,
+			}
+		}
+
 		return versionedAttesterSlashings, nil
 	default:
 		return nil, errors.New("unknown version")
@@ -992,6 +1102,12 @@ func (v *VersionedSignedBeaconBlock) ProposerSlashings() ([]*phase0.ProposerSlas
 		}
 
 		return v.Fulu.Message.Body.ProposerSlashings, nil
+	case DataVersionGloas:
+		if v.Gloas == nil || v.Gloas.Message == nil || v.Gloas.Message.Body == nil {
+			return nil, errors.New("no gloas block")
+		}
+
+		return v.Gloas.Message.Body.ProposerSlashings, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -1038,6 +1154,12 @@ func (v *VersionedSignedBeaconBlock) SyncAggregate() (*altair.SyncAggregate, err
 		}
 
 		return v.Fulu.Message.Body.SyncAggregate, nil
+	case DataVersionGloas:
+		if v.Gloas == nil || v.Gloas.Message == nil || v.Gloas.Message.Body == nil {
+			return nil, errors.New("no gloas block")
+		}
+
+		return v.Gloas.Message.Body.SyncAggregate, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -1076,6 +1198,12 @@ func (v *VersionedSignedBeaconBlock) BLSToExecutionChanges() ([]*capella.SignedB
 		}
 
 		return v.Fulu.Message.Body.BLSToExecutionChanges, nil
+	case DataVersionGloas:
+		if v.Gloas == nil || v.Gloas.Message == nil || v.Gloas.Message.Body == nil {
+			return nil, errors.New("no gloas block")
+		}
+
+		return v.Gloas.Message.Body.BLSToExecutionChanges, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -1120,6 +1248,8 @@ func (v *VersionedSignedBeaconBlock) Withdrawals() ([]*capella.Withdrawal, error
 		}
 
 		return v.Fulu.Message.Body.ExecutionPayload.Withdrawals, nil
+	case DataVersionGloas:
+		return nil, errors.New("gloas block does not have execution withdrawals")
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -1154,6 +1284,8 @@ func (v *VersionedSignedBeaconBlock) BlobKZGCommitments() ([]deneb.KZGCommitment
 		}
 
 		return v.Fulu.Message.Body.BlobKZGCommitments, nil
+	case DataVersionGloas:
+		return nil, errors.New("gloas block does not have kzg commitments")
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -1186,6 +1318,8 @@ func (v *VersionedSignedBeaconBlock) ExecutionRequests() (*electra.ExecutionRequ
 		}
 
 		return v.Fulu.Message.Body.ExecutionRequests, nil
+	case DataVersionGloas:
+		return nil, errors.New("gloas block does not have execution requests")
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -1232,6 +1366,8 @@ func (v *VersionedSignedBeaconBlock) ExecutionPayload() (*VersionedExecutionPayl
 		}
 
 		versionedExecutionPayload.Fulu = v.Fulu.Message.Body.ExecutionPayload
+	case DataVersionGloas:
+		return nil, errors.New("no execution payload in gloas")
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -1239,6 +1375,62 @@ func (v *VersionedSignedBeaconBlock) ExecutionPayload() (*VersionedExecutionPayl
 	return versionedExecutionPayload, nil
 }
 
+// SignedExecutionPayloadBid returns the signed execution payload bid of the signed beacon block.
+func (v *VersionedSignedBeaconBlock) SignedExecutionPayloadBid() (*gloas.SignedExecutionPayloadBid, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		return nil, errors.New("phase0 block does not have execution payload bids")
+	case DataVersionAltair:
+		return nil, errors.New("altair block does not have execution payload bids")
+	case DataVersionBellatrix:
+		return nil, errors.New("bellatrix block does not have execution payload bids")
+	case DataVersionCapella:
+		return nil, errors.New("capella block does not have execution payload bids")
+	case DataVersionDeneb:
+		return nil, errors.New("deneb block does not have execution payload bids")
+	case DataVersionElectra:
+		return nil, errors.New("electra block does not have execution payload bids")
+	case DataVersionFulu:
+		return nil, errors.New("fulu block does not have execution payload bids")
+	case DataVersionGloas:
+		if v.Gloas == nil || v.Gloas.Message == nil || v.Gloas.Message.Body == nil {
+			return nil, errors.New("no gloas block")
+		}
+
+		return v.Gloas.Message.Body.SignedExecutionPayloadBid, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// PayloadAttestations returns the payload attestations of the signed beacon block.
+func (v *VersionedSignedBeaconBlock) PayloadAttestations() ([]*gloas.PayloadAttestation, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		return nil, errors.New("phase0 block does not have payload attestations")
+	case DataVersionAltair:
+		return nil, errors.New("altair block does not have payload attestations")
+	case DataVersionBellatrix:
+		return nil, errors.New("bellatrix block does not have payload attestations")
+	case DataVersionCapella:
+		return nil, errors.New("capella block does not have payload attestations")
+	case DataVersionDeneb:
+		return nil, errors.New("deneb block does not have payload attestations")
+	case DataVersionElectra:
+		return nil, errors.New("electra block does not have payload attestations")
+	case DataVersionFulu:
+		return nil, errors.New("fulu block does not have payload attestations")
+	case DataVersionGloas:
+		if v.Gloas == nil || v.Gloas.Message == nil || v.Gloas.Message.Body == nil {
+			return nil, errors.New("no gloas block")
+		}
+
+		return v.Gloas.Message.Body.PayloadAttestations, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
 // String returns a string version of the structure.
 func (v *VersionedSignedBeaconBlock) String() string {
 	switch v.Version {
@@ -1284,6 +1476,12 @@ func (v *VersionedSignedBeaconBlock) String() string {
 		}
 
 		return v.Fulu.String()
+	case DataVersionGloas:
+		if v.Gloas == nil {
+			return ""
+		}
+
+		return v.Gloas.String()
 	default:
 		return "unknown version"
 	}
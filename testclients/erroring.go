@@ -25,8 +25,11 @@ import (
 	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
 	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/fulu"
+	"github.com/attestantio/go-eth2-client/spec/gloas"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -550,19 +553,38 @@ func (s *Erroring) BeaconState(ctx context.Context,
 }
 
 // Events feeds requested events with the given topics to the supplied handler.
-func (s *Erroring) Events(ctx context.Context, opts *api.EventsOpts) error {
+func (s *Erroring) Events(ctx context.Context, opts *api.EventsOpts) (api.EventStream, error) {
 	if err := s.maybeError(ctx); err != nil {
-		return err
+		return nil, err
 	}
 
 	next, isNext := s.next.(consensusclient.EventsProvider)
 	if !isNext {
-		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
 	}
 
 	return next.Events(ctx, opts)
 }
 
+// Subscribe returns a channel on which events with the given topics are delivered.
+func (s *Erroring) Subscribe(ctx context.Context,
+	opts *api.SubscribeOpts,
+) (
+	<-chan *api.Event,
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+
+	next, isNext := s.next.(consensusclient.EventSubscriptionProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.Subscribe(ctx, opts)
+}
+
 // Finality provides the finality given a state ID.
 func (s *Erroring) Finality(ctx context.Context,
 	opts *api.FinalityOpts,
@@ -1096,3 +1118,467 @@ func (s *Erroring) PendingDeposits(ctx context.Context,
 
 	return next.PendingDeposits(ctx, opts)
 }
+
+// LightClientBootstrap provides the light client bootstrap for a given block root.
+func (s *Erroring) LightClientBootstrap(ctx context.Context,
+	opts *api.LightClientBootstrapOpts,
+) (
+	*api.Response[*spec.VersionedLightClientBootstrap],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+
+	next, isNext := s.next.(consensusclient.LightClientBootstrapProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.LightClientBootstrap(ctx, opts)
+}
+
+// LightClientUpdates provides the light client updates for a range of sync committee periods.
+func (s *Erroring) LightClientUpdates(ctx context.Context,
+	opts *api.LightClientUpdatesOpts,
+) (
+	*api.Response[[]*spec.VersionedLightClientUpdate],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+
+	next, isNext := s.next.(consensusclient.LightClientUpdatesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.LightClientUpdates(ctx, opts)
+}
+
+// LightClientFinalityUpdate provides the latest light client finality update.
+func (s *Erroring) LightClientFinalityUpdate(ctx context.Context,
+	opts *api.LightClientFinalityUpdateOpts,
+) (
+	*api.Response[*spec.VersionedLightClientFinalityUpdate],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+
+	next, isNext := s.next.(consensusclient.LightClientFinalityUpdateProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.LightClientFinalityUpdate(ctx, opts)
+}
+
+// LightClientOptimisticUpdate provides the latest light client optimistic update.
+func (s *Erroring) LightClientOptimisticUpdate(ctx context.Context,
+	opts *api.LightClientOptimisticUpdateOpts,
+) (
+	*api.Response[*spec.VersionedLightClientOptimisticUpdate],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+
+	next, isNext := s.next.(consensusclient.LightClientOptimisticUpdateProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.LightClientOptimisticUpdate(ctx, opts)
+}
+
+// DataColumnSidecars fetches the data column sidecars given options.
+func (s *Erroring) DataColumnSidecars(ctx context.Context,
+	opts *api.DataColumnSidecarsOpts,
+) (
+	*api.Response[[]*fulu.DataColumnSidecar],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+
+	next, isNext := s.next.(consensusclient.DataColumnSidecarsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.DataColumnSidecars(ctx, opts)
+}
+
+// NodeHealth provides the health of the node.
+func (s *Erroring) NodeHealth(ctx context.Context,
+	opts *api.NodeHealthOpts,
+) (
+	*api.Response[apiv1.NodeHealth],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+
+	next, isNext := s.next.(consensusclient.NodeHealthProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.NodeHealth(ctx, opts)
+}
+
+// NodeIdentity provides the network identity of the node.
+func (s *Erroring) NodeIdentity(ctx context.Context,
+	opts *api.NodeIdentityOpts,
+) (
+	*api.Response[*apiv1.NodeIdentity],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+
+	next, isNext := s.next.(consensusclient.NodeIdentityProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.NodeIdentity(ctx, opts)
+}
+
+// NodePeer provides information about a single peer of the node.
+func (s *Erroring) NodePeer(ctx context.Context,
+	opts *api.NodePeerOpts,
+) (
+	*api.Response[*apiv1.Peer],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+
+	next, isNext := s.next.(consensusclient.NodePeerProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.NodePeer(ctx, opts)
+}
+
+// NodePeerCount provides the number of peers of the node by connection state.
+func (s *Erroring) NodePeerCount(ctx context.Context,
+	opts *api.NodePeerCountOpts,
+) (
+	*api.Response[*apiv1.PeerCount],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+
+	next, isNext := s.next.(consensusclient.NodePeerCountProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.NodePeerCount(ctx, opts)
+}
+
+// BeaconBlockHeaders provides the block headers matching the given slot and parent root.
+func (s *Erroring) BeaconBlockHeaders(ctx context.Context,
+	opts *api.BeaconBlockHeadersOpts,
+) (
+	*api.Response[[]*apiv1.BeaconBlockHeader],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+
+	next, isNext := s.next.(consensusclient.BeaconBlockHeadersProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.BeaconBlockHeaders(ctx, opts)
+}
+
+// BlockAttestations fetches the attestations included in a block given a set of options.
+func (s *Erroring) BlockAttestations(ctx context.Context,
+	opts *api.BlockAttestationsOpts,
+) (
+	*api.Response[[]*spec.VersionedAttestation],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+
+	next, isNext := s.next.(consensusclient.BlockAttestationsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.BlockAttestations(ctx, opts)
+}
+
+// AttesterSlashingPool fetches the attester slashing pool.
+func (s *Erroring) AttesterSlashingPool(ctx context.Context,
+	opts *api.AttesterSlashingPoolOpts,
+) (
+	*api.Response[[]*spec.VersionedAttesterSlashing],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+
+	next, isNext := s.next.(consensusclient.AttesterSlashingPoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.AttesterSlashingPool(ctx, opts)
+}
+
+// ProposerSlashingPool fetches the proposer slashing pool.
+func (s *Erroring) ProposerSlashingPool(ctx context.Context,
+	opts *api.ProposerSlashingPoolOpts,
+) (
+	*api.Response[[]*phase0.ProposerSlashing],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+
+	next, isNext := s.next.(consensusclient.ProposerSlashingPoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.ProposerSlashingPool(ctx, opts)
+}
+
+// BLSToExecutionChangePool fetches the BLS to execution address change pool.
+func (s *Erroring) BLSToExecutionChangePool(ctx context.Context,
+	opts *api.BLSToExecutionChangePoolOpts,
+) (
+	*api.Response[[]*capella.SignedBLSToExecutionChange],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+
+	next, isNext := s.next.(consensusclient.BLSToExecutionChangePoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.BLSToExecutionChangePool(ctx, opts)
+}
+
+// SyncCommitteeSelections obtains sync committee selections.
+func (s *Erroring) SyncCommitteeSelections(ctx context.Context,
+	opts *api.SyncCommitteeSelectionsOpts,
+) (
+	*api.Response[[]*apiv1.SyncCommitteeSelection],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.SyncCommitteeSelectionsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SyncCommitteeSelections(ctx, opts)
+}
+
+// ValidatorIdentities provides the index, public key and activation epoch of validators for the given options.
+func (s *Erroring) ValidatorIdentities(ctx context.Context,
+	opts *api.ValidatorIdentitiesOpts,
+) (
+	*api.Response[[]*apiv1.ValidatorIdentity],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+
+	next, isNext := s.next.(consensusclient.ValidatorIdentitiesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.ValidatorIdentities(ctx, opts)
+}
+
+// DepositSnapshot provides the snapshot of the deposit tree as at the latest finalized checkpoint.
+func (s *Erroring) DepositSnapshot(ctx context.Context,
+	opts *api.DepositSnapshotOpts,
+) (
+	*api.Response[*apiv1.DepositSnapshot],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+
+	next, isNext := s.next.(consensusclient.DepositSnapshotProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.DepositSnapshot(ctx, opts)
+}
+
+// BeaconHeads fetches all heads of the node's fork choice.
+func (s *Erroring) BeaconHeads(ctx context.Context,
+	opts *api.BeaconHeadsOpts,
+) (
+	*api.Response[[]*apiv1.BeaconHead],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+
+	next, isNext := s.next.(consensusclient.BeaconHeadsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.BeaconHeads(ctx, opts)
+}
+
+// ProposerLookahead obtains the proposer lookahead for the given options.
+func (s *Erroring) ProposerLookahead(ctx context.Context,
+	opts *api.ProposerLookaheadOpts,
+) (
+	*api.Response[[]phase0.ValidatorIndex],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+
+	next, isNext := s.next.(consensusclient.ProposerLookaheadProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.ProposerLookahead(ctx, opts)
+}
+
+// NodeVersionDetails returns the identity of the beacon node and its execution client.
+func (s *Erroring) NodeVersionDetails(ctx context.Context,
+	opts *api.NodeVersionOpts,
+) (
+	*api.Response[*apiv1.NodeVersion],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+
+	next, isNext := s.next.(consensusclient.NodeVersionDetailsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.NodeVersionDetails(ctx, opts)
+}
+
+// PayloadAttestationData fetches the payload attestation data for the given options.
+func (s *Erroring) PayloadAttestationData(ctx context.Context,
+	opts *api.PayloadAttestationDataOpts,
+) (
+	*api.Response[*gloas.PayloadAttestationData],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+
+	next, isNext := s.next.(consensusclient.PayloadAttestationDataProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.PayloadAttestationData(ctx, opts)
+}
+
+// PayloadAttestationPool fetches the payload attestation pool for the given options.
+func (s *Erroring) PayloadAttestationPool(ctx context.Context,
+	opts *api.PayloadAttestationPoolOpts,
+) (
+	*api.Response[[]*gloas.PayloadAttestation],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+
+	next, isNext := s.next.(consensusclient.PayloadAttestationPoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.PayloadAttestationPool(ctx, opts)
+}
+
+// SignedExecutionPayloadEnvelope fetches the signed execution payload envelope for a given block.
+func (s *Erroring) SignedExecutionPayloadEnvelope(ctx context.Context,
+	opts *api.SignedExecutionPayloadEnvelopeOpts,
+) (
+	*api.Response[*gloas.SignedExecutionPayloadEnvelope],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+
+	next, isNext := s.next.(consensusclient.SignedExecutionPayloadEnvelopeProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SignedExecutionPayloadEnvelope(ctx, opts)
+}
+
+// SubmitPayloadAttestationMessages submits payload attestation messages.
+func (s *Erroring) SubmitPayloadAttestationMessages(ctx context.Context, opts *api.SubmitPayloadAttestationMessagesOpts) error {
+	if err := s.maybeError(ctx); err != nil {
+		return err
+	}
+
+	next, isNext := s.next.(consensusclient.PayloadAttestationMessagesSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SubmitPayloadAttestationMessages(ctx, opts)
+}
+
+// SubmitExecutionPayloadEnvelope submits a signed execution payload envelope.
+func (s *Erroring) SubmitExecutionPayloadEnvelope(ctx context.Context, opts *api.SubmitExecutionPayloadEnvelopeOpts) error {
+	if err := s.maybeError(ctx); err != nil {
+		return err
+	}
+
+	next, isNext := s.next.(consensusclient.ExecutionPayloadEnvelopeSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SubmitExecutionPayloadEnvelope(ctx, opts)
+}
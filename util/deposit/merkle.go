@@ -0,0 +1,256 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deposit
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// zeroHashes are the roots of empty subtrees at each level of the tree.
+var zeroHashes = func() [treeDepth + 1]phase0.Root {
+	res := [treeDepth + 1]phase0.Root{}
+	for i := 1; i <= treeDepth; i++ {
+		res[i] = hashPair(res[i-1], res[i-1])
+	}
+
+	return res
+}()
+
+// merkleNode is a node in the sparse deposit tree.
+type merkleNode interface {
+	// root returns the root of the subtree.
+	root() phase0.Root
+	// isFull returns true if the subtree has no space for further leaves.
+	isFull() bool
+	// pushLeaf adds a leaf to the subtree at the given level, returning the updated subtree.
+	pushLeaf(leaf phase0.Root, level int) (merkleNode, error)
+	// finalize finalizes the given number of deposits in the subtree at the given level, returning the updated subtree.
+	finalize(deposits uint64, level int) merkleNode
+	// finalized appends the roots of finalized subtrees to the supplied list, returning the list
+	// and the number of deposits that they cover.
+	finalized(roots []phase0.Root) ([]phase0.Root, uint64)
+}
+
+// branchNode is a node with two children.
+type branchNode struct {
+	left  merkleNode
+	right merkleNode
+}
+
+func (n *branchNode) root() phase0.Root {
+	return hashPair(n.left.root(), n.right.root())
+}
+
+func (n *branchNode) isFull() bool {
+	return n.right.isFull()
+}
+
+func (n *branchNode) pushLeaf(leaf phase0.Root, level int) (merkleNode, error) {
+	var err error
+	if n.left.isFull() {
+		n.right, err = n.right.pushLeaf(leaf, level-1)
+	} else {
+		n.left, err = n.left.pushLeaf(leaf, level-1)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return n, nil
+}
+
+func (n *branchNode) finalize(deposits uint64, level int) merkleNode {
+	size := uint64(1) << level
+	if deposits >= size {
+		return &finalizedNode{
+			deposits: size,
+			hash:     n.root(),
+		}
+	}
+
+	n.left = n.left.finalize(deposits, level-1)
+	if deposits > size/2 {
+		n.right = n.right.finalize(deposits-size/2, level-1)
+	}
+
+	return n
+}
+
+func (n *branchNode) finalized(roots []phase0.Root) ([]phase0.Root, uint64) {
+	roots, leftDeposits := n.left.finalized(roots)
+	roots, rightDeposits := n.right.finalized(roots)
+
+	return roots, leftDeposits + rightDeposits
+}
+
+// leafNode is a single deposit.
+type leafNode struct {
+	hash phase0.Root
+}
+
+func (n *leafNode) root() phase0.Root {
+	return n.hash
+}
+
+func (*leafNode) isFull() bool {
+	return true
+}
+
+func (*leafNode) pushLeaf(_ phase0.Root, _ int) (merkleNode, error) {
+	return nil, errors.New("cannot push to a leaf")
+}
+
+func (n *leafNode) finalize(_ uint64, _ int) merkleNode {
+	return &finalizedNode{
+		deposits: 1,
+		hash:     n.hash,
+	}
+}
+
+func (*leafNode) finalized(roots []phase0.Root) ([]phase0.Root, uint64) {
+	return roots, 0
+}
+
+// finalizedNode is a subtree that has been finalized and pruned.
+type finalizedNode struct {
+	deposits uint64
+	hash     phase0.Root
+}
+
+func (n *finalizedNode) root() phase0.Root {
+	return n.hash
+}
+
+func (*finalizedNode) isFull() bool {
+	return true
+}
+
+func (*finalizedNode) pushLeaf(_ phase0.Root, _ int) (merkleNode, error) {
+	return nil, errors.New("cannot push to a finalized subtree")
+}
+
+func (n *finalizedNode) finalize(_ uint64, _ int) merkleNode {
+	return n
+}
+
+func (n *finalizedNode) finalized(roots []phase0.Root) ([]phase0.Root, uint64) {
+	return append(roots, n.hash), n.deposits
+}
+
+// zeroNode is an empty subtree.
+type zeroNode struct {
+	level int
+}
+
+func (n *zeroNode) root() phase0.Root {
+	return zeroHashes[n.level]
+}
+
+func (*zeroNode) isFull() bool {
+	return false
+}
+
+func (*zeroNode) pushLeaf(leaf phase0.Root, level int) (merkleNode, error) {
+	return newSingleLeafNode(leaf, level), nil
+}
+
+func (n *zeroNode) finalize(_ uint64, _ int) merkleNode {
+	return n
+}
+
+func (*zeroNode) finalized(roots []phase0.Root) ([]phase0.Root, uint64) {
+	return roots, 0
+}
+
+// newSingleLeafNode creates a subtree at the given level containing a single leaf.
+func newSingleLeafNode(leaf phase0.Root, level int) merkleNode {
+	if level == 0 {
+		return &leafNode{hash: leaf}
+	}
+
+	return &branchNode{
+		left:  newSingleLeafNode(leaf, level-1),
+		right: &zeroNode{level: level - 1},
+	}
+}
+
+// nodeFromSnapshot rebuilds a subtree at the given level from finalized roots.
+func nodeFromSnapshot(finalized []phase0.Root, deposits uint64, level int) (merkleNode, error) {
+	if len(finalized) == 0 {
+		if deposits != 0 {
+			return nil, errors.New("insufficient finalized roots for deposit count")
+		}
+
+		return &zeroNode{level: level}, nil
+	}
+
+	if deposits == uint64(1)<<level {
+		return &finalizedNode{
+			deposits: deposits,
+			hash:     finalized[0],
+		}, nil
+	}
+
+	if level == 0 {
+		return nil, errors.New("too many deposits for tree")
+	}
+
+	size := uint64(1) << (level - 1)
+	if deposits <= size {
+		left, err := nodeFromSnapshot(finalized, deposits, level-1)
+		if err != nil {
+			return nil, err
+		}
+
+		return &branchNode{
+			left:  left,
+			right: &zeroNode{level: level - 1},
+		}, nil
+	}
+
+	right, err := nodeFromSnapshot(finalized[1:], deposits-size, level-1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &branchNode{
+		left: &finalizedNode{
+			deposits: size,
+			hash:     finalized[0],
+		},
+		right: right,
+	}, nil
+}
+
+// hashPair returns the hash of the concatenation of two roots.
+func hashPair(left phase0.Root, right phase0.Root) phase0.Root {
+	data := make([]byte, 0, 2*phase0.RootLength)
+	data = append(data, left[:]...)
+	data = append(data, right[:]...)
+
+	return sha256.Sum256(data)
+}
+
+// lengthRoot returns the little-endian representation of a length as a root.
+func lengthRoot(length uint64) phase0.Root {
+	res := phase0.Root{}
+	binary.LittleEndian.PutUint64(res[:8], length)
+
+	return res
+}
@@ -0,0 +1,263 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package deposit provides an implementation of the EIP-4881 deposit tree.
+//
+// The tree holds the deposits made to the deposit contract, pruning subtrees once they have been finalized.
+// It can be rebuilt from a deposit snapshot, extended with further deposits, and used to generate
+// Merkle proofs for deposits that have not been finalized.
+package deposit
+
+import (
+	"errors"
+	"fmt"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// treeDepth is the depth of the deposit contract's Merkle tree.
+const treeDepth = 32
+
+// Tree is an EIP-4881 deposit tree.
+type Tree struct {
+	tree                 merkleNode
+	depositCount         uint64
+	finalizedCount       uint64
+	executionBlockHash   *phase0.Hash32
+	executionBlockHeight uint64
+}
+
+// New creates a new empty deposit tree.
+func New() *Tree {
+	return &Tree{
+		tree: &zeroNode{level: treeDepth},
+	}
+}
+
+// NewFromSnapshot rebuilds a deposit tree from a snapshot.
+// The snapshot is verified against its deposit root before the tree is returned.
+func NewFromSnapshot(snapshot *apiv1.DepositSnapshot) (*Tree, error) {
+	if snapshot == nil {
+		return nil, errors.New("no snapshot supplied")
+	}
+
+	if err := VerifySnapshot(snapshot); err != nil {
+		return nil, err
+	}
+
+	tree, err := nodeFromSnapshot(snapshot.Finalized, snapshot.DepositCount, treeDepth)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to rebuild tree from snapshot"), err)
+	}
+
+	res := &Tree{
+		tree:                 tree,
+		depositCount:         snapshot.DepositCount,
+		finalizedCount:       snapshot.DepositCount,
+		executionBlockHash:   &snapshot.ExecutionBlockHash,
+		executionBlockHeight: snapshot.ExecutionBlockHeight,
+	}
+
+	if res.Root() != snapshot.DepositRoot {
+		return nil, errors.New("rebuilt tree does not match snapshot deposit root")
+	}
+
+	return res, nil
+}
+
+// DepositCount returns the number of deposits in the tree.
+func (t *Tree) DepositCount() uint64 {
+	return t.depositCount
+}
+
+// Root returns the deposit root of the tree, including the deposit count.
+func (t *Tree) Root() phase0.Root {
+	return hashPair(t.tree.root(), lengthRoot(t.depositCount))
+}
+
+// AddDeposit adds the deposit data as the next leaf of the tree.
+func (t *Tree) AddDeposit(data *phase0.DepositData) error {
+	if data == nil {
+		return errors.New("no deposit data supplied")
+	}
+
+	leaf, err := data.HashTreeRoot()
+	if err != nil {
+		return errors.Join(errors.New("failed to calculate deposit data root"), err)
+	}
+
+	return t.PushLeaf(leaf)
+}
+
+// PushLeaf adds a leaf to the tree.
+func (t *Tree) PushLeaf(leaf phase0.Root) error {
+	if t.depositCount == uint64(1)<<treeDepth {
+		return errors.New("deposit tree is full")
+	}
+
+	tree, err := t.tree.pushLeaf(leaf, treeDepth)
+	if err != nil {
+		return err
+	}
+
+	t.tree = tree
+	t.depositCount++
+
+	return nil
+}
+
+// Finalize finalizes the deposits covered by the execution data, pruning the tree accordingly.
+func (t *Tree) Finalize(eth1Data *phase0.ETH1Data, executionBlockHeight uint64) error {
+	if eth1Data == nil {
+		return errors.New("no eth1 data supplied")
+	}
+
+	if eth1Data.DepositCount > t.depositCount {
+		return fmt.Errorf("cannot finalize %d deposits; tree only has %d", eth1Data.DepositCount, t.depositCount)
+	}
+
+	if eth1Data.DepositCount < t.finalizedCount {
+		return fmt.Errorf("cannot finalize %d deposits; %d already finalized", eth1Data.DepositCount, t.finalizedCount)
+	}
+
+	executionBlockHash := phase0.Hash32{}
+	copy(executionBlockHash[:], eth1Data.BlockHash)
+
+	t.tree = t.tree.finalize(eth1Data.DepositCount, treeDepth)
+	t.finalizedCount = eth1Data.DepositCount
+	t.executionBlockHash = &executionBlockHash
+	t.executionBlockHeight = executionBlockHeight
+
+	return nil
+}
+
+// Snapshot returns a snapshot of the finalized portion of the tree.
+func (t *Tree) Snapshot() (*apiv1.DepositSnapshot, error) {
+	if t.executionBlockHash == nil {
+		return nil, errors.New("tree has not been finalized")
+	}
+
+	finalized, depositCount := t.tree.finalized(make([]phase0.Root, 0))
+
+	snapshot := &apiv1.DepositSnapshot{
+		Finalized:            finalized,
+		DepositCount:         depositCount,
+		ExecutionBlockHash:   *t.executionBlockHash,
+		ExecutionBlockHeight: t.executionBlockHeight,
+	}
+
+	root, err := SnapshotRoot(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot.DepositRoot = root
+
+	return snapshot, nil
+}
+
+// Proof returns the leaf and Merkle proof for the deposit at the given index.
+// The proof includes the deposit count mix-in, so it can be verified against the tree's root.
+// Proofs cannot be generated for deposits that have been finalized.
+func (t *Tree) Proof(index uint64) (phase0.Root, []phase0.Root, error) {
+	if index >= t.depositCount {
+		return phase0.Root{}, nil, fmt.Errorf("deposit %d not in tree", index)
+	}
+
+	if index < t.finalizedCount {
+		return phase0.Root{}, nil, fmt.Errorf("deposit %d has been finalized", index)
+	}
+
+	proof := make([]phase0.Root, treeDepth+1)
+	node := t.tree
+	for level := treeDepth; level > 0; level-- {
+		branch, isBranch := node.(*branchNode)
+		if !isBranch {
+			return phase0.Root{}, nil, fmt.Errorf("unexpected node %T in tree", node)
+		}
+
+		if (index>>(level-1))&1 == 1 {
+			proof[level-1] = branch.left.root()
+			node = branch.right
+		} else {
+			proof[level-1] = branch.right.root()
+			node = branch.left
+		}
+	}
+	proof[treeDepth] = lengthRoot(t.depositCount)
+
+	return node.root(), proof, nil
+}
+
+// VerifyProof returns true if the proof shows the leaf to be at the given index of the tree with the given root.
+func VerifyProof(root phase0.Root, leaf phase0.Root, proof []phase0.Root, index uint64) bool {
+	if len(proof) != treeDepth+1 {
+		return false
+	}
+
+	value := leaf
+	for i := range proof {
+		if (index>>i)&1 == 1 {
+			value = hashPair(proof[i], value)
+		} else {
+			value = hashPair(value, proof[i])
+		}
+	}
+
+	return value == root
+}
+
+// SnapshotRoot calculates the deposit root from the finalized roots and deposit count of a snapshot.
+func SnapshotRoot(snapshot *apiv1.DepositSnapshot) (phase0.Root, error) {
+	if snapshot == nil {
+		return phase0.Root{}, errors.New("no snapshot supplied")
+	}
+
+	size := snapshot.DepositCount
+	index := len(snapshot.Finalized)
+	root := zeroHashes[0]
+	for level := range treeDepth {
+		if size&1 == 1 {
+			if index == 0 {
+				return phase0.Root{}, errors.New("insufficient finalized roots for deposit count")
+			}
+
+			index--
+			root = hashPair(snapshot.Finalized[index], root)
+		} else {
+			root = hashPair(root, zeroHashes[level])
+		}
+		size >>= 1
+	}
+
+	if size != 0 {
+		return phase0.Root{}, errors.New("too many deposits for tree")
+	}
+
+	return hashPair(root, lengthRoot(snapshot.DepositCount)), nil
+}
+
+// VerifySnapshot checks that the deposit root of a snapshot matches its finalized roots and deposit count.
+func VerifySnapshot(snapshot *apiv1.DepositSnapshot) error {
+	root, err := SnapshotRoot(snapshot)
+	if err != nil {
+		return err
+	}
+
+	if root != snapshot.DepositRoot {
+		return fmt.Errorf("calculated deposit root %#x does not match snapshot deposit root %#x", root, snapshot.DepositRoot)
+	}
+
+	return nil
+}
@@ -0,0 +1,225 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deposit_test
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/deposit"
+	"github.com/stretchr/testify/require"
+)
+
+// leaves creates a number of distinct leaves.
+func leaves(count int) []phase0.Root {
+	res := make([]phase0.Root, count)
+	for i := range res {
+		res[i] = sha256.Sum256([]byte{byte(i)})
+	}
+
+	return res
+}
+
+// naiveRoot calculates the deposit root by hashing the full tree.
+func naiveRoot(leaves []phase0.Root) phase0.Root {
+	hash := func(left phase0.Root, right phase0.Root) phase0.Root {
+		return sha256.Sum256(append(left[:], right[:]...))
+	}
+
+	zero := phase0.Root{}
+	layer := append([]phase0.Root{}, leaves...)
+	for range 32 {
+		if len(layer)%2 == 1 {
+			layer = append(layer, zero)
+		}
+		next := make([]phase0.Root, 0, len(layer)/2)
+		for i := 0; i < len(layer); i += 2 {
+			next = append(next, hash(layer[i], layer[i+1]))
+		}
+		layer = next
+		zero = hash(zero, zero)
+	}
+
+	root := zero
+	if len(layer) > 0 {
+		root = layer[0]
+	}
+	length := phase0.Root{}
+	binary.LittleEndian.PutUint64(length[:], uint64(len(leaves)))
+
+	return hash(root, length)
+}
+
+func TestEmptyRoot(t *testing.T) {
+	tree := deposit.New()
+	require.Equal(t, "0xd70a234731285c6804c2a4f56711ddb8c82c99740f207854891028af34e27e5e", tree.Root().String())
+	require.Equal(t, uint64(0), tree.DepositCount())
+}
+
+func TestAddDeposit(t *testing.T) {
+	data := &phase0.DepositData{
+		PublicKey:             phase0.BLSPubKey{0x01},
+		WithdrawalCredentials: make([]byte, 32),
+		Amount:                32000000000,
+		Signature:             phase0.BLSSignature{0x02},
+	}
+	leaf, err := data.HashTreeRoot()
+	require.NoError(t, err)
+
+	tree := deposit.New()
+	require.EqualError(t, tree.AddDeposit(nil), "no deposit data supplied")
+	require.NoError(t, tree.AddDeposit(data))
+	require.Equal(t, naiveRoot([]phase0.Root{leaf}), tree.Root())
+
+	proofLeaf, proof, err := tree.Proof(0)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Root(leaf), proofLeaf)
+	require.True(t, deposit.VerifyProof(tree.Root(), proofLeaf, proof, 0))
+}
+
+func TestProofs(t *testing.T) {
+	leaves := leaves(37)
+
+	tree := deposit.New()
+	for i, leaf := range leaves {
+		require.NoError(t, tree.PushLeaf(leaf))
+		require.Equal(t, naiveRoot(leaves[:i+1]), tree.Root())
+	}
+
+	root := tree.Root()
+	for i := range leaves {
+		leaf, proof, err := tree.Proof(uint64(i))
+		require.NoError(t, err)
+		require.Equal(t, leaves[i], leaf)
+		require.Len(t, proof, 33)
+		require.True(t, deposit.VerifyProof(root, leaf, proof, uint64(i)))
+		require.False(t, deposit.VerifyProof(root, leaf, proof, uint64(i+1)))
+	}
+
+	_, _, err := tree.Proof(uint64(len(leaves)))
+	require.EqualError(t, err, "deposit 37 not in tree")
+}
+
+func TestSnapshot(t *testing.T) {
+	leaves := leaves(29)
+
+	tree := deposit.New()
+	for _, leaf := range leaves[:20] {
+		require.NoError(t, tree.PushLeaf(leaf))
+	}
+
+	_, err := tree.Snapshot()
+	require.EqualError(t, err, "tree has not been finalized")
+
+	require.EqualError(t, tree.Finalize(&phase0.ETH1Data{DepositCount: 21}, 100), "cannot finalize 21 deposits; tree only has 20")
+	require.NoError(t, tree.Finalize(&phase0.ETH1Data{DepositCount: 13, BlockHash: []byte{0x01}}, 100))
+	require.EqualError(t, tree.Finalize(&phase0.ETH1Data{DepositCount: 12}, 100), "cannot finalize 12 deposits; 13 already finalized")
+
+	_, _, err = tree.Proof(12)
+	require.EqualError(t, err, "deposit 12 has been finalized")
+
+	snapshot, err := tree.Snapshot()
+	require.NoError(t, err)
+	require.Equal(t, uint64(13), snapshot.DepositCount)
+	require.Len(t, snapshot.Finalized, 3)
+	require.Equal(t, naiveRoot(leaves[:13]), snapshot.DepositRoot)
+	require.Equal(t, phase0.Hash32{0x01}, snapshot.ExecutionBlockHash)
+	require.Equal(t, uint64(100), snapshot.ExecutionBlockHeight)
+
+	restored, err := deposit.NewFromSnapshot(snapshot)
+	require.NoError(t, err)
+	require.Equal(t, snapshot.DepositRoot, restored.Root())
+	for _, leaf := range leaves[13:20] {
+		require.NoError(t, restored.PushLeaf(leaf))
+	}
+	require.Equal(t, tree.Root(), restored.Root())
+
+	for _, leaf := range leaves[20:] {
+		require.NoError(t, tree.PushLeaf(leaf))
+		require.NoError(t, restored.PushLeaf(leaf))
+	}
+	require.Equal(t, naiveRoot(leaves), restored.Root())
+
+	for i := 13; i < len(leaves); i++ {
+		leaf, proof, err := restored.Proof(uint64(i))
+		require.NoError(t, err)
+		expectedLeaf, expectedProof, err := tree.Proof(uint64(i))
+		require.NoError(t, err)
+		require.Equal(t, expectedLeaf, leaf)
+		require.Equal(t, expectedProof, proof)
+		require.True(t, deposit.VerifyProof(restored.Root(), leaf, proof, uint64(i)))
+	}
+}
+
+func TestNewFromSnapshot(t *testing.T) {
+	tests := []struct {
+		name     string
+		snapshot *apiv1.DepositSnapshot
+		err      string
+	}{
+		{
+			name: "Nil",
+			err:  "no snapshot supplied",
+		},
+		{
+			name: "Empty",
+			snapshot: &apiv1.DepositSnapshot{
+				Finalized:   []phase0.Root{},
+				DepositRoot: naiveRoot(nil),
+			},
+		},
+		{
+			name: "InsufficientFinalized",
+			snapshot: &apiv1.DepositSnapshot{
+				Finalized:    []phase0.Root{},
+				DepositCount: 1,
+			},
+			err: "insufficient finalized roots for deposit count",
+		},
+		{
+			name: "RootMismatch",
+			snapshot: &apiv1.DepositSnapshot{
+				Finalized:    []phase0.Root{{0x01}},
+				DepositCount: 1,
+			},
+			err: "calculated deposit root 0x",
+		},
+		{
+			name: "Good",
+			snapshot: &apiv1.DepositSnapshot{
+				Finalized:    []phase0.Root{{0x01}},
+				DepositRoot:  naiveRoot([]phase0.Root{{0x01}}),
+				DepositCount: 1,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tree, err := deposit.NewFromSnapshot(test.snapshot)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.snapshot.DepositRoot, tree.Root())
+			require.Equal(t, test.snapshot.DepositCount, tree.DepositCount())
+		})
+	}
+}